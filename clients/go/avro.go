@@ -0,0 +1,57 @@
+package stencil
+
+import (
+	"fmt"
+
+	"github.com/hamba/avro"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// NewAvroResolver parses avro schema and returns type Resolver.
+// All named types (records, enums and fixed) defined in the schema can be looked up by their full name.
+func NewAvroResolver(data []byte) (*Resolver, error) {
+	resolver := &Resolver{
+		types:           &protoregistry.Types{},
+		javaToProtoName: make(map[string]string),
+		avroSchemas:     make(map[string]avro.Schema),
+	}
+	sc, err := avro.ParseWithCache(string(data), "", &avro.SchemaCache{})
+	if err != nil {
+		return resolver, fmt.Errorf("invalid avro schema. %w", err)
+	}
+	resolver.addAvroSchema(sc)
+	return resolver, nil
+}
+
+// GetAvroSchema returns avro schema for given full name of named avro type
+func (r *Resolver) GetAvroSchema(name string) (avro.Schema, bool) {
+	sc, ok := r.avroSchemas[name]
+	return sc, ok
+}
+
+func (r *Resolver) addAvroSchema(sc avro.Schema) {
+	switch s := sc.(type) {
+	case *avro.RecordSchema:
+		if _, ok := r.avroSchemas[s.FullName()]; ok {
+			return
+		}
+		r.avroSchemas[s.FullName()] = s
+		for _, field := range s.Fields() {
+			r.addAvroSchema(field.Type())
+		}
+	case *avro.EnumSchema:
+		r.avroSchemas[s.FullName()] = s
+	case *avro.FixedSchema:
+		r.avroSchemas[s.FullName()] = s
+	case *avro.ArraySchema:
+		r.addAvroSchema(s.Items())
+	case *avro.MapSchema:
+		r.addAvroSchema(s.Values())
+	case *avro.UnionSchema:
+		for _, t := range s.Types() {
+			r.addAvroSchema(t)
+		}
+	case *avro.RefSchema:
+		r.addAvroSchema(s.Schema())
+	}
+}
@@ -5,9 +5,12 @@ package stencil
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/hamba/avro"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -19,6 +22,26 @@ var (
 	ErrNotFound = errors.New("not found")
 	//ErrInvalidDescriptor is for when descriptor does not match the message
 	ErrInvalidDescriptor = errors.New("invalid descriptor")
+	//ErrInvalidFrame is for when framed message is malformed
+	ErrInvalidFrame = errors.New("invalid frame")
+	//ErrSchemaIDNotFound is for when global schema ID of downloaded schema is unknown
+	ErrSchemaIDNotFound = errors.New("schema id not found")
+)
+
+const (
+	// globalIDHeader response header used by stencil server to return global ID of schema
+	globalIDHeader = "X-Global-Id"
+	// formatHeader response header used by stencil server to return schema format
+	formatHeader   = "X-Format"
+	protobufFormat = "FORMAT_PROTOBUF"
+	avroFormat     = "FORMAT_AVRO"
+	jsonFormat     = "FORMAT_JSON"
+
+	// versionStateHeader response header used by stencil server to return lifecycle state of schema version
+	versionStateHeader       = "X-Version-State"
+	versionStateReasonHeader = "X-Version-State-Reason"
+	sunsetHeader             = "Sunset"
+	deprecatedState          = "DEPRECATED"
 )
 
 // Client provides utility functions to parse protobuf messages at runtime.
@@ -40,6 +63,33 @@ type Client interface {
 	Refresh()
 }
 
+// SchemaClient provides Client utility functions along with framed protobuf messages, avro and json schemas.
+type SchemaClient interface {
+	Client
+	// Deserialize parses framed message produced by Serialize with Framing option enabled.
+	// Schema is resolved from stencil server using schema ID present in the frame.
+	// Returns ErrInvalidFrame if data is not framed and ErrNotFound if message is not found in the schema
+	Deserialize([]byte) (protoreflect.ProtoMessage, error)
+	// ParseAvro decodes avro binary data to generic value given full name of avro named type.
+	// Records are decoded to map[string]interface{}.
+	// Returns ErrNotFound error if given name is not found
+	ParseAvro(string, []byte) (interface{}, error)
+	// ParseAvroInto decodes avro binary data into v given full name of avro named type.
+	// v should be a pointer to a struct with `avro` field tags or a map.
+	// Returns ErrNotFound error if given name is not found
+	ParseAvroInto(string, []byte, interface{}) error
+	// SerializeAvro encodes data to avro binary given full name of avro named type.
+	// Returns ErrNotFound error if given name is not found
+	SerializeAvro(string, interface{}) ([]byte, error)
+	// GetAvroSchema returns avro.Schema given full name of avro named type
+	GetAvroSchema(string) (avro.Schema, error)
+	// Validate validates json payload against json schema downloaded from given schema url.
+	// Compiled schema is cached and refreshed as per client options.
+	// Returns ValidationErrors if payload does not conform to the schema
+	// and ErrNotFound if schema url does not serve json schema
+	Validate(schemaURL string, payload []byte) error
+}
+
 // HTTPOptions options for http client
 type HTTPOptions struct {
 	// Timeout specifies a time limit for requests made by this client. Default to 10s.
@@ -63,6 +113,16 @@ type Options struct {
 	RefreshStrategy
 	// Logger is the interface used to get logging from stencil internals.
 	Logger
+	// Framing enables schema ID framing of messages returned by Serialize. Default to false.
+	// Frames follow confluent wire format, so only messages of root file of the schema can be framed.
+	// Schema urls should point to specific schema version, so that server returns schema ID.
+	Framing bool
+	// ServerURL base url of stencil server used to resolve schemas by ID.
+	// Defaults to base url of first schema url.
+	ServerURL string
+	// Format schema format used when server does not return format header, like FORMAT_AVRO or FORMAT_JSON.
+	// Defaults to detecting format from response content type and schema data.
+	Format string
 }
 
 func (o *Options) setDefaults() {
@@ -77,6 +137,12 @@ func (o *Options) setDefaults() {
 // NewClient creates stencil client. Downloads proto descriptor file from given url and stores the definitions.
 // It will throw error if download fails or downloaded file is not fully contained descriptor file
 func NewClient(urls []string, options Options) (Client, error) {
+	return NewSchemaClient(urls, options)
+}
+
+// NewSchemaClient creates stencil client which additionally supports framed messages, avro and json schemas.
+// Schemas are downloaded from given urls same as NewClient.
+func NewSchemaClient(urls []string, options Options) (SchemaClient, error) {
 	options.setDefaults()
 	stores := []*store{}
 	for _, url := range urls {
@@ -87,13 +153,33 @@ func NewClient(urls []string, options Options) (Client, error) {
 		stores = append(stores, s)
 	}
 
-	return &stencilClient{urls: urls, stores: stores, options: options}, nil
+	if options.ServerURL == "" && len(urls) > 0 {
+		options.ServerURL = getServerURL(urls[0])
+	}
+	return &stencilClient{
+		urls:         urls,
+		stores:       stores,
+		options:      options,
+		idResolvers:  make(map[int32]*Resolver),
+		schemaStores: make(map[string]*store),
+	}, nil
+}
+
+func getServerURL(url string) string {
+	if idx := strings.Index(url, "/v1beta1/"); idx >= 0 {
+		return url[:idx]
+	}
+	return strings.TrimRight(url, "/")
 }
 
 type stencilClient struct {
-	urls    []string
-	stores  []*store
-	options Options
+	urls        []string
+	stores      []*store
+	options     Options
+	idResolvers map[int32]*Resolver
+	// schemaStores holds stores of schema urls passed to Validate
+	schemaStores map[string]*store
+	lock         sync.RWMutex
 }
 
 func (s *stencilClient) Parse(className string, data []byte) (protoreflect.ProtoMessage, error) {
@@ -129,7 +215,59 @@ func (s *stencilClient) Serialize(className string, data interface{}) (bytes []b
 	}
 
 	// from proto message to byte[]
-	return proto.Marshal(m)
+	bytes, err = proto.Marshal(m)
+	if err != nil || !s.options.Framing {
+		return
+	}
+	if resolver.globalID == 0 {
+		return nil, ErrSchemaIDNotFound
+	}
+	indexes, ok := resolver.messagePath(messageType.Descriptor())
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return frame{schemaID: resolver.globalID, indexes: indexes, payload: bytes}.encode(), nil
+}
+
+func (s *stencilClient) Deserialize(data []byte) (protoreflect.ProtoMessage, error) {
+	f, err := decodeFrame(data)
+	if err != nil {
+		return nil, err
+	}
+	resolver, err := s.getResolverByID(f.schemaID)
+	if err != nil {
+		return nil, err
+	}
+	messageType, ok := resolver.getByPath(f.indexes)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	m := messageType.New().Interface()
+	err = proto.UnmarshalOptions{Resolver: resolver.GetTypeResolver()}.Unmarshal(f.payload, m)
+	return m, err
+}
+
+func (s *stencilClient) getResolverByID(id int32) (*Resolver, error) {
+	for _, store := range s.stores {
+		if resolver, ok := store.getResolver(); ok && resolver.globalID == id {
+			return resolver, nil
+		}
+	}
+	s.lock.RLock()
+	resolver, ok := s.idResolvers[id]
+	s.lock.RUnlock()
+	if ok {
+		return resolver, nil
+	}
+	resolver, err := loadFromURL(fmt.Sprintf("%s/v1beta1/schemas/ids/%d?assemble=true", s.options.ServerURL, id), s.options)
+	if err != nil {
+		return nil, err
+	}
+	resolver.globalID = id
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.idResolvers[id] = resolver
+	return resolver, nil
 }
 
 func (s *stencilClient) getMatchingResolver(className string) (*Resolver, bool) {
@@ -155,17 +293,116 @@ func (s *stencilClient) GetDescriptor(className string) (protoreflect.MessageDes
 	return desc.Descriptor(), nil
 }
 
+func (s *stencilClient) getMatchingAvroSchema(name string) (avro.Schema, bool) {
+	for _, store := range s.stores {
+		resolver, ok := store.getResolver()
+		if !ok {
+			return nil, false
+		}
+		sc, ok := resolver.GetAvroSchema(name)
+		if ok {
+			return sc, ok
+		}
+	}
+	return nil, false
+}
+
+func (s *stencilClient) GetAvroSchema(name string) (avro.Schema, error) {
+	sc, ok := s.getMatchingAvroSchema(name)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return sc, nil
+}
+
+func (s *stencilClient) ParseAvro(name string, data []byte) (interface{}, error) {
+	var val interface{}
+	err := s.ParseAvroInto(name, data, &val)
+	if err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+func (s *stencilClient) ParseAvroInto(name string, data []byte, v interface{}) error {
+	sc, ok := s.getMatchingAvroSchema(name)
+	if !ok {
+		return ErrNotFound
+	}
+	return avro.Unmarshal(sc, data, v)
+}
+
+func (s *stencilClient) SerializeAvro(name string, data interface{}) ([]byte, error) {
+	sc, ok := s.getMatchingAvroSchema(name)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return avro.Marshal(sc, data)
+}
+
+func (s *stencilClient) Validate(schemaURL string, payload []byte) error {
+	st, err := s.getStoreByURL(schemaURL)
+	if err != nil {
+		return err
+	}
+	resolver, ok := st.getResolver()
+	if !ok {
+		return ErrNotFound
+	}
+	sc, ok := resolver.GetJSONSchema()
+	if !ok {
+		return ErrNotFound
+	}
+	return validateJSON(sc, payload)
+}
+
+func (s *stencilClient) getStoreByURL(url string) (*store, error) {
+	for i, u := range s.urls {
+		if u == url {
+			return s.stores[i], nil
+		}
+	}
+	s.lock.RLock()
+	st, ok := s.schemaStores[url]
+	s.lock.RUnlock()
+	if ok {
+		return st, nil
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if st, ok := s.schemaStores[url]; ok {
+		return st, nil
+	}
+	st, err := newStore(url, s.options)
+	if err != nil {
+		return nil, err
+	}
+	s.schemaStores[url] = st
+	return st, nil
+}
+
 func (s *stencilClient) Close() {
 	for _, store := range s.stores {
 		if store != nil {
 			store.Close()
 		}
 	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, store := range s.schemaStores {
+		store.Close()
+	}
 }
 
 func (s *stencilClient) Refresh() {
 	var wg sync.WaitGroup
-	for _, st := range s.stores {
+	stores := append([]*store{}, s.stores...)
+	s.lock.RLock()
+	for _, st := range s.schemaStores {
+		stores = append(stores, st)
+	}
+	s.lock.RUnlock()
+	for _, st := range stores {
 		wg.Add(1)
 		go func(s *store) {
 			defer wg.Done()
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"os/exec"
 	"path/filepath"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -348,6 +349,292 @@ func TestClient(t *testing.T) {
 			assert.Equal(t, int64(fieldOneValue), val.Int())
 		})
 	})
+
+	t.Run("Deserialize", func(t *testing.T) {
+		desc, err := getDescriptorData(t, true)
+		assert.NoError(t, err)
+		idCalls := 0
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1beta1/namespaces/ns/schemas/sc/versions/1", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Global-Id", "7")
+			w.Write(desc)
+		})
+		mux.HandleFunc("/v1beta1/namespaces/ns/schemas/sc", func(w http.ResponseWriter, r *http.Request) {
+			w.Write(desc)
+		})
+		mux.HandleFunc("/v1beta1/schemas/ids/7", func(w http.ResponseWriter, r *http.Request) {
+			idCalls++
+			assert.Equal(t, "true", r.URL.Query().Get("assemble"))
+			w.Header().Set("X-Global-Id", "7")
+			w.Write(desc)
+		})
+		ts := httptest.NewServer(mux)
+		defer ts.Close()
+		producer, err := stencil.NewSchemaClient([]string{ts.URL + "/v1beta1/namespaces/ns/schemas/sc/versions/1"}, stencil.Options{Framing: true})
+		assert.NoError(t, err)
+		consumer, err := stencil.NewSchemaClient([]string{}, stencil.Options{ServerURL: ts.URL})
+		assert.NoError(t, err)
+
+		t.Run("should return error if schema id is not known while framing", func(t *testing.T) {
+			client, err := stencil.NewClient([]string{ts.URL + "/v1beta1/namespaces/ns/schemas/sc"}, stencil.Options{Framing: true})
+			assert.NoError(t, err)
+			result, err := client.Serialize("test.stencil.One", map[string]interface{}{"field_one": 23})
+			assert.Nil(t, result)
+			assert.Equal(t, stencil.ErrSchemaIDNotFound, err)
+		})
+		t.Run("should return error if data is not framed", func(t *testing.T) {
+			_, err := consumer.Deserialize([]byte{1, 0, 0, 0, 7, 0})
+			assert.ErrorIs(t, err, stencil.ErrInvalidFrame)
+			_, err = consumer.Deserialize([]byte{0, 0})
+			assert.ErrorIs(t, err, stencil.ErrInvalidFrame)
+		})
+		t.Run("should return not found if message index path is not in schema", func(t *testing.T) {
+			_, err := consumer.Deserialize([]byte{0, 0, 0, 0, 7, 4, 0, 100})
+			assert.Equal(t, stencil.ErrNotFound, err)
+		})
+		t.Run("should deserialize framed message without knowing class name", func(t *testing.T) {
+			data, err := producer.Serialize("test.stencil.Two.Four", map[string]interface{}{"field_two": "value"})
+			assert.NoError(t, err)
+			assert.Equal(t, []byte{0, 0, 0, 0, 7, 4, 2, 2}, data[:8])
+
+			parsed, err := consumer.Deserialize(data)
+			assert.NoError(t, err)
+			msgDesc := parsed.ProtoReflect().Descriptor()
+			assert.Equal(t, "test.Two.Four", string(msgDesc.FullName()))
+			assert.Equal(t, "value", parsed.ProtoReflect().Get(msgDesc.Fields().ByName("field_two")).String())
+
+			data, err = producer.Serialize("test.stencil.One", map[string]interface{}{"field_one": 23})
+			assert.NoError(t, err)
+			assert.Equal(t, []byte{0, 0, 0, 0, 7, 0}, data[:6])
+			parsed, err = consumer.Deserialize(data)
+			assert.NoError(t, err)
+			msgDesc = parsed.ProtoReflect().Descriptor()
+			assert.Equal(t, "test.One", string(msgDesc.FullName()))
+			assert.Equal(t, int64(23), parsed.ProtoReflect().Get(msgDesc.Fields().ByName("field_one")).Int())
+			assert.Equal(t, 1, idCalls)
+		})
+		t.Run("should deserialize confluent frame with explicit first message index", func(t *testing.T) {
+			data, err := producer.Serialize("test.stencil.One", map[string]interface{}{"field_one": 23})
+			assert.NoError(t, err)
+			parsed, err := consumer.Deserialize(append([]byte{0, 0, 0, 0, 7, 2, 0}, data[6:]...))
+			assert.NoError(t, err)
+			assert.Equal(t, "test.One", string(parsed.ProtoReflect().Descriptor().FullName()))
+		})
+		t.Run("should return not found while framing message outside root file", func(t *testing.T) {
+			result, err := producer.Serialize("test.Three", map[string]interface{}{"field_one": 23})
+			assert.Nil(t, result)
+			assert.Equal(t, stencil.ErrNotFound, err)
+		})
+		t.Run("should deserialize using already downloaded schema with same id", func(t *testing.T) {
+			data, err := producer.Serialize("test.stencil.One", map[string]interface{}{"field_one": 23})
+			assert.NoError(t, err)
+			parsed, err := producer.Deserialize(data)
+			assert.NoError(t, err)
+			assert.Equal(t, "test.One", string(parsed.ProtoReflect().Descriptor().FullName()))
+		})
+	})
+}
+
+type recordingLogger struct {
+	infos, warnings []string
+}
+
+func (l *recordingLogger) Info(msg string)  { l.infos = append(l.infos, msg) }
+func (l *recordingLogger) Error(msg string) {}
+func (l *recordingLogger) Warn(msg string)  { l.warnings = append(l.warnings, msg) }
+
+func TestDeprecatedVersion(t *testing.T) {
+	data, err := ioutil.ReadFile("./test_data/avro/schema.avsc")
+	assert.NoError(t, err)
+	state := "DEPRECATED"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Format", "FORMAT_AVRO")
+		w.Header().Set("X-Version-State", state)
+		w.Header().Set("X-Version-State-Reason", "use version 3")
+		w.Header().Set("Sunset", "Wed, 02 Jan 2030 03:04:05 GMT")
+		w.Write(data)
+	}))
+	defer ts.Close()
+	t.Run("should log warning when deprecated version is loaded", func(t *testing.T) {
+		logger := &recordingLogger{}
+		_, err := stencil.NewClient([]string{ts.URL}, stencil.Options{Logger: logger})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{fmt.Sprintf("schema version fetched from %s is deprecated: use version 3, sunset at Wed, 02 Jan 2030 03:04:05 GMT", ts.URL)}, logger.warnings)
+	})
+	t.Run("should not log warning for active version", func(t *testing.T) {
+		state = "ACTIVE"
+		logger := &recordingLogger{}
+		_, err := stencil.NewClient([]string{ts.URL}, stencil.Options{Logger: logger})
+		assert.NoError(t, err)
+		assert.Empty(t, logger.warnings)
+	})
+}
+
+func TestAvro(t *testing.T) {
+	data, err := ioutil.ReadFile("./test_data/avro/schema.avsc")
+	assert.NoError(t, err)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Format", "FORMAT_AVRO")
+		w.Write(data)
+	}))
+	defer ts.Close()
+	client, err := stencil.NewSchemaClient([]string{ts.URL}, stencil.Options{})
+	assert.NoError(t, err)
+	user := map[string]interface{}{
+		"name":    "stencil",
+		"age":     3,
+		"address": map[string]interface{}{"city": "bengaluru"},
+		"status":  "ACTIVE",
+	}
+
+	t.Run("should detect avro schema from content type", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(data)
+		}))
+		defer ts.Close()
+		client, err := stencil.NewSchemaClient([]string{ts.URL}, stencil.Options{})
+		assert.NoError(t, err)
+		_, err = client.GetAvroSchema("test.stencil.User")
+		assert.NoError(t, err)
+	})
+	t.Run("should return error if avro schema is invalid", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Format", "FORMAT_AVRO")
+			w.Write([]byte(`{"type": "record"}`))
+		}))
+		defer ts.Close()
+		_, err := stencil.NewClient([]string{ts.URL}, stencil.Options{})
+		assert.Contains(t, err.Error(), "invalid avro schema.")
+	})
+	t.Run("should return notFoundErr if name not found", func(t *testing.T) {
+		_, err := client.GetAvroSchema("test.stencil.Unknown")
+		assert.Equal(t, stencil.ErrNotFound, err)
+		_, err = client.SerializeAvro("test.stencil.Unknown", user)
+		assert.Equal(t, stencil.ErrNotFound, err)
+		_, err = client.ParseAvro("test.stencil.Unknown", []byte{})
+		assert.Equal(t, stencil.ErrNotFound, err)
+	})
+	t.Run("should lookup nested named types", func(t *testing.T) {
+		sc, err := client.GetAvroSchema("test.stencil.Address")
+		assert.NoError(t, err)
+		assert.Equal(t, "record", string(sc.Type()))
+		sc, err = client.GetAvroSchema("test.stencil.Status")
+		assert.NoError(t, err)
+		assert.Equal(t, "enum", string(sc.Type()))
+	})
+	t.Run("should serialize and parse into generic map", func(t *testing.T) {
+		bytes, err := client.SerializeAvro("test.stencil.User", user)
+		assert.NoError(t, err)
+		parsed, err := client.ParseAvro("test.stencil.User", bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"name":    "stencil",
+			"age":     3,
+			"address": map[string]interface{}{"city": "bengaluru"},
+			"status":  "ACTIVE",
+		}, parsed)
+	})
+	t.Run("should parse into struct", func(t *testing.T) {
+		type address struct {
+			City string `avro:"city"`
+		}
+		type userRecord struct {
+			Name    string  `avro:"name"`
+			Age     int     `avro:"age"`
+			Address address `avro:"address"`
+			Status  string  `avro:"status"`
+		}
+		bytes, err := client.SerializeAvro("test.stencil.User", userRecord{Name: "stencil", Age: 3, Address: address{City: "bengaluru"}, Status: "INACTIVE"})
+		assert.NoError(t, err)
+		var parsed userRecord
+		err = client.ParseAvroInto("test.stencil.User", bytes, &parsed)
+		assert.NoError(t, err)
+		assert.Equal(t, userRecord{Name: "stencil", Age: 3, Address: address{City: "bengaluru"}, Status: "INACTIVE"}, parsed)
+	})
+	t.Run("should return error if data does not match schema", func(t *testing.T) {
+		_, err := client.SerializeAvro("test.stencil.User", map[string]interface{}{"name": "stencil"})
+		assert.Error(t, err)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	data, err := ioutil.ReadFile("./test_data/json/schema.json")
+	assert.NoError(t, err)
+	callCount := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		w.Header().Set("X-Format", "FORMAT_JSON")
+		w.Write(data)
+	}))
+	defer ts.Close()
+	client, err := stencil.NewSchemaClient([]string{}, stencil.Options{})
+	assert.NoError(t, err)
+	defer client.Close()
+
+	t.Run("should return nil if payload is valid", func(t *testing.T) {
+		err := client.Validate(ts.URL, []byte(`{"id": "one", "count": 2, "tags": ["a"]}`))
+		assert.NoError(t, err)
+	})
+	t.Run("should return validation errors with json pointers", func(t *testing.T) {
+		err := client.Validate(ts.URL, []byte(`{"count": -1, "tags": ["a", 2]}`))
+		var validationErrs stencil.ValidationErrors
+		if assert.ErrorAs(t, err, &validationErrs) {
+			locations := []string{}
+			for _, e := range validationErrs {
+				locations = append(locations, e.InstanceLocation)
+			}
+			assert.ElementsMatch(t, []string{"", "/count", "/tags/1"}, locations)
+		}
+	})
+	t.Run("should return error if payload is not json", func(t *testing.T) {
+		err := client.Validate(ts.URL, []byte(`{invalid`))
+		assert.Contains(t, err.Error(), "invalid json payload.")
+	})
+	t.Run("should cache compiled schema", func(t *testing.T) {
+		assert.Equal(t, 1, callCount)
+	})
+	t.Run("should return error if schema is not json schema", func(t *testing.T) {
+		desc, err := getDescriptorData(t, true)
+		assert.NoError(t, err)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write(desc)
+		}))
+		defer ts.Close()
+		err = client.Validate(ts.URL, []byte(`{}`))
+		assert.Equal(t, stencil.ErrNotFound, err)
+	})
+	t.Run("should return error if schema download fails", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		}))
+		defer ts.Close()
+		err := client.Validate(ts.URL, []byte(`{}`))
+		assert.Contains(t, err.Error(), "request failed.")
+	})
+	t.Run("should detect json schema from content type and schema data", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(data)
+		}))
+		defer ts.Close()
+		err := client.Validate(ts.URL, []byte(`{"id": "one", "count": 2, "tags": ["a"]}`))
+		assert.NoError(t, err)
+	})
+	t.Run("should use format option if server does not return format header", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"type": "string"}`))
+		}))
+		defer ts.Close()
+		client, err := stencil.NewSchemaClient([]string{}, stencil.Options{Format: "FORMAT_JSON"})
+		assert.NoError(t, err)
+		defer client.Close()
+		err = client.Validate(ts.URL, []byte(`"one"`))
+		assert.NoError(t, err)
+		err = client.Validate(ts.URL, []byte(`1`))
+		assert.Error(t, err)
+	})
 }
 
 func TestRefreshStrategies(t *testing.T) {
@@ -402,4 +689,53 @@ func TestRefreshStrategies(t *testing.T) {
 		assert.Equal(t, 1, dataDownloadOneCount)
 		assert.Equal(t, 1, dataDownloadTwoCount)
 	})
+	t.Run("StreamingRefresh", func(t *testing.T) {
+		var downloadCount int32
+		data, err := getDescriptorDataByPath(t, true, "./test_data")
+		assert.NoError(t, err)
+		events := make(chan string)
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1beta1/namespaces/test-namespace/schemas/test-schema", func(rw http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&downloadCount, 1)
+			rw.Write(data)
+		})
+		mux.HandleFunc("/v1beta1/namespaces/test-namespace/watch", func(rw http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "test-schema", r.URL.Query().Get("schema_id"))
+			rw.WriteHeader(http.StatusOK)
+			rw.(http.Flusher).Flush()
+			for {
+				select {
+				case <-r.Context().Done():
+					return
+				case event := <-events:
+					rw.Write([]byte(event + "\n"))
+					rw.(http.Flusher).Flush()
+				}
+			}
+		})
+		ts := httptest.NewServer(mux)
+		defer ts.Close()
+
+		opts := stencil.Options{AutoRefresh: true, RefreshStrategy: stencil.StreamingRefresh}
+		client, err := stencil.NewClient([]string{fmt.Sprintf("%s/v1beta1/namespaces/test-namespace/schemas/test-schema", ts.URL)}, opts)
+		assert.NoError(t, err)
+		defer client.Close()
+		assert.Equal(t, int32(1), atomic.LoadInt32(&downloadCount))
+
+		events <- `{"result": {"type": "EVENT_TYPE_CREATE", "namespaceId": "test-namespace", "schemaId": "test-schema", "version": 2}}`
+		assert.Eventually(t, func() bool { return atomic.LoadInt32(&downloadCount) == 2 }, time.Second, time.Millisecond)
+		events <- `{"result": {"type": "EVENT_TYPE_UPDATE_METADATA", "namespaceId": "test-namespace", "schemaId": "test-schema"}}`
+		assert.Eventually(t, func() bool { return atomic.LoadInt32(&downloadCount) == 3 }, time.Second, time.Millisecond)
+	})
+	t.Run("StreamingRefresh should return error if url is not stencil schema url", func(t *testing.T) {
+		data, err := getDescriptorDataByPath(t, true, "./test_data")
+		assert.NoError(t, err)
+		ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			rw.Write(data)
+		}))
+		defer ts.Close()
+		opts := stencil.Options{AutoRefresh: true, RefreshStrategy: stencil.StreamingRefresh}
+		_, err = stencil.NewClient([]string{ts.URL}, opts)
+		assert.Contains(t, err.Error(), "streaming refresh requires stencil schema url")
+	})
 }
@@ -0,0 +1,75 @@
+package stencil
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// magicByte marks start of a framed message
+const magicByte byte = 0
+
+// frame is the envelope written around protobuf wire format data, compatible with confluent wire format.
+// Layout is magic byte, 4 byte big endian schema ID, message index path and the payload.
+// Message index path is written as zigzag varint count followed by zigzag varint indexes.
+// Indexes refer to message position within root file of the schema, then to nested message position
+// within its parent. Path [0] of first message in root file is written as single zero count.
+type frame struct {
+	schemaID int32
+	indexes  []int
+	payload  []byte
+}
+
+func (f frame) encode() []byte {
+	buf := make([]byte, 5, 5+binary.MaxVarintLen64*(len(f.indexes)+1)+len(f.payload))
+	buf[0] = magicByte
+	binary.BigEndian.PutUint32(buf[1:5], uint32(f.schemaID))
+	if isDefaultPath(f.indexes) {
+		buf = appendVarint(buf, 0)
+	} else {
+		buf = appendVarint(buf, int64(len(f.indexes)))
+		for _, idx := range f.indexes {
+			buf = appendVarint(buf, int64(idx))
+		}
+	}
+	return append(buf, f.payload...)
+}
+
+func decodeFrame(data []byte) (frame, error) {
+	var f frame
+	if len(data) < 5 {
+		return f, fmt.Errorf("%w: message too short", ErrInvalidFrame)
+	}
+	if data[0] != magicByte {
+		return f, fmt.Errorf("%w: unknown magic byte %d", ErrInvalidFrame, data[0])
+	}
+	f.schemaID = int32(binary.BigEndian.Uint32(data[1:5]))
+	rest := data[5:]
+	count, n := binary.Varint(rest)
+	if n <= 0 || count < 0 || count > int64(len(rest)) {
+		return f, fmt.Errorf("%w: invalid message index count", ErrInvalidFrame)
+	}
+	rest = rest[n:]
+	if count == 0 {
+		f.indexes = []int{0}
+	}
+	for i := int64(0); i < count; i++ {
+		idx, n := binary.Varint(rest)
+		if n <= 0 || idx < 0 {
+			return f, fmt.Errorf("%w: invalid message index", ErrInvalidFrame)
+		}
+		f.indexes = append(f.indexes, int(idx))
+		rest = rest[n:]
+	}
+	f.payload = rest
+	return f, nil
+}
+
+func appendVarint(buf []byte, v int64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+func isDefaultPath(indexes []int) bool {
+	return len(indexes) == 1 && indexes[0] == 0
+}
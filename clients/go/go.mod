@@ -3,7 +3,9 @@ module github.com/raystack/stencil/clients/go
 go 1.16
 
 require (
+	github.com/hamba/avro v1.8.0
 	github.com/pkg/errors v0.9.1
-	github.com/stretchr/testify v1.7.0
+	github.com/santhosh-tekuri/jsonschema/v5 v5.3.0
+	github.com/stretchr/testify v1.7.2
 	google.golang.org/protobuf v1.26.0
 )
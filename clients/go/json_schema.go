@@ -0,0 +1,90 @@
+package stencil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const jsonSchemaResource = "schema.json"
+
+// ValidationError describes single violation of json schema by the payload
+type ValidationError struct {
+	// InstanceLocation is json pointer to invalid value within the payload
+	InstanceLocation string
+	// KeywordLocation is json pointer to failed keyword within the schema
+	KeywordLocation string
+	Message         string
+}
+
+// ValidationErrors is returned by Validate when payload does not conform to json schema
+type ValidationErrors []ValidationError
+
+func (v ValidationErrors) Error() string {
+	var msgs []string
+	for _, e := range v {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", e.InstanceLocation, e.Message))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// NewJSONSchemaResolver compiles json schema and returns type Resolver
+func NewJSONSchemaResolver(data []byte) (*Resolver, error) {
+	resolver := &Resolver{
+		types:           &protoregistry.Types{},
+		javaToProtoName: make(map[string]string),
+	}
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft2020
+	if err := compiler.AddResource(jsonSchemaResource, bytes.NewReader(data)); err != nil {
+		return resolver, fmt.Errorf("invalid json schema. %w", err)
+	}
+	sc, err := compiler.Compile(jsonSchemaResource)
+	if err != nil {
+		return resolver, fmt.Errorf("invalid json schema. %w", err)
+	}
+	resolver.jsonSchema = sc
+	return resolver, nil
+}
+
+// GetJSONSchema returns compiled json schema if resolver is created from json schema
+func (r *Resolver) GetJSONSchema() (*jsonschema.Schema, bool) {
+	return r.jsonSchema, r.jsonSchema != nil
+}
+
+func validateJSON(sc *jsonschema.Schema, payload []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var val interface{}
+	if err := decoder.Decode(&val); err != nil {
+		return fmt.Errorf("invalid json payload. %w", err)
+	}
+	err := sc.Validate(val)
+	if err == nil {
+		return nil
+	}
+	validationErr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return err
+	}
+	return toValidationErrors(validationErr, nil)
+}
+
+// toValidationErrors flattens nested validation errors to leaf violations
+func toValidationErrors(err *jsonschema.ValidationError, errs ValidationErrors) ValidationErrors {
+	if len(err.Causes) == 0 {
+		return append(errs, ValidationError{
+			InstanceLocation: err.InstanceLocation,
+			KeywordLocation:  err.KeywordLocation,
+			Message:          err.Message,
+		})
+	}
+	for _, cause := range err.Causes {
+		errs = toValidationErrors(cause, errs)
+	}
+	return errs
+}
@@ -1,11 +1,16 @@
 package stencil
 
 // Logger interface used to get logging from stencil internals.
+// Warnings are logged using Warn(string) method if the logger has one, otherwise using Info.
 type Logger interface {
 	Info(string)
 	Error(string)
 }
 
+type warnLogger interface {
+	Warn(string)
+}
+
 type wrappedLogger struct {
 	l Logger
 }
@@ -22,6 +27,14 @@ func (w wrappedLogger) Error(msg string) {
 	}
 }
 
-func wrapLogger(l Logger) Logger {
+func (w wrappedLogger) Warn(msg string) {
+	if wl, ok := w.l.(warnLogger); ok {
+		wl.Warn(msg)
+		return
+	}
+	w.Info(msg)
+}
+
+func wrapLogger(l Logger) wrappedLogger {
 	return wrappedLogger{l}
 }
@@ -33,11 +33,16 @@ func defaultKeyFn(msg protoreflect.MessageDescriptor) string {
 	return strings.Replace(fullName, protoPackage, pkg, 1)
 }
 
-func getFilesRegistry(data []byte) (*protoregistry.Files, error) {
+func getFilesRegistry(data []byte) (*protoregistry.Files, []string, error) {
 	msg := &descriptorpb.FileDescriptorSet{}
 	err := proto.Unmarshal(data, msg)
 	if err != nil {
-		return nil, fmt.Errorf("invalid file descriptorset file. %w", err)
+		return nil, nil, fmt.Errorf("invalid file descriptorset file. %w", err)
 	}
-	return protodesc.NewFiles(msg)
+	var fileNames []string
+	for _, file := range msg.GetFile() {
+		fileNames = append(fileNames, file.GetName())
+	}
+	files, err := protodesc.NewFiles(msg)
+	return files, fileNames, err
 }
@@ -3,6 +3,8 @@ package stencil
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -17,6 +19,10 @@ const (
 	// VersionBasedRefresh this refresh strategy utilizes versions API provided by Stencil Server.
 	// If new version is available then only schema cache would be updated.
 	VersionBasedRefresh
+	// StreamingRefresh this refresh strategy listens to schema change events streamed by Stencil Server
+	// and updates schema cache only when schema changes. Schema urls should point to Stencil Server schemas API.
+	// RefreshInterval is not used by this strategy.
+	StreamingRefresh
 )
 
 func (r RefreshStrategy) getLoader(opts Options) loaderFunc {
@@ -31,13 +37,78 @@ func (r RefreshStrategy) getLoader(opts Options) loaderFunc {
 func loadFromURL(url string, opts Options) (*Resolver, error) {
 	logger := wrapLogger(opts.Logger)
 	logger.Info(fmt.Sprintf("fetching schema from %s", url))
-	data, err := downloader(url, opts.HTTPOptions)
+	data, header, err := downloader(url, opts.HTTPOptions)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to fetch schema from %s", url))
 		return nil, err
 	}
 	logger.Info(fmt.Sprintf("successfully fetched schema from %s", url))
-	return NewResolver(data)
+	if header.Get(versionStateHeader) == deprecatedState {
+		logger.Warn(deprecationMessage(url, header))
+	}
+	newResolver := NewResolver
+	switch getFormat(header, data, opts) {
+	case avroFormat:
+		newResolver = NewAvroResolver
+	case jsonFormat:
+		newResolver = NewJSONSchemaResolver
+	}
+	resolver, err := newResolver(data)
+	if err != nil {
+		return resolver, err
+	}
+	if id, err := strconv.ParseInt(header.Get(globalIDHeader), 10, 32); err == nil {
+		resolver.globalID = int32(id)
+	}
+	return resolver, nil
+}
+
+func deprecationMessage(url string, header http.Header) string {
+	msg := fmt.Sprintf("schema version fetched from %s is deprecated", url)
+	if reason := header.Get(versionStateReasonHeader); reason != "" {
+		msg = fmt.Sprintf("%s: %s", msg, reason)
+	}
+	if sunset := header.Get(sunsetHeader); sunset != "" {
+		msg = fmt.Sprintf("%s, sunset at %s", msg, sunset)
+	}
+	return msg
+}
+
+// getFormat returns schema format from response headers, falling back to format option and then to schema data.
+// Servers not sending format header serve protobuf as octet-stream, avro and json schemas as json.
+func getFormat(header http.Header, data []byte, opts Options) string {
+	if format := header.Get(formatHeader); format != "" {
+		return format
+	}
+	if opts.Format != "" {
+		return opts.Format
+	}
+	if strings.HasPrefix(header.Get("Content-Type"), "application/json") {
+		return detectJSONFormat(data)
+	}
+	return protobufFormat
+}
+
+// jsonSchemaKeywords are keywords of json schema which are not attributes of avro schemas
+var jsonSchemaKeywords = []string{"$schema", "$id", "$ref", "$defs", "definitions", "properties", "required", "allOf", "anyOf", "oneOf"}
+
+// detectJSONFormat tells json schema apart from avro schema served as json.
+// Avro schema is assumed if data has none of json schema keywords.
+func detectJSONFormat(data []byte) string {
+	var sc map[string]json.RawMessage
+	if err := json.Unmarshal(data, &sc); err != nil {
+		return avroFormat
+	}
+	for _, keyword := range jsonSchemaKeywords {
+		if _, ok := sc[keyword]; ok {
+			return jsonFormat
+		}
+	}
+	var typ string
+	if json.Unmarshal(sc["type"], &typ) == nil && (typ == "object" || typ == "integer" || typ == "number") {
+		return jsonFormat
+	}
+	return avroFormat
 }
 
 func longPollingRefresh(opts Options) loaderFunc {
@@ -55,7 +126,7 @@ func versionBasedRefresh(opts Options) loaderFunc {
 	logger := wrapLogger(opts.Logger)
 	return func(url string) (*Resolver, error) {
 		versionsURL := fmt.Sprintf("%s/versions", strings.TrimRight(url, "/"))
-		data, err := downloader(versionsURL, opts.HTTPOptions)
+		data, _, err := downloader(versionsURL, opts.HTTPOptions)
 		if err != nil {
 			logger.Error(fmt.Sprintf("unable to download versions info, %s", err))
 			return nil, err
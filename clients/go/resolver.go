@@ -3,15 +3,22 @@ package stencil
 import (
 	"fmt"
 
+	"github.com/hamba/avro"
+	"github.com/santhosh-tekuri/jsonschema/v5"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/dynamicpb"
 )
 
-// Resolver protobuf type resolver
+// Resolver protobuf, avro and json schema type resolver
 type Resolver struct {
 	types           *protoregistry.Types
 	javaToProtoName map[string]string
+	files           []protoreflect.FileDescriptor
+	rootFile        protoreflect.FileDescriptor
+	avroSchemas     map[string]avro.Schema
+	jsonSchema      *jsonschema.Schema
+	globalID        int32
 }
 
 // Get returns protobuf messageType for given proto message fullname.
@@ -34,6 +41,62 @@ func (r *Resolver) GetTypeResolver() *protoregistry.Types {
 	return r.types
 }
 
+// messagePath returns message index path of given message descriptor used in framed messages.
+// Only messages of root file can be framed.
+func (r *Resolver) messagePath(msg protoreflect.MessageDescriptor) ([]int, bool) {
+	if r.rootFile == nil || msg.ParentFile().Path() != r.rootFile.Path() {
+		return nil, false
+	}
+	var indexes []int
+	var desc protoreflect.Descriptor = msg
+	for ; desc != nil; desc = desc.Parent() {
+		if _, ok := desc.(protoreflect.FileDescriptor); ok {
+			break
+		}
+		indexes = append([]int{desc.Index()}, indexes...)
+	}
+	return indexes, true
+}
+
+// getByPath returns protobuf messageType referred by message index path.
+func (r *Resolver) getByPath(indexes []int) (protoreflect.MessageType, bool) {
+	if r.rootFile == nil || len(indexes) == 0 {
+		return nil, false
+	}
+	msgs := r.rootFile.Messages()
+	var msg protoreflect.MessageDescriptor
+	for _, idx := range indexes {
+		if idx >= msgs.Len() {
+			return nil, false
+		}
+		msg = msgs.Get(idx)
+		msgs = msg.Messages()
+	}
+	msgType, err := r.types.FindMessageByName(msg.FullName())
+	if err != nil {
+		return nil, false
+	}
+	return msgType, true
+}
+
+// getRootFile returns first file which is not imported by other files of the schema,
+// like root file of confluent schema whose imports are served as references.
+func getRootFile(files []protoreflect.FileDescriptor) protoreflect.FileDescriptor {
+	imported := make(map[string]bool)
+	for _, file := range files {
+		imports := file.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			imported[imports.Get(i).Path()] = true
+		}
+	}
+	for _, file := range files {
+		if !imported[file.Path()] {
+			return file
+		}
+	}
+	return nil
+}
+
 func (r *Resolver) addExtensions(exts protoreflect.ExtensionDescriptors) *Resolver {
 	for i := 0; i < exts.Len(); i++ {
 		ext := exts.Get(i)
@@ -76,13 +139,18 @@ func NewResolver(data []byte) (*Resolver, error) {
 		types:           types,
 		javaToProtoName: make(map[string]string),
 	}
-	files, err := getFilesRegistry(data)
+	files, fileNames, err := getFilesRegistry(data)
 	if err != nil {
 		return resolver, fmt.Errorf("file is not fully contained descriptor file. %w", err)
 	}
-	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+	for _, name := range fileNames {
+		file, err := files.FindFileByPath(name)
+		if err != nil {
+			return resolver, err
+		}
+		resolver.files = append(resolver.files, file)
 		resolver.registerFile(file)
-		return true
-	})
+	}
+	resolver.rootFile = getRootFile(resolver.files)
 	return resolver, nil
 }
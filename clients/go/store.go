@@ -87,7 +87,15 @@ func newStore(url string, options Options) (*store, error) {
 	}
 	s.data = val
 	if options.AutoRefresh {
-		s.timer = setInterval(options.RefreshInterval, s.refresh, s.access)
+		if options.RefreshStrategy == StreamingRefresh {
+			w, err := watch(url, options, s.refresh)
+			if err != nil {
+				return s, err
+			}
+			s.timer = w
+		} else {
+			s.timer = setInterval(options.RefreshInterval, s.refresh, s.access)
+		}
 	}
 	return s, nil
 }
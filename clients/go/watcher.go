@@ -0,0 +1,118 @@
+package stencil
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = time.Minute
+)
+
+var schemaURLPattern = regexp.MustCompile(`^(.*)/v1beta1/namespaces/([^/]+)/schemas/([^/?]+)`)
+
+type watchEvent struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// watcher listens to schema change events streamed by stencil server
+type watcher struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (w *watcher) Close() error {
+	w.cancel()
+	<-w.done
+	return nil
+}
+
+// getWatchURL returns url of change events stream for given schema url
+func getWatchURL(schemaURL string) (string, error) {
+	matches := schemaURLPattern.FindStringSubmatch(schemaURL)
+	if matches == nil {
+		return "", fmt.Errorf("streaming refresh requires stencil schema url, got %s", schemaURL)
+	}
+	return fmt.Sprintf("%s/v1beta1/namespaces/%s/watch?schema_id=%s", matches[1], matches[2], url.QueryEscape(matches[3])), nil
+}
+
+// watch calls onChange for every change event of the schema. After reconnecting to the server
+// onChange is called as well, since events might have been missed in between.
+func watch(schemaURL string, opts Options, onChange func()) (*watcher, error) {
+	watchURL, err := getWatchURL(schemaURL)
+	if err != nil {
+		return nil, err
+	}
+	logger := wrapLogger(opts.Logger)
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &watcher{cancel: cancel, done: make(chan struct{})}
+	go func() {
+		defer close(w.done)
+		delay := minReconnectDelay
+		connected := false
+		for {
+			err := streamEvents(ctx, watchURL, opts.HTTPOptions, func() {
+				delay = minReconnectDelay
+				if connected {
+					onChange()
+				}
+				connected = true
+			}, onChange)
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Error(fmt.Sprintf("schema change stream from %s closed, %v", watchURL, err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			if delay *= 2; delay > maxReconnectDelay {
+				delay = maxReconnectDelay
+			}
+		}
+	}()
+	return w, nil
+}
+
+func streamEvents(ctx context.Context, watchURL string, opts HTTPOptions, onConnect, onEvent func()) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", watchURL, nil)
+	if err != nil {
+		return fmt.Errorf("invalid request. %w", err)
+	}
+	for key, val := range opts.Headers {
+		req.Header.Add(key, val)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed. %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("request failed. status code: %d", res.StatusCode)
+	}
+	onConnect()
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		event := &watchEvent{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			return fmt.Errorf("invalid event. %w", err)
+		}
+		if event.Error != nil {
+			return errors.New(event.Error.Message)
+		}
+		onEvent()
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/salt/cli/printer"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"github.com/spf13/cobra"
+)
+
+func AuditCmd(cdk *CDK) *cobra.Command {
+	var req stencilv1beta1.ListAuditEventsRequest
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List audit events",
+		Long:  "List mutations made to namespaces and schemas, latest first",
+		Args:  cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ stencil audit
+			$ stencil audit -n raystack
+			$ stencil audit -n raystack -s person --page-size 20
+			$ stencil audit -n raystack --page-token 120
+		`),
+		Annotations: map[string]string{
+			"group":  "core",
+			"client": "true",
+		},
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		s := printer.Spin("")
+		defer s.Stop()
+
+		client, cancel, err := createClient(cmd, cdk)
+		if err != nil {
+			return err
+		}
+		defer cancel()
+
+		if len(req.SchemaId) > 0 && len(req.NamespaceId) == 0 {
+			s.Stop()
+			fmt.Println("Namespace ID not specified for", req.SchemaId)
+			return nil
+		}
+
+		res, err := client.ListAuditEvents(context.Background(), &req)
+		if err != nil {
+			return err
+		}
+
+		events := res.GetEvents()
+		s.Stop()
+
+		if len(events) == 0 {
+			fmt.Println("No audit events found")
+			return nil
+		}
+
+		report := [][]string{}
+		report = append(report, []string{
+			printer.Bold("ID"),
+			printer.Bold("TIME"),
+			printer.Bold("ACTOR"),
+			printer.Bold("OPERATION"),
+			printer.Bold("NAMESPACE"),
+			printer.Bold("SCHEMA"),
+			printer.Bold("VERSION"),
+			printer.Bold("REQUEST ID"),
+		})
+		for _, e := range events {
+			version := ""
+			if e.GetVersion() != 0 {
+				version = strconv.Itoa(int(e.GetVersion()))
+			}
+			report = append(report, []string{
+				strconv.FormatInt(e.GetId(), 10),
+				e.GetCreatedAt().AsTime().Format("2006-01-02 15:04:05"),
+				e.GetActor(),
+				e.GetOperation(),
+				e.GetNamespaceId(),
+				e.GetSchemaId(),
+				version,
+				e.GetRequestId(),
+			})
+		}
+		printer.Table(os.Stdout, report)
+		if res.GetNextPageToken() != "" {
+			fmt.Printf("\nNext page token: %s\n", res.GetNextPageToken())
+		}
+		return nil
+	}
+
+	cmd.Flags().StringVarP(&req.NamespaceId, "namespace", "n", "", "filter by namespace ID")
+	cmd.Flags().StringVarP(&req.SchemaId, "schema", "s", "", "filter by schema ID")
+	cmd.Flags().Int32Var(&req.PageSize, "page-size", 0, "number of events per page")
+	cmd.Flags().StringVar(&req.PageToken, "page-token", "", "token of the page to fetch")
+
+	return cmd
+}
@@ -1,25 +1,47 @@
 package cmd
 
+import (
+	"sort"
+	"strings"
+
+	"github.com/raystack/stencil/core/schema/provider"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+)
+
 var dict = map[string]string{
-	"COMPATIBILITY_BACKWARD":    "backward",
-	"COMPATIBILITY_FORWARD":     "forward",
-	"COMPATIBILITY_FULL":        "full",
-	"COMPATIBILITY_UNSPECIFIED": "-",
-	"FORMAT_PROTOBUF":           "protobuf",
-	"FORMAT_JSON":               "json",
-	"FORMAT_AVRO":               "avro",
+	"COMPATIBILITY_BACKWARD":            "backward",
+	"COMPATIBILITY_BACKWARD_TRANSITIVE": "backward_transitive",
+	"COMPATIBILITY_FORWARD":             "forward",
+	"COMPATIBILITY_FORWARD_TRANSITIVE":  "forward_transitive",
+	"COMPATIBILITY_FULL":                "full",
+	"COMPATIBILITY_FULL_TRANSITIVE":     "full_transitive",
+	"COMPATIBILITY_UNSPECIFIED":         "-",
 }
 
 var (
-	formats = []string{
-		"FORMAT_JSON",
-		"FORMAT_PROTOBUF",
-		"FORMAT_AVRO",
+	// formats has names of schema formats registered with provider
+	formats = provider.Formats()
+	// comps has compatibility modes in the order they are declared
+	comps = compatibilities()
+)
+
+func init() {
+	for _, format := range formats {
+		dict[format] = strings.ToLower(strings.TrimPrefix(format, "FORMAT_"))
 	}
+}
 
-	comps = []string{
-		"COMPATIBILITY_BACKWARD",
-		"COMPATIBILITY_FORWARD",
-		"COMPATIBILITY_FULL",
+func compatibilities() []string {
+	var values []int32
+	for value := range stencilv1beta1.Schema_Compatibility_name {
+		if value != int32(stencilv1beta1.Schema_COMPATIBILITY_UNSPECIFIED) {
+			values = append(values, value)
+		}
 	}
-)
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	names := make([]string, 0, len(values))
+	for _, value := range values {
+		names = append(names, stencilv1beta1.Schema_Compatibility_name[value])
+	}
+	return names
+}
@@ -14,7 +14,8 @@ import (
 )
 
 func checkSchemaCmd(cdk *CDK) *cobra.Command {
-	var comp, file, namespaceID string
+	var comp, file, namespaceID, importRoot string
+	var references []string
 	var req stencilv1beta1.CheckCompatibilityRequest
 
 	cmd := &cobra.Command{
@@ -26,12 +27,14 @@ func checkSchemaCmd(cdk *CDK) *cobra.Command {
 			against a remote schema(against) on stencil server.`),
 		Example: heredoc.Doc(`
 			$ stencil schema check <id> -n raystack -c COMPATIBILITY_BACKWARD -F ./booking.desc
+			$ stencil schema check <id> -n raystack -c COMPATIBILITY_BACKWARD -F ./booking.desc --reference common/money@2
+			$ stencil schema check <id> -n raystack -c COMPATIBILITY_BACKWARD -F ./proto
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			spinner := printer.Spin("")
 			defer spinner.Stop()
 
-			fileData, err := os.ReadFile(file)
+			fileData, err := readSchemaFile(file)
 			if err != nil {
 				return err
 			}
@@ -45,18 +48,42 @@ func checkSchemaCmd(cdk *CDK) *cobra.Command {
 			schemaID := args[0]
 
 			req.Data = fileData
+			req.ImportRoot = importRoot
 			req.NamespaceId = namespaceID
 			req.SchemaId = schemaID
 			req.Compatibility = stencilv1beta1.Schema_Compatibility(stencilv1beta1.Schema_Compatibility_value[comp])
+			for _, r := range references {
+				ref, err := parseReference(r)
+				if err != nil {
+					return err
+				}
+				req.References = append(req.References, ref)
+			}
 
-			_, err = client.CheckCompatibility(context.Background(), &req)
+			res, err := client.CheckCompatibility(context.Background(), &req)
 			if err != nil {
 				errStatus := status.Convert(err)
 				return errors.New(errStatus.Message())
 			}
 
 			spinner.Stop()
+			if len(res.GetLintIssues()) > 0 {
+				printLintIssues(lintIssuesFromProto(res.GetLintIssues()))
+			}
+			if !res.GetCompatible() {
+				report := [][]string{}
+				report = append(report, []string{"KIND", "PATH", "OLD", "NEW", "MESSAGE"})
+				for _, v := range res.GetViolations() {
+					report = append(report, []string{v.GetKind(), v.GetPath(), v.GetOldValue(), v.GetNewValue(), v.GetMessage()})
+				}
+				fmt.Printf("\n%s Schema is not compatible. Found %d violation(s)\n\n", printer.Red(printer.Icon("failure")), len(res.GetViolations()))
+				printer.Table(os.Stdout, report)
+				return errors.New("schema is not compatible")
+			}
 			fmt.Printf("\n%s Schema is compatible.\n", printer.Green(printer.Icon("success")))
+			if len(res.GetLintIssues()) > 0 {
+				return errors.New("schema failed lint rules")
+			}
 			return nil
 		},
 	}
@@ -67,8 +94,12 @@ func checkSchemaCmd(cdk *CDK) *cobra.Command {
 	cmd.Flags().StringVarP(&comp, "comp", "c", "", "Schema compatibility")
 	cmd.MarkFlagRequired("comp")
 
-	cmd.Flags().StringVarP(&file, "file", "F", "", "Path to the schema file")
+	cmd.Flags().StringVarP(&file, "file", "F", "", "Path to the schema file, archive of .proto files or directory of .proto files")
 	cmd.MarkFlagRequired("file")
 
+	cmd.Flags().StringVar(&importRoot, "import-root", "", "Directory in archive of .proto files, which imports are relative to")
+
+	cmd.Flags().StringArrayVar(&references, "reference", nil, "Schema version referenced by this schema in namespace/schema@version form, can be repeated")
+
 	return cmd
 }
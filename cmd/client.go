@@ -13,13 +13,29 @@ import (
 
 type ClientConfig struct {
 	Host string `yaml:"host" cmdx:"host"`
+	// Token is sent as bearer token to servers with authentication enabled
+	Token string `yaml:"token" cmdx:"token"`
 }
 
-func createConnection(ctx context.Context, host string) (*grpc.ClientConn, error) {
+// bearerToken attaches API token to every request
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
+func createConnection(ctx context.Context, host, token string) (*grpc.ClientConn, error) {
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithBlock(),
 	}
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(token)))
+	}
 
 	return grpc.DialContext(ctx, host, opts...)
 }
@@ -37,7 +53,7 @@ func createClient(cmd *cobra.Command, cdk *CDK) (stencilv1beta1.StencilServiceCl
 	}
 
 	dialTimeoutCtx, dialCancel := context.WithTimeout(cmd.Context(), time.Second*2)
-	conn, err := createConnection(dialTimeoutCtx, host)
+	conn, err := createConnection(dialTimeoutCtx, host, c.Token)
 	if err != nil {
 		dialCancel()
 		return nil, nil, err
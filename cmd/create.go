@@ -8,6 +8,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/raystack/salt/cli/printer"
+	"github.com/raystack/stencil/pkg/archive"
 	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
@@ -15,7 +16,9 @@ import (
 )
 
 func createSchemaCmd(cdk *CDK) *cobra.Command {
-	var format, comp, file, namespaceID string
+	var format, comp, file, namespaceID, message, importRoot string
+	var dryRun bool
+	var references []string
 	var req stencilv1beta1.CreateSchemaRequest
 
 	cmd := &cobra.Command{
@@ -25,13 +28,26 @@ func createSchemaCmd(cdk *CDK) *cobra.Command {
 		Example: heredoc.Doc(`
 			$ stencil schema create booking -n raystack –F booking.json
 			$ stencil schema create booking -n raystack -f FORMAT_JSON –c COMPATIBILITY_BACKWARD –F ./booking.json 
+			$ stencil schema create booking -n raystack –F booking.json --dry-run
+			$ stencil schema create booking -n raystack –F booking.json -m "add booking status"
+			$ stencil schema create booking -n raystack –F booking.desc --reference common/money@2
+			$ stencil schema create booking -n raystack -f FORMAT_PROTOBUF –F ./proto
+			$ stencil schema create booking -n raystack -f FORMAT_PROTOBUF –F protos.zip --import-root proto
 	    `),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			fileData, err := os.ReadFile(file)
+			fileData, err := readSchemaFile(file)
 			if err != nil {
 				return err
 			}
 			req.Data = fileData
+			req.ImportRoot = importRoot
+			for _, r := range references {
+				ref, err := parseReference(r)
+				if err != nil {
+					return err
+				}
+				req.References = append(req.References, ref)
+			}
 
 			spinner := printer.Spin("")
 			defer spinner.Stop()
@@ -46,6 +62,8 @@ func createSchemaCmd(cdk *CDK) *cobra.Command {
 			req.SchemaId = schemaID
 			req.Format = stencilv1beta1.Schema_Format(stencilv1beta1.Schema_Format_value[format])
 			req.Compatibility = stencilv1beta1.Schema_Compatibility(stencilv1beta1.Schema_Compatibility_value[comp])
+			req.DryRun = dryRun
+			req.Message = message
 
 			res, err := client.CreateSchema(context.Background(), &req)
 			if err != nil {
@@ -60,6 +78,21 @@ func createSchemaCmd(cdk *CDK) *cobra.Command {
 			id := res.GetId()
 
 			spinner.Stop()
+			if len(res.GetLintIssues()) > 0 {
+				printLintIssues(lintIssuesFromProto(res.GetLintIssues()))
+			}
+			if dryRun {
+				if res.GetDuplicate() {
+					fmt.Printf("\n%s Schema is already registered as version %d with id %s.\n", printer.Green(printer.Icon("success")), res.GetVersion(), printer.Cyan(id))
+					return nil
+				}
+				if res.GetRestorable() {
+					fmt.Printf("\n%s Schema would restore deleted version %d with id %s.\n", printer.Green(printer.Icon("success")), res.GetVersion(), printer.Cyan(id))
+					return nil
+				}
+				fmt.Printf("\n%s Schema would be created as version %d with id %s.\n", printer.Green(printer.Icon("success")), res.GetVersion(), printer.Cyan(id))
+				return nil
+			}
 			fmt.Printf("\n%s Created schema with id %s.\n", printer.Green(printer.Icon("success")), printer.Cyan(id))
 			return nil
 		},
@@ -72,8 +105,28 @@ func createSchemaCmd(cdk *CDK) *cobra.Command {
 
 	cmd.Flags().StringVarP(&comp, "comp", "c", "", "Schema compatibility")
 
-	cmd.Flags().StringVarP(&file, "file", "F", "", "Path to the schema file")
+	cmd.Flags().StringVarP(&file, "file", "F", "", "Path to the schema file, archive of .proto files or directory of .proto files")
 	cmd.MarkFlagRequired("file")
 
+	cmd.Flags().StringVar(&importRoot, "import-root", "", "Directory in archive of .proto files, which imports are relative to")
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate schema and report the version it would get without creating it")
+
+	cmd.Flags().StringVarP(&message, "message", "m", "", "Message describing changes made in this version")
+
+	cmd.Flags().StringArrayVar(&references, "reference", nil, "Schema version referenced by this schema in namespace/schema@version form, can be repeated")
+
 	return cmd
 }
+
+// readSchemaFile reads schema file, directory is packed as archive of .proto files to be compiled by server
+func readSchemaFile(file string) ([]byte, error) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return archive.Pack(file, ".proto")
+	}
+	return os.ReadFile(file)
+}
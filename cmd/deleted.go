@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/salt/cli/printer"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"github.com/spf13/cobra"
+)
+
+func DeletedCmd(cdk *CDK) *cobra.Command {
+	var req stencilv1beta1.ListDeletedItemsRequest
+
+	cmd := &cobra.Command{
+		Use:   "deleted",
+		Short: "List deleted items",
+		Long:  "List deleted namespaces, schemas and versions which can still be restored",
+		Args:  cobra.NoArgs,
+		Example: heredoc.Doc(`
+			$ stencil deleted
+			$ stencil deleted -n raystack
+		`),
+		Annotations: map[string]string{
+			"group":  "core",
+			"client": "true",
+		},
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		s := printer.Spin("")
+		defer s.Stop()
+
+		client, cancel, err := createClient(cmd, cdk)
+		if err != nil {
+			return err
+		}
+		defer cancel()
+
+		res, err := client.ListDeletedItems(context.Background(), &req)
+		if err != nil {
+			return err
+		}
+
+		items := res.GetItems()
+		s.Stop()
+
+		if len(items) == 0 {
+			fmt.Println("No deleted items found")
+			return nil
+		}
+
+		report := [][]string{}
+		report = append(report, []string{
+			printer.Bold("KIND"),
+			printer.Bold("NAMESPACE"),
+			printer.Bold("SCHEMA"),
+			printer.Bold("VERSION"),
+			printer.Bold("DELETED AT"),
+			printer.Bold("PURGE AT"),
+		})
+		for _, item := range items {
+			version := ""
+			if item.GetVersion() != 0 {
+				version = strconv.Itoa(int(item.GetVersion()))
+			}
+			report = append(report, []string{
+				strings.TrimPrefix(item.GetKind().String(), "KIND_"),
+				item.GetNamespaceId(),
+				item.GetSchemaId(),
+				version,
+				item.GetDeletedAt().AsTime().Format("2006-01-02 15:04:05"),
+				item.GetPurgeAt().AsTime().Format("2006-01-02 15:04:05"),
+			})
+		}
+		printer.Table(os.Stdout, report)
+		return nil
+	}
+
+	cmd.Flags().StringVarP(&req.NamespaceId, "namespace", "n", "", "filter by namespace ID")
+
+	return cmd
+}
@@ -2,145 +2,69 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/raystack/salt/cli/printer"
 	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
 	"github.com/spf13/cobra"
-	"github.com/yudai/gojsondiff"
-	"github.com/yudai/gojsondiff/formatter"
-	"google.golang.org/protobuf/encoding/protojson"
-	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/reflect/protodesc"
-	"google.golang.org/protobuf/reflect/protoreflect"
-	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 func diffSchemaCmd(cdk *CDK) *cobra.Command {
-	var fullname string
+	var fullname, comp string
 	var namespace string
 	var earlierVersion int32
 	var laterVersion int32
 
-	var schemaFetcher = func(req *stencilv1beta1.GetSchemaRequest, client stencilv1beta1.StencilServiceClient) ([]byte, error) {
-		res, err := client.GetSchema(context.Background(), req)
-		if err != nil {
-			return nil, err
-		}
-		return res.Data, nil
-	}
-	var protoSchemaFetcher = func(req *stencilv1beta1.GetSchemaRequest, client stencilv1beta1.StencilServiceClient) ([]byte, error) {
-		if fullname == "" {
-			return nil, fmt.Errorf("fullname flag is mandator for FORMAT_PROTO")
-		}
-		res, err := client.GetSchema(context.Background(), req)
-		if err != nil {
-			return nil, err
-		}
-		fds := &descriptorpb.FileDescriptorSet{}
-		if err := proto.Unmarshal(res.Data, fds); err != nil {
-			return nil, fmt.Errorf("descriptor set file is not valid. %w", err)
-		}
-		files, err := protodesc.NewFiles(fds)
-		if err != nil {
-			return nil, fmt.Errorf("file is not fully contained descriptor file. hint: generate file descriptorset with --include_imports option. %w", err)
-		}
-		desc, err := files.FindDescriptorByName(protoreflect.FullName(fullname))
-		if err != nil {
-			return nil, fmt.Errorf("unable to find message. %w", err)
-		}
-		mDesc, ok := desc.(protoreflect.MessageDescriptor)
-		if !ok {
-			return nil, fmt.Errorf("not a message desc")
-		}
-		jsonByte, err := protojson.Marshal(protodesc.ToDescriptorProto(mDesc))
-		if err != nil {
-			return nil, fmt.Errorf("fail to convert json. %w", err)
-		}
-		return jsonByte, nil
-	}
-
 	cmd := &cobra.Command{
 		Use:   "diff",
 		Short: "Diff(s) of two schema versions",
 		Args:  cobra.ExactArgs(1),
+		Long: heredoc.Doc(`
+			Show changes made in later version of schema since earlier version.
+			Changes are computed by server for protobuf, avro, json, thrift and graphql schemas and marked
+			breaking as per the compatibility mode, schema compatibility is used by default.
+			OpenAPI and AsyncAPI schemas have no diff, use check command to find breaking changes instead.`),
 		Example: heredoc.Doc(`
-			$ stencil schema diff booking -n=raystack --later-version=2 --earlier-version=1 --fullname=<fullname>
+			$ stencil schema diff booking -n=raystack --later-version=2 --earlier-version=1
+			$ stencil schema diff booking -n=raystack --later-version=2 --earlier-version=1 -c COMPATIBILITY_FULL
+			$ stencil schema diff booking -n=raystack --later-version=2 --earlier-version=1 --fullname=raystack.booking.Booking
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			compatibility := stencilv1beta1.Schema_COMPATIBILITY_UNSPECIFIED
+			if comp != "" {
+				if !slices.Contains(comps, comp) {
+					return fmt.Errorf("invalid compatibility %q, should be one of %s", comp, strings.Join(comps, ", "))
+				}
+				compatibility = stencilv1beta1.Schema_Compatibility(stencilv1beta1.Schema_Compatibility_value[comp])
+			}
+
 			spinner := printer.Spin("")
 			defer spinner.Stop()
 
 			schemaID := args[0]
 
-			metaReq := stencilv1beta1.GetSchemaMetadataRequest{
-				NamespaceId: namespace,
-				SchemaId:    schemaID,
-			}
-			eReq := &stencilv1beta1.GetSchemaRequest{
-				NamespaceId: namespace,
-				SchemaId:    schemaID,
-				VersionId:   earlierVersion,
-			}
-			lReq := &stencilv1beta1.GetSchemaRequest{
-				NamespaceId: namespace,
-				SchemaId:    schemaID,
-				VersionId:   laterVersion,
-			}
-
 			client, cancel, err := createClient(cmd, cdk)
 			if err != nil {
 				return err
 			}
 			defer cancel()
 
-			meta, err := client.GetSchemaMetadata(context.Background(), &metaReq)
-			if err != nil {
-				return err
-			}
-
-			var getSchema = schemaFetcher
-			if meta.Format == *stencilv1beta1.Schema_FORMAT_PROTOBUF.Enum() {
-				getSchema = protoSchemaFetcher
-			}
-
-			eJson, err := getSchema(eReq, client)
+			res, err := client.DiffSchemaVersions(context.Background(), &stencilv1beta1.DiffSchemaVersionsRequest{
+				NamespaceId:   namespace,
+				SchemaId:      schemaID,
+				FromVersion:   earlierVersion,
+				ToVersion:     laterVersion,
+				Compatibility: compatibility,
+			})
 			if err != nil {
 				return err
 			}
-
-			lJson, err := getSchema(lReq, client)
-			if err != nil {
-				return err
-			}
-
-			d, err := gojsondiff.New().Compare(eJson, lJson)
-			if err != nil {
-				return err
-			}
-
-			var placeholder map[string]interface{}
-			json.Unmarshal(eJson, &placeholder)
-			config := formatter.AsciiFormatterConfig{
-				ShowArrayIndex: true,
-				Coloring:       true,
-			}
-
-			formatter := formatter.NewAsciiFormatter(placeholder, config)
-			diffString, err := formatter.Format(d)
-			if err != nil {
-				return err
-			}
-
 			spinner.Stop()
-			if !d.Modified() {
-				fmt.Print("No diff!")
-				return nil
-			}
-			fmt.Print(diffString)
-
+			printChanges(res, fullname)
 			return nil
 		},
 	}
@@ -151,6 +75,41 @@ func diffSchemaCmd(cdk *CDK) *cobra.Command {
 	cmd.MarkFlagRequired("earlier-version")
 	cmd.Flags().Int32Var(&laterVersion, "later-version", 0, "Later version of the schema")
 	cmd.MarkFlagRequired("later-version")
-	cmd.Flags().StringVar(&fullname, "fullname", "", "Only applicable for FORMAT_PROTO. fullname of proto schema eg: raystack.common.v1.Version")
+	cmd.Flags().StringVar(&fullname, "fullname", "", "Show only changes under the element eg: raystack.common.v1.Version or #/properties/name")
+	cmd.Flags().StringVarP(&comp, "comp", "c", "", "Compatibility mode to mark breaking changes for, defaults to schema compatibility")
 	return cmd
 }
+
+// printChanges prints changes of diff, limited to changes under element having fullname if it is given
+func printChanges(res *stencilv1beta1.DiffSchemaVersionsResponse, fullname string) {
+	report := [][]string{{"KIND", "PATH", "BREAKING", "MESSAGE"}}
+	var breaking int
+	for _, c := range res.GetChanges() {
+		if fullname != "" && !underElement(c.GetPath(), fullname) {
+			continue
+		}
+		mark := "no"
+		if c.GetBreaking() {
+			mark = printer.Red("yes")
+			breaking++
+		}
+		report = append(report, []string{c.GetKind(), c.GetPath(), mark, c.GetMessage()})
+	}
+	if len(report) == 1 {
+		fmt.Print("No diff!")
+		return
+	}
+	fmt.Printf("\nFound %d change(s), %d breaking for %s\n\n", len(report)-1, breaking, res.GetCompatibility().String())
+	printer.Table(os.Stdout, report)
+}
+
+// underElement checks whether path is of the element or nested under it, as in
+// protobuf, avro, thrift and graphql names separated by dots, graphql arguments in parentheses
+// or json schema locations separated by slashes
+func underElement(path, element string) bool {
+	if !strings.HasPrefix(path, element) {
+		return false
+	}
+	rest := strings.TrimPrefix(path, element)
+	return rest == "" || strings.ContainsAny(rest[:1], "./[{(")
+}
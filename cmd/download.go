@@ -12,6 +12,7 @@ import (
 func downloadSchemaCmd(cdk *CDK) *cobra.Command {
 	var output, namespaceID string
 	var version int32
+	var assemble bool
 	var data []byte
 
 	cmd := &cobra.Command{
@@ -20,6 +21,7 @@ func downloadSchemaCmd(cdk *CDK) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Example: heredoc.Doc(`
 			$ stencil schema download customer -n=raystack --version 1
+			$ stencil schema download customer -n=raystack --version 1 --assemble
 	    `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			spinner := printer.Spin("")
@@ -30,7 +32,7 @@ func downloadSchemaCmd(cdk *CDK) *cobra.Command {
 			}
 			defer cancel()
 
-			data, _, err = fetchSchemaAndMeta(client, version, namespaceID, args[0])
+			data, _, err = fetchSchemaAndMeta(client, version, namespaceID, args[0], assemble)
 			if err != nil {
 				return err
 			}
@@ -54,5 +56,7 @@ func downloadSchemaCmd(cdk *CDK) *cobra.Command {
 	cmd.Flags().StringVarP(&output, "output", "o", "", "Path to the output file")
 	cmd.MarkFlagRequired("output")
 
+	cmd.Flags().BoolVar(&assemble, "assemble", false, "Include referenced schemas in the downloaded file")
+
 	return cmd
 }
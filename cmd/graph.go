@@ -33,7 +33,7 @@ func graphSchemaCmd(cdk *CDK) *cobra.Command {
 
 			schemaID := args[0]
 
-			data, resMetadata, err := fetchSchemaAndMeta(client, version, namespaceID, schemaID)
+			data, resMetadata, err := fetchSchemaAndMeta(client, version, namespaceID, schemaID, true)
 			if err != nil {
 				return err
 			}
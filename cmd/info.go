@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/dustin/go-humanize"
 	"github.com/raystack/salt/cli/printer"
 	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
 	"github.com/spf13/cobra"
@@ -75,6 +77,7 @@ func versionSchemaCmd(cdk *CDK) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Example: heredoc.Doc(`
 			$ stencil schema version booking -n raystack
+			$ stencil schema version booking -n raystack --page-size 10 --page-token 20
 	    `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			spinner := printer.Spin("")
@@ -95,7 +98,7 @@ func versionSchemaCmd(cdk *CDK) *cobra.Command {
 				return err
 			}
 
-			versions := res.GetVersions()
+			versions := res.GetVersionDetails()
 			spinner.Stop()
 
 			if len(versions) == 0 {
@@ -104,23 +107,42 @@ func versionSchemaCmd(cdk *CDK) *cobra.Command {
 			}
 
 			report := [][]string{}
-			report = append(report, []string{"VERSION", "CREATED", "MESSAGE"})
+			report = append(report, []string{"VERSION", "STATE", "CREATED", "CREATOR", "SIZE", "MESSAGE"})
 
 			for _, v := range versions {
+				created := "-"
+				if v.GetCreatedAt() != nil {
+					created = v.GetCreatedAt().AsTime().Format("2006-01-02 15:04:05")
+				}
 				report = append(report, []string{
-					printer.Greenf("#%v", strconv.FormatInt(int64(v), 10)),
-					"-",
-					"-",
+					printer.Greenf("#%v", strconv.FormatInt(int64(v.GetVersion()), 10)),
+					strings.TrimPrefix(v.GetState().String(), "STATE_"),
+					created,
+					valueOrDash(v.GetCreator()),
+					humanize.Bytes(uint64(v.GetSize())),
+					valueOrDash(v.GetMessage()),
 				})
 			}
-			fmt.Printf("\nShowing %[1]d of %[1]d versions for %s\n \n", len(versions), schemaID)
+			fmt.Printf("\nShowing %d versions for %s\n \n", len(versions), schemaID)
 			printer.Table(os.Stdout, report)
+			if res.GetNextPageToken() != "" {
+				fmt.Printf("\nNext page token: %s\n", res.GetNextPageToken())
+			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVarP(&namespaceID, "namespace", "n", "", "parent namespace ID")
 	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().Int32Var(&req.PageSize, "page-size", 0, "number of versions per page, all versions are shown if not set")
+	cmd.Flags().StringVar(&req.PageToken, "page-token", "", "token of the page to fetch")
 
 	return cmd
 }
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
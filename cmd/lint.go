@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/salt/cli/printer"
+	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/formats/protobuf"
+	"github.com/raystack/stencil/pkg/archive"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"github.com/spf13/cobra"
+)
+
+func lintSchemaCmd(cdk *CDK) *cobra.Command {
+	var file, namespaceID, ruleSet, importRoot string
+	var rules map[string]string
+
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Lint a local protobuf schema",
+		Args:  cobra.ExactArgs(0),
+		Long: heredoc.Doc(`
+			Run lint rules on a local protobuf descriptor set, archive
+			or directory of .proto files, without uploading it.
+			Rules configured for a namespace on stencil server are used if namespace is given.`),
+		Example: heredoc.Doc(`
+			$ stencil schema lint -F ./booking.desc
+			$ stencil schema lint -F ./proto --rule-set BASIC --rule JAVA_PACKAGE_DEFINED=WARNING
+			$ stencil schema lint -F ./booking.desc -n raystack
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := readSchemaFile(file)
+			if err != nil {
+				return err
+			}
+			if archive.IsArchive(data) {
+				files, err := archive.Extract(data)
+				if err != nil {
+					return err
+				}
+				if data, err = protobuf.Compile(files, importRoot); err != nil {
+					return err
+				}
+			}
+
+			if namespaceID != "" {
+				client, cancel, err := createClient(cmd, cdk)
+				if err != nil {
+					return err
+				}
+				defer cancel()
+				res, err := client.GetNamespace(context.Background(), &stencilv1beta1.GetNamespaceRequest{Id: namespaceID})
+				if err != nil {
+					return err
+				}
+				lint := res.GetNamespace().GetLint()
+				if ruleSet == "" {
+					ruleSet = lint.GetRuleSet()
+				}
+				merged := map[string]string{}
+				for rule, severity := range lint.GetRules() {
+					merged[rule] = severity
+				}
+				for rule, severity := range rules {
+					merged[rule] = severity
+				}
+				rules = merged
+			}
+			if ruleSet == "" && len(rules) == 0 {
+				ruleSet = namespace.LintRuleSetDefault
+			}
+
+			parsed, err := protobuf.GetParsedSchema(data)
+			if err != nil {
+				return err
+			}
+			issues := parsed.(schema.Linter).Lint(ruleSet, rules)
+			if len(issues) == 0 {
+				fmt.Printf("\n%s Schema passed lint rules.\n", printer.Green(printer.Icon("success")))
+				return nil
+			}
+			printLintIssues(issues)
+			for _, issue := range issues {
+				if issue.Severity == namespace.LintSeverityError {
+					return errors.New("schema failed lint rules")
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "F", "", "Path to the descriptor set file, archive of .proto files or directory of .proto files")
+	cmd.MarkFlagRequired("file")
+
+	cmd.Flags().StringVar(&importRoot, "import-root", "", "Directory in archive of .proto files, which imports are relative to")
+
+	cmd.Flags().StringVarP(&namespaceID, "namespace", "n", "", "Namespace ID to use lint rules from")
+
+	cmd.Flags().StringVar(&ruleSet, "rule-set", "", "Lint rule set, one of MINIMAL, BASIC or DEFAULT")
+
+	cmd.Flags().StringToStringVar(&rules, "rule", nil, "Override lint rule severity as RULE=SEVERITY, severity is one of ERROR, WARNING or OFF")
+
+	return cmd
+}
+
+// printLintIssues prints table of failed lint rules
+func printLintIssues(issues []schema.LintIssue) {
+	report := [][]string{{"SEVERITY", "RULE", "PATH", "MESSAGE"}}
+	for _, issue := range issues {
+		report = append(report, []string{issue.Severity, issue.Rule, issue.Path, issue.Message})
+	}
+	fmt.Printf("\n%s Found %d lint issue(s)\n\n", printer.Yellow(printer.Icon("warning")), len(issues))
+	printer.Table(os.Stdout, report)
+}
+
+func lintIssuesFromProto(issues []*stencilv1beta1.LintIssue) []schema.LintIssue {
+	var res []schema.LintIssue
+	for _, issue := range issues {
+		res = append(res, schema.LintIssue{Rule: issue.GetRule(), Severity: issue.GetSeverity(), Path: issue.GetPath(), Message: issue.GetMessage()})
+	}
+	return res
+}
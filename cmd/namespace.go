@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dustin/go-humanize"
@@ -37,6 +38,7 @@ func NamespaceCmd(cdk *CDK) *cobra.Command {
 	cmd.AddCommand(viewNamespaceCmd(cdk))
 	cmd.AddCommand(editNamespaceCmd(cdk))
 	cmd.AddCommand(deleteNamespaceCmd(cdk))
+	cmd.AddCommand(restoreNamespaceCmd(cdk))
 
 	return cmd
 }
@@ -98,7 +100,8 @@ func listNamespaceCmd(cdk *CDK) *cobra.Command {
 }
 
 func createNamespaceCmd(cdk *CDK) *cobra.Command {
-	var id, desc, format, comp string
+	var id, desc, format, comp, lintRuleSet string
+	var lintRules map[string]string
 	var req stencilv1beta1.CreateNamespaceRequest
 
 	cmd := &cobra.Command{
@@ -108,6 +111,7 @@ func createNamespaceCmd(cdk *CDK) *cobra.Command {
 		Example: heredoc.Doc(`
 			$ stencil namespace create 
 			$ stencil namespace create -n=raystack -f=FORMAT_PROTOBUF -c=COMPATIBILITY_BACKWARD -d="Event schemas"
+			$ stencil namespace create -n=raystack -f=FORMAT_PROTOBUF -c=COMPATIBILITY_BACKWARD -d="Event schemas" --lint-rule-set=DEFAULT --lint-rule=JAVA_PACKAGE_DEFINED=WARNING
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			prompter := prompter.New()
@@ -134,6 +138,7 @@ func createNamespaceCmd(cdk *CDK) *cobra.Command {
 			req.Format = stencilv1beta1.Schema_Format(stencilv1beta1.Schema_Format_value[format])
 			req.Compatibility = stencilv1beta1.Schema_Compatibility(stencilv1beta1.Schema_Compatibility_value[comp])
 			req.Description = desc
+			req.Lint = lintConfig(lintRuleSet, lintRules)
 
 			spinner := printer.Spin("")
 			defer spinner.Stop()
@@ -166,13 +171,16 @@ func createNamespaceCmd(cdk *CDK) *cobra.Command {
 	cmd.Flags().StringVarP(&format, "format", "f", "", "Default schema format for schemas in this namespace")
 	cmd.Flags().StringVarP(&comp, "comp", "c", "", "Default schema compatibility for schemas in this namespace")
 	cmd.Flags().StringVarP(&desc, "desc", "d", "", "Supply a description. Will prompt otherwise")
+	cmd.Flags().StringVar(&lintRuleSet, "lint-rule-set", "", "Lint rule set run on schemas in this namespace, one of MINIMAL, BASIC or DEFAULT")
+	cmd.Flags().StringToStringVar(&lintRules, "lint-rule", nil, "Override lint rule severity as RULE=SEVERITY, severity is one of ERROR, WARNING or OFF")
 
 	return cmd
 }
 
 func editNamespaceCmd(cdk *CDK) *cobra.Command {
 	var format, comp string
-	var desc string
+	var desc, lintRuleSet string
+	var lintRules map[string]string
 	var req stencilv1beta1.UpdateNamespaceRequest
 
 	cmd := &cobra.Command{
@@ -198,6 +206,7 @@ func editNamespaceCmd(cdk *CDK) *cobra.Command {
 			req.Format = stencilv1beta1.Schema_Format(stencilv1beta1.Schema_Format_value[format])
 			req.Compatibility = stencilv1beta1.Schema_Compatibility(stencilv1beta1.Schema_Compatibility_value[comp])
 			req.Description = desc
+			req.Lint = lintConfig(lintRuleSet, lintRules)
 
 			res, err := client.UpdateNamespace(context.Background(), &req)
 			spinner.Stop()
@@ -228,9 +237,20 @@ func editNamespaceCmd(cdk *CDK) *cobra.Command {
 	cmd.Flags().StringVarP(&desc, "desc", "d", "", "description")
 	cmd.MarkFlagRequired("desc")
 
+	cmd.Flags().StringVar(&lintRuleSet, "lint-rule-set", "", "lint rule set, one of MINIMAL, BASIC or DEFAULT")
+	cmd.Flags().StringToStringVar(&lintRules, "lint-rule", nil, "lint rule severity override as RULE=SEVERITY")
+
 	return cmd
 }
 
+// lintConfig returns lint config of namespace, lint is disabled if neither rule set nor rules are given
+func lintConfig(ruleSet string, rules map[string]string) *stencilv1beta1.LintConfig {
+	if ruleSet == "" && len(rules) == 0 {
+		return nil
+	}
+	return &stencilv1beta1.LintConfig{RuleSet: ruleSet, Rules: rules}
+}
+
 func viewNamespaceCmd(cdk *CDK) *cobra.Command {
 	var req stencilv1beta1.GetNamespaceRequest
 
@@ -330,6 +350,52 @@ func deleteNamespaceCmd(cdk *CDK) *cobra.Command {
 	return cmd
 }
 
+func restoreNamespaceCmd(cdk *CDK) *cobra.Command {
+	var req stencilv1beta1.RestoreNamespaceRequest
+
+	cmd := &cobra.Command{
+		Use:   "restore <id>",
+		Short: "Restore a deleted namespace",
+		Long:  "Restore a deleted namespace along with schemas and versions deleted with it",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ stencil namespace restore raystack
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd, cdk)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id := args[0]
+			req.Id = id
+
+			_, err = client.RestoreNamespace(context.Background(), &req)
+
+			spinner.Stop()
+
+			if err != nil {
+				errStatus, _ := status.FromError(err)
+				if codes.NotFound == errStatus.Code() {
+					fmt.Printf("\n%s Deleted namespace with id '%s' does not exist.\n", printer.Icon("failure"), id)
+					return nil
+				}
+				return err
+			}
+
+			fmt.Printf("\n%s Restored namespace with id %s.\n", printer.Green(printer.Icon("success")), printer.Bold(printer.Blue(id)))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 func printNamespace(namespace *stencilv1beta1.Namespace) {
 	desc := namespace.GetDescription()
 	if desc == "" {
@@ -340,6 +406,17 @@ func printNamespace(namespace *stencilv1beta1.Namespace) {
 	fmt.Printf("\n%s.\n\n", printer.Grey(desc))
 	fmt.Printf("%s \t %s \n", printer.Grey("Format:"), namespace.GetFormat().String())
 	fmt.Printf("%s \t %s \n", printer.Grey("Compatibility:"), namespace.GetCompatibility().String())
+	if lint := namespace.GetLint(); lint != nil {
+		fmt.Printf("%s \t %s \n", printer.Grey("Lint rule set:"), lint.GetRuleSet())
+		rules := make([]string, 0, len(lint.GetRules()))
+		for rule, severity := range lint.GetRules() {
+			rules = append(rules, rule+"="+severity)
+		}
+		sort.Strings(rules)
+		for _, rule := range rules {
+			fmt.Printf("%s \t %s \n", printer.Grey("Lint rule:"), rule)
+		}
+	}
 	fmt.Printf("\n%s %s, ", printer.Grey("Created"), humanize.Time(namespace.GetCreatedAt().AsTime()))
 	fmt.Printf("%s %s \n\n", printer.Grey("last updated"), humanize.Time(namespace.GetUpdatedAt().AsTime()))
 }
@@ -2,18 +2,15 @@ package cmd
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/alecthomas/chroma/quick"
-	"github.com/jhump/protoreflect/desc"
-	"github.com/jhump/protoreflect/desc/protoprint"
 	"github.com/raystack/salt/cli/printer"
 	"github.com/raystack/salt/cli/terminator"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/core/schema/provider"
 	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
 	"github.com/spf13/cobra"
-	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 func printSchemaCmd(cdk *CDK) *cobra.Command {
@@ -38,27 +35,24 @@ func printSchemaCmd(cdk *CDK) *cobra.Command {
 			}
 			defer cancel()
 
-			data, meta, err := fetchSchemaAndMeta(client, version, namespaceID, args[0])
+			data, meta, err := fetchSchemaAndMeta(client, version, namespaceID, args[0], true)
 			if err != nil {
 				return err
 			}
 			spinner.Stop()
 
 			format := stencilv1beta1.Schema_Format_name[int32(meta.GetFormat())]
-			switch format {
-			case "FORMAT_AVRO":
-				if err := printSchema(data); err != nil {
-					return err
-				}
-			case "FORMAT_JSON":
-				if err := printSchema(data); err != nil {
-					return err
-				}
-			case "FORMAT_PROTOBUF":
-				printProtoSchema(data, filter)
-			default:
+			f, _ := provider.Lookup(format)
+			p, ok := f.(schema.Printer)
+			if !ok {
 				fmt.Printf("%s Unknown schema format: %s\n", printer.Red(printer.Icon("failure")), format)
+				return nil
 			}
+			source, language, err := p.PrintSchema(data, filter)
+			if err != nil {
+				return err
+			}
+			printSource(source, language)
 			return nil
 		},
 	}
@@ -72,54 +66,13 @@ func printSchemaCmd(cdk *CDK) *cobra.Command {
 	return cmd
 }
 
-func printSchema(data []byte) error {
-	page := terminator.NewPager()
-	page.Start()
-	defer page.Stop()
-
-	err := quick.Highlight(page.Out, string(data), "JSON", "terminal16m", "solarized-light")
-	if err != nil {
-		page.Out.Write(data)
-	}
-	return nil
-}
-
-func printProtoSchema(data []byte, filter string) error {
-	fds := &descriptorpb.FileDescriptorSet{}
-	if err := proto.Unmarshal(data, fds); err != nil {
-		return fmt.Errorf("descriptor set file is not valid. %w", err)
-	}
-	fdsMap, err := desc.CreateFileDescriptorsFromSet(fds)
-	if err != nil {
-		return err
-	}
-	var filteredFds []*desc.FileDescriptor
-	for fdName, fd := range fdsMap {
-		if filter != "" && !strings.HasPrefix(fdName, filter) {
-			continue
-		}
-		filteredFds = append(filteredFds, fd)
-	}
-
-	protoPrinter := &protoprint.Printer{}
-
-	var schema string
-
-	for _, fd := range filteredFds {
-		protoAsString, err := protoPrinter.PrintProtoToString(fd)
-		if err != nil {
-			return err
-		}
-		schema = schema + fmt.Sprintf("\n//Schema file:: %s\n\n%s", fd.GetName(), protoAsString)
-	}
-
+// printSource prints schema source highlighted for its language in a pager
+func printSource(source, language string) {
 	page := terminator.NewPager()
 	page.Start()
 	defer page.Stop()
 
-	err = quick.Highlight(page.Out, schema, "Protocol Buffer", "terminal16m", "solarized-light")
-	if err != nil {
-		fmt.Fprint(page.Out, schema)
+	if err := quick.Highlight(page.Out, source, language, "terminal16m", "solarized-light"); err != nil {
+		fmt.Fprint(page.Out, source)
 	}
-	return nil
 }
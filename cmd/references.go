@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/salt/cli/printer"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"github.com/spf13/cobra"
+)
+
+func referencesSchemaCmd(cdk *CDK) *cobra.Command {
+	var req stencilv1beta1.ListReferencesRequest
+
+	cmd := &cobra.Command{
+		Use:   "references <id>",
+		Short: "List references of a schema version",
+		Long: heredoc.Doc(`
+			List schema versions referenced by a schema version and versions referring to it.
+			Referenced versions can't be deleted as long as they have referrers.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ stencil schema references money -n common -v 2
+	    `),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd, cdk)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			req.SchemaId = args[0]
+			res, err := client.ListReferences(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			if len(res.GetReferences()) == 0 && len(res.GetReferrers()) == 0 {
+				fmt.Printf("Version %d of %s has no references\n", req.VersionId, req.SchemaId)
+				return nil
+			}
+
+			report := [][]string{}
+			report = append(report, []string{
+				printer.Bold("DIRECTION"),
+				printer.Bold("NAMESPACE"),
+				printer.Bold("SCHEMA"),
+				printer.Bold("VERSION"),
+			})
+			for _, ref := range res.GetReferences() {
+				report = append(report, []string{"references", ref.GetNamespaceId(), ref.GetSchemaId(), strconv.Itoa(int(ref.GetVersion()))})
+			}
+			for _, ref := range res.GetReferrers() {
+				report = append(report, []string{"referred by", ref.GetNamespaceId(), ref.GetSchemaId(), strconv.Itoa(int(ref.GetVersion()))})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&req.NamespaceId, "namespace", "n", "", "parent namespace ID")
+	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().Int32VarP(&req.VersionId, "version", "v", 0, "version of the schema")
+	cmd.MarkFlagRequired("version")
+
+	return cmd
+}
+
+func parseReference(value string) (*stencilv1beta1.SchemaReference, error) {
+	invalid := fmt.Errorf("invalid reference %q, should be in namespace/schema@version form", value)
+	path, versionString, ok := strings.Cut(value, "@")
+	if !ok {
+		return nil, invalid
+	}
+	namespaceID, schemaID, ok := strings.Cut(path, "/")
+	if !ok || namespaceID == "" || schemaID == "" {
+		return nil, invalid
+	}
+	version, err := strconv.ParseInt(versionString, 10, 32)
+	if err != nil || version <= 0 {
+		return nil, invalid
+	}
+	return &stencilv1beta1.SchemaReference{NamespaceId: namespaceID, SchemaId: schemaID, Version: int32(version)}, nil
+}
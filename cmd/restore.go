@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/salt/cli/printer"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"github.com/spf13/cobra"
+)
+
+func restoreSchemaCmd(cdk *CDK) *cobra.Command {
+	var namespaceID string
+	var req stencilv1beta1.RestoreSchemaRequest
+	var reqVer stencilv1beta1.RestoreVersionRequest
+	var version int32
+
+	cmd := &cobra.Command{
+		Use:   "restore <id>",
+		Short: "Restore a deleted schema",
+		Long:  "Restore a deleted schema or schema version before it is purged",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ stencil schema restore booking -n raystack
+			$ stencil schema restore booking -n raystack -v 2
+	    `),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd, cdk)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			schemaID := args[0]
+
+			if version == 0 {
+				req.NamespaceId = namespaceID
+				req.SchemaId = schemaID
+
+				_, err = client.RestoreSchema(context.Background(), &req)
+				if err != nil {
+					return err
+				}
+			} else {
+				reqVer.NamespaceId = namespaceID
+				reqVer.SchemaId = schemaID
+				reqVer.VersionId = version
+
+				_, err = client.RestoreVersion(context.Background(), &reqVer)
+				if err != nil {
+					return err
+				}
+			}
+
+			spinner.Stop()
+			fmt.Printf("Schema successfully restored")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespaceID, "namespace", "n", "", "Parent namespace ID")
+	cmd.MarkFlagRequired("namespace")
+
+	cmd.Flags().Int32VarP(&version, "version", "v", 0, "Particular version to be restored")
+
+	return cmd
+}
@@ -44,6 +44,8 @@ func New() *cobra.Command {
 	cmd.AddCommand(NamespaceCmd(cdk))
 	cmd.AddCommand(SchemaCmd(cdk))
 	cmd.AddCommand(SearchCmd(cdk))
+	cmd.AddCommand(AuditCmd(cdk))
+	cmd.AddCommand(DeletedCmd(cdk))
 
 	hooks := []commander.HookBehavior{
 		{
@@ -26,15 +26,19 @@ func SchemaCmd(cdk *CDK) *cobra.Command {
 	cmd.AddCommand(printSchemaCmd(cdk))
 	cmd.AddCommand(downloadSchemaCmd(cdk))
 	cmd.AddCommand(checkSchemaCmd(cdk))
+	cmd.AddCommand(lintSchemaCmd(cdk))
 	cmd.AddCommand(editSchemaCmd(cdk))
 	cmd.AddCommand(deleteSchemaCmd(cdk))
+	cmd.AddCommand(restoreSchemaCmd(cdk))
+	cmd.AddCommand(stateSchemaCmd(cdk))
 	cmd.AddCommand(diffSchemaCmd(cdk))
 	cmd.AddCommand(graphSchemaCmd(cdk))
+	cmd.AddCommand(referencesSchemaCmd(cdk))
 
 	return cmd
 }
 
-func fetchSchemaAndMeta(client stencilv1beta1.StencilServiceClient, version int32, namespaceID, schemaID string) ([]byte, *stencilv1beta1.GetSchemaMetadataResponse, error) {
+func fetchSchemaAndMeta(client stencilv1beta1.StencilServiceClient, version int32, namespaceID, schemaID string, assemble bool) ([]byte, *stencilv1beta1.GetSchemaMetadataResponse, error) {
 	var req stencilv1beta1.GetSchemaRequest
 	var reqLatest stencilv1beta1.GetLatestSchemaRequest
 	var data []byte
@@ -45,6 +49,7 @@ func fetchSchemaAndMeta(client stencilv1beta1.StencilServiceClient, version int3
 		req.NamespaceId = namespaceID
 		req.SchemaId = schemaID
 		req.VersionId = version
+		req.Assemble = assemble
 		res, err := client.GetSchema(ctx, &req)
 		if err != nil {
 			return nil, nil, err
@@ -53,6 +58,7 @@ func fetchSchemaAndMeta(client stencilv1beta1.StencilServiceClient, version int3
 	} else {
 		reqLatest.NamespaceId = namespaceID
 		reqLatest.SchemaId = schemaID
+		reqLatest.Assemble = assemble
 		res, err := client.GetLatestSchema(ctx, &reqLatest)
 		if err != nil {
 			return nil, nil, err
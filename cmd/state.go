@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/raystack/salt/cli/printer"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func stateSchemaCmd(cdk *CDK) *cobra.Command {
+	var req stencilv1beta1.UpdateVersionStateRequest
+	var state, sunset string
+
+	cmd := &cobra.Command{
+		Use:   "state <id>",
+		Short: "Set lifecycle state of a schema version",
+		Long: heredoc.Doc(`
+			Set lifecycle state of a schema version to active, deprecated or disabled.
+			Deprecated versions are still served, disabled versions can't be registered again.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ stencil schema state booking -n raystack -v 1 --state deprecated --reason "use version 2" --sunset 2023-01-31
+			$ stencil schema state booking -n raystack -v 1 --state disabled --reason "leaks customer email"
+			$ stencil schema state booking -n raystack -v 1 --state active
+	    `),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stateValue, ok := stencilv1beta1.SchemaVersion_State_value["STATE_"+strings.ToUpper(state)]
+			if !ok || stateValue == 0 {
+				return fmt.Errorf("invalid state %q, should be one of active, deprecated or disabled", state)
+			}
+			req.State = stencilv1beta1.SchemaVersion_State(stateValue)
+			if sunset != "" {
+				sunsetAt, err := time.Parse("2006-01-02", sunset)
+				if err != nil {
+					return fmt.Errorf("invalid sunset date %q, should be in YYYY-MM-DD format", sunset)
+				}
+				req.SunsetAt = timestamppb.New(sunsetAt)
+			}
+
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client, cancel, err := createClient(cmd, cdk)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			req.SchemaId = args[0]
+
+			res, err := client.UpdateVersionState(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("\n%s Version %d of %s is %s.\n", printer.Green(printer.Icon("success")), res.GetVersion().GetVersion(), req.SchemaId,
+				strings.ToLower(strings.TrimPrefix(res.GetVersion().GetState().String(), "STATE_")))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&req.NamespaceId, "namespace", "n", "", "parent namespace ID")
+	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().Int32VarP(&req.VersionId, "version", "v", 0, "version to update")
+	cmd.MarkFlagRequired("version")
+	cmd.Flags().StringVar(&state, "state", "", "lifecycle state, one of active, deprecated or disabled")
+	cmd.MarkFlagRequired("state")
+	cmd.Flags().StringVar(&req.Reason, "reason", "", "reason for the state change")
+	cmd.Flags().StringVar(&sunset, "sunset", "", "date after which deprecated version will be removed, in YYYY-MM-DD format")
+
+	return cmd
+}
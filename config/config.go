@@ -20,6 +20,52 @@ type GRPCConfig struct {
 	MaxSendMsgSizeInMB int `default:"10"`
 }
 
+// WebhookConfig webhook delivery options
+type WebhookConfig struct {
+	// MaxAttempts number of delivery attempts for each event
+	MaxAttempts int `default:"5"`
+	// Backoff delay before first retry, doubled for every retry
+	Backoff time.Duration `default:"1s"`
+	// MaxBackoff upper limit of delay between retries
+	MaxBackoff time.Duration `default:"10m"`
+	// Timeout for each delivery request
+	Timeout time.Duration `default:"10s"`
+	// PollInterval interval at which pending deliveries are checked, deliveries queued by this instance are sent right away
+	PollInterval time.Duration `default:"5s"`
+	// SecretKey used to encrypt webhook secrets, webhooks can not be created if it is empty
+	SecretKey string
+}
+
+// RetentionConfig options for deleted namespaces, schemas and versions
+type RetentionConfig struct {
+	// Period deleted items are kept for, they can be restored within this period
+	Period time.Duration `default:"720h"`
+	// PurgeInterval interval at which items deleted longer than retention period are purged
+	PurgeInterval time.Duration `default:"1h"`
+}
+
+// JWTConfig options to validate JWTs issued by OIDC provider
+type JWTConfig struct {
+	// JWKSFile path to JSON Web Key Set used to verify token signatures. JWT authentication is disabled if empty.
+	JWKSFile string
+	// Issuer expected iss claim, not checked if empty
+	Issuer string
+	// Audience expected in aud claim, not checked if empty
+	Audience string
+	// PrincipalClaim claim used as principal name
+	PrincipalClaim string `default:"sub"`
+}
+
+// AuthConfig authentication and authorization options
+type AuthConfig struct {
+	Enabled bool `default:"false"`
+	// Tokens static API tokens mapped to principal names
+	Tokens map[string]string
+	// Admins principals having admin role on every namespace
+	Admins []string
+	JWT    JWTConfig
+}
+
 // Config Server config
 type Config struct {
 	Port string `default:"8080"`
@@ -29,4 +75,7 @@ type Config struct {
 	GRPC          GRPCConfig
 	NewRelic      NewRelicConfig
 	DB            DBConfig
+	Webhook       WebhookConfig
+	Retention     RetentionConfig
+	Auth          AuthConfig
 }
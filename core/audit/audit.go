@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+// Operation kind of mutation recorded in audit log
+type Operation string
+
+const (
+	OperationCreateNamespace      Operation = "CREATE_NAMESPACE"
+	OperationUpdateNamespace      Operation = "UPDATE_NAMESPACE"
+	OperationDeleteNamespace      Operation = "DELETE_NAMESPACE"
+	OperationCreateVersion        Operation = "CREATE_VERSION"
+	OperationUpdateSchemaMetadata Operation = "UPDATE_SCHEMA_METADATA"
+	OperationDeleteSchema         Operation = "DELETE_SCHEMA"
+	OperationDeleteVersion        Operation = "DELETE_VERSION"
+	OperationUpdateVersionState   Operation = "UPDATE_VERSION_STATE"
+	OperationRestoreNamespace     Operation = "RESTORE_NAMESPACE"
+	OperationRestoreSchema        Operation = "RESTORE_SCHEMA"
+	OperationRestoreVersion       Operation = "RESTORE_VERSION"
+	OperationPurgeNamespace       Operation = "PURGE_NAMESPACE"
+	OperationPurgeSchema          Operation = "PURGE_SCHEMA"
+	OperationPurgeVersion         Operation = "PURGE_VERSION"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+// Event is an append-only record of a mutation
+type Event struct {
+	ID          int64
+	Actor       string
+	RequestID   string
+	Operation   Operation
+	NamespaceID string
+	SchemaName  string
+	Version     int32
+	// Before JSON encoded state of the resource before the mutation, nil for creates
+	Before []byte
+	// After JSON encoded state of the resource after the mutation, nil for deletes
+	After     []byte
+	CreatedAt time.Time
+}
+
+// Filter selects page of audit events, latest first
+type Filter struct {
+	NamespaceID string
+	SchemaName  string
+	PageSize    int
+	// BeforeID only events older than this ID are returned if set
+	BeforeID int64
+}
+
+type Repository interface {
+	List(ctx context.Context, filter Filter) ([]Event, error)
+}
@@ -0,0 +1,54 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	audit "github.com/raystack/stencil/core/audit"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditRepository is an autogenerated mock type for the Repository type
+type AuditRepository struct {
+	mock.Mock
+}
+
+// List provides a mock function with given fields: ctx, filter
+func (_m *AuditRepository) List(ctx context.Context, filter audit.Filter) ([]audit.Event, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []audit.Event
+	if rf, ok := ret.Get(0).(func(context.Context, audit.Filter) []audit.Event); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]audit.Event)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, audit.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewAuditRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAuditRepository creates a new instance of AuditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAuditRepository(t mockConstructorTestingTNewAuditRepository) *AuditRepository {
+	mock := &AuditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
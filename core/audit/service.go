@@ -0,0 +1,51 @@
+package audit
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrInvalidPageToken = status.Error(codes.InvalidArgument, "invalid page token")
+
+type Service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+// List returns page of audit events, latest first, along with token to fetch next page.
+// Next page token is empty if there are no more events.
+func (s *Service) List(ctx context.Context, namespaceID, schemaName string, pageSize int, pageToken string) ([]Event, string, error) {
+	filter := Filter{NamespaceID: namespaceID, SchemaName: schemaName, PageSize: pageSize}
+	if filter.PageSize <= 0 {
+		filter.PageSize = defaultPageSize
+	}
+	if filter.PageSize > maxPageSize {
+		filter.PageSize = maxPageSize
+	}
+	if pageToken != "" {
+		beforeID, err := strconv.ParseInt(pageToken, 10, 64)
+		if err != nil || beforeID <= 0 {
+			return nil, "", ErrInvalidPageToken
+		}
+		filter.BeforeID = beforeID
+	}
+	// fetch one extra event to know whether next page exists
+	filter.PageSize++
+	events, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(events) < filter.PageSize {
+		return events, "", nil
+	}
+	events = events[:filter.PageSize-1]
+	return events, strconv.FormatInt(events[len(events)-1].ID, 10), nil
+}
@@ -0,0 +1,45 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/stencil/core/audit"
+	"github.com/raystack/stencil/core/audit/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should return next page token if more events exist", func(t *testing.T) {
+		repo := &mocks.AuditRepository{}
+		svc := audit.NewService(repo)
+		repo.On("List", ctx, audit.Filter{NamespaceID: "ns", PageSize: 3}).Return([]audit.Event{{ID: 9}, {ID: 7}, {ID: 4}}, nil)
+		events, next, err := svc.List(ctx, "ns", "", 2, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []audit.Event{{ID: 9}, {ID: 7}}, events)
+		assert.Equal(t, "7", next)
+	})
+	t.Run("should return empty token on last page", func(t *testing.T) {
+		repo := &mocks.AuditRepository{}
+		svc := audit.NewService(repo)
+		repo.On("List", ctx, audit.Filter{NamespaceID: "ns", SchemaName: "sc", PageSize: 3, BeforeID: 7}).Return([]audit.Event{{ID: 4}}, nil)
+		events, next, err := svc.List(ctx, "ns", "sc", 2, "7")
+		assert.NoError(t, err)
+		assert.Equal(t, []audit.Event{{ID: 4}}, events)
+		assert.Empty(t, next)
+	})
+	t.Run("should use default page size", func(t *testing.T) {
+		repo := &mocks.AuditRepository{}
+		svc := audit.NewService(repo)
+		repo.On("List", ctx, audit.Filter{PageSize: 51}).Return(nil, nil)
+		_, _, err := svc.List(ctx, "", "", 0, "")
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+	t.Run("should validate page token", func(t *testing.T) {
+		svc := audit.NewService(&mocks.AuditRepository{})
+		_, _, err := svc.List(ctx, "ns", "", 2, "abc")
+		assert.ErrorIs(t, err, audit.ErrInvalidPageToken)
+	})
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// Role grants set of permissions on a namespace. Each role includes permissions of roles ranked below it.
+type Role string
+
+const (
+	// RoleReader can read schemas and namespace
+	RoleReader Role = "ROLE_READER"
+	// RoleWriter can additionally upload schemas, update schema metadata and delete versions
+	RoleWriter Role = "ROLE_WRITER"
+	// RoleAdmin can additionally delete schemas, manage namespace, webhooks and role bindings
+	RoleAdmin Role = "ROLE_ADMIN"
+)
+
+var roleRanks = map[Role]int{
+	RoleReader: 1,
+	RoleWriter: 2,
+	RoleAdmin:  3,
+}
+
+// IsValid reports whether r is one of known roles
+func (r Role) IsValid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// Includes reports whether r grants all permissions of required role
+func (r Role) Includes(required Role) bool {
+	return r.IsValid() && roleRanks[r] >= roleRanks[required]
+}
+
+// RoleBinding assigns role on a namespace to a principal
+type RoleBinding struct {
+	NamespaceID string
+	Principal   string
+	Role        Role
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Authenticator resolves principal identified by bearer token
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (string, error)
+}
+
+type Repository interface {
+	Upsert(ctx context.Context, binding RoleBinding) (RoleBinding, error)
+	Get(ctx context.Context, namespaceID, principal string) (RoleBinding, error)
+	List(ctx context.Context, namespaceID string) ([]RoleBinding, error)
+	ListByPrincipal(ctx context.Context, principal string) ([]RoleBinding, error)
+	Delete(ctx context.Context, namespaceID, principal string) error
+}
@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+)
+
+// StaticTokens authenticates API tokens configured on the server, mapping each token to its principal
+type StaticTokens map[string]string
+
+func (s StaticTokens) Authenticate(ctx context.Context, token string) (string, error) {
+	for t, principal := range s {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return principal, nil
+		}
+	}
+	return "", ErrUnauthenticated
+}
+
+// Authenticators tries each authenticator in order and returns the first resolved principal
+type Authenticators []Authenticator
+
+func (a Authenticators) Authenticate(ctx context.Context, token string) (string, error) {
+	for _, authenticator := range a {
+		if principal, err := authenticator.Authenticate(ctx, token); err == nil {
+			return principal, nil
+		}
+	}
+	return "", ErrUnauthenticated
+}
@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+// clockSkew tolerated while validating token expiry
+const clockSkew = time.Minute
+
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// JWTConfig options to validate tokens issued by OIDC provider
+type JWTConfig struct {
+	// JWKSFile path to JSON Web Key Set used to verify token signatures
+	JWKSFile string
+	// Issuer expected iss claim, not checked if empty
+	Issuer string
+	// Audience expected to be present in aud claim, not checked if empty
+	Audience string
+	// PrincipalClaim claim used as principal name
+	PrincipalClaim string
+}
+
+// JWTAuthenticator authenticates JWTs signed by keys from local JWKS file
+type JWTAuthenticator struct {
+	keys   jose.JSONWebKeySet
+	config JWTConfig
+}
+
+func NewJWTAuthenticator(config JWTConfig) (*JWTAuthenticator, error) {
+	data, err := os.ReadFile(config.JWKSFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jwks file. %w", err)
+	}
+	a := &JWTAuthenticator{config: config}
+	if err := json.Unmarshal(data, &a.keys); err != nil {
+		return nil, fmt.Errorf("invalid jwks file. %w", err)
+	}
+	if a.config.PrincipalClaim == "" {
+		a.config.PrincipalClaim = "sub"
+	}
+	return a, nil
+}
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string) (string, error) {
+	tok, err := jwt.ParseSigned(token, signatureAlgorithms)
+	if err != nil || len(tok.Headers) == 0 {
+		return "", ErrUnauthenticated
+	}
+	for _, key := range a.keys.Key(tok.Headers[0].KeyID) {
+		claims := jwt.Claims{}
+		custom := map[string]interface{}{}
+		if err := tok.Claims(key.Public(), &claims, &custom); err != nil {
+			continue
+		}
+		expected := jwt.Expected{Issuer: a.config.Issuer, Time: time.Now()}
+		if a.config.Audience != "" {
+			expected.AnyAudience = jwt.Audience{a.config.Audience}
+		}
+		if err := claims.ValidateWithLeeway(expected, clockSkew); err != nil {
+			return "", ErrUnauthenticated
+		}
+		principal, _ := custom[a.config.PrincipalClaim].(string)
+		if principal == "" {
+			return "", ErrUnauthenticated
+		}
+		return principal, nil
+	}
+	return "", ErrUnauthenticated
+}
@@ -0,0 +1,89 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/raystack/stencil/core/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims interface{}) string {
+	t.Helper()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, (&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", kid))
+	assert.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	assert.NoError(t, err)
+	return token
+}
+
+func writeJWKS(t *testing.T, key *rsa.PrivateKey, kid string) string {
+	t.Helper()
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: key.Public(), KeyID: kid, Algorithm: string(jose.RS256), Use: "sig"}}}
+	data, err := json.Marshal(jwks)
+	assert.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NoError(t, os.WriteFile(path, data, 0600))
+	return path
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	ctx := context.Background()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	authenticator, err := auth.NewJWTAuthenticator(auth.JWTConfig{
+		JWKSFile:       writeJWKS(t, key, "key1"),
+		Issuer:         "https://issuer.example.com",
+		Audience:       "stencil",
+		PrincipalClaim: "email",
+	})
+	assert.NoError(t, err)
+	claims := func(exp time.Time) map[string]interface{} {
+		return map[string]interface{}{
+			"iss":   "https://issuer.example.com",
+			"aud":   []string{"stencil"},
+			"sub":   "1234",
+			"email": "user@example.com",
+			"exp":   exp.Unix(),
+		}
+	}
+	t.Run("should return principal from configured claim", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(ctx, signToken(t, key, "key1", claims(time.Now().Add(time.Hour))))
+		assert.NoError(t, err)
+		assert.Equal(t, "user@example.com", principal)
+	})
+	t.Run("should reject expired token", func(t *testing.T) {
+		_, err := authenticator.Authenticate(ctx, signToken(t, key, "key1", claims(time.Now().Add(-time.Hour))))
+		assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+	})
+	t.Run("should reject token signed by unknown key", func(t *testing.T) {
+		_, err := authenticator.Authenticate(ctx, signToken(t, otherKey, "key1", claims(time.Now().Add(time.Hour))))
+		assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+	})
+	t.Run("should reject token for other audience", func(t *testing.T) {
+		c := claims(time.Now().Add(time.Hour))
+		c["aud"] = []string{"other"}
+		_, err := authenticator.Authenticate(ctx, signToken(t, key, "key1", c))
+		assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+	})
+	t.Run("should reject malformed token", func(t *testing.T) {
+		_, err := authenticator.Authenticate(ctx, "token1")
+		assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+	})
+	t.Run("should fall back to next authenticator", func(t *testing.T) {
+		chain := auth.Authenticators{authenticator, auth.StaticTokens{"token1": "user1"}}
+		principal, err := chain.Authenticate(ctx, "token1")
+		assert.NoError(t, err)
+		assert.Equal(t, "user1", principal)
+	})
+}
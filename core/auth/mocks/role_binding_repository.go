@@ -0,0 +1,133 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	auth "github.com/raystack/stencil/core/auth"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RoleBindingRepository is an autogenerated mock type for the Repository type
+type RoleBindingRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, namespaceID, principal
+func (_m *RoleBindingRepository) Delete(ctx context.Context, namespaceID string, principal string) error {
+	ret := _m.Called(ctx, namespaceID, principal)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespaceID, principal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, namespaceID, principal
+func (_m *RoleBindingRepository) Get(ctx context.Context, namespaceID string, principal string) (auth.RoleBinding, error) {
+	ret := _m.Called(ctx, namespaceID, principal)
+
+	var r0 auth.RoleBinding
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) auth.RoleBinding); ok {
+		r0 = rf(ctx, namespaceID, principal)
+	} else {
+		r0 = ret.Get(0).(auth.RoleBinding)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespaceID, principal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, namespaceID
+func (_m *RoleBindingRepository) List(ctx context.Context, namespaceID string) ([]auth.RoleBinding, error) {
+	ret := _m.Called(ctx, namespaceID)
+
+	var r0 []auth.RoleBinding
+	if rf, ok := ret.Get(0).(func(context.Context, string) []auth.RoleBinding); ok {
+		r0 = rf(ctx, namespaceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]auth.RoleBinding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespaceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByPrincipal provides a mock function with given fields: ctx, principal
+func (_m *RoleBindingRepository) ListByPrincipal(ctx context.Context, principal string) ([]auth.RoleBinding, error) {
+	ret := _m.Called(ctx, principal)
+
+	var r0 []auth.RoleBinding
+	if rf, ok := ret.Get(0).(func(context.Context, string) []auth.RoleBinding); ok {
+		r0 = rf(ctx, principal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]auth.RoleBinding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, principal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: ctx, binding
+func (_m *RoleBindingRepository) Upsert(ctx context.Context, binding auth.RoleBinding) (auth.RoleBinding, error) {
+	ret := _m.Called(ctx, binding)
+
+	var r0 auth.RoleBinding
+	if rf, ok := ret.Get(0).(func(context.Context, auth.RoleBinding) auth.RoleBinding); ok {
+		r0 = rf(ctx, binding)
+	} else {
+		r0 = ret.Get(0).(auth.RoleBinding)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, auth.RoleBinding) error); ok {
+		r1 = rf(ctx, binding)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRoleBindingRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRoleBindingRepository creates a new instance of RoleBindingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRoleBindingRepository(t mockConstructorTestingTNewRoleBindingRepository) *RoleBindingRepository {
+	mock := &RoleBindingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/raystack/stencil/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrUnauthenticated  = status.Error(codes.Unauthenticated, "missing or invalid credentials")
+	ErrPermissionDenied = status.Error(codes.PermissionDenied, "caller does not have required role on the namespace")
+	ErrInvalidRole      = status.Error(codes.InvalidArgument, "unknown role")
+	ErrEmptyPrincipal   = status.Error(codes.InvalidArgument, "principal cannot be empty")
+)
+
+type Service struct {
+	authenticator Authenticator
+	repo          Repository
+	admins        map[string]bool
+}
+
+// NewService creates auth service. Admins have admin role on every namespace,
+// they are the only principals allowed to create namespaces and search across namespaces.
+func NewService(authenticator Authenticator, repo Repository, admins []string) *Service {
+	s := &Service{
+		authenticator: authenticator,
+		repo:          repo,
+		admins:        make(map[string]bool),
+	}
+	for _, admin := range admins {
+		s.admins[admin] = true
+	}
+	return s
+}
+
+// Authenticate returns principal identified by bearer token
+func (s *Service) Authenticate(ctx context.Context, token string) (string, error) {
+	if token == "" {
+		return "", ErrUnauthenticated
+	}
+	return s.authenticator.Authenticate(ctx, token)
+}
+
+// Authorize checks principal has at least required role on the namespace.
+// Empty namespace refers to server wide operations, which are allowed only for admins.
+func (s *Service) Authorize(ctx context.Context, principal, namespaceID string, required Role) error {
+	if s.admins[principal] {
+		return nil
+	}
+	if namespaceID == "" {
+		return ErrPermissionDenied
+	}
+	binding, err := s.repo.Get(ctx, namespaceID, principal)
+	if err != nil {
+		if errors.Is(err, store.NoRowsErr) {
+			return ErrPermissionDenied
+		}
+		return err
+	}
+	if !binding.Role.Includes(required) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// AuthorizedNamespaces returns IDs of namespaces principal has at least required role on.
+// all is true for admins, who have every role on all namespaces.
+func (s *Service) AuthorizedNamespaces(ctx context.Context, principal string, required Role) (namespaceIDs map[string]bool, all bool, err error) {
+	if s.admins[principal] {
+		return nil, true, nil
+	}
+	bindings, err := s.repo.ListByPrincipal(ctx, principal)
+	if err != nil {
+		return nil, false, err
+	}
+	namespaceIDs = make(map[string]bool)
+	for _, binding := range bindings {
+		if binding.Role.Includes(required) {
+			namespaceIDs[binding.NamespaceID] = true
+		}
+	}
+	return namespaceIDs, false, nil
+}
+
+func (s *Service) Grant(ctx context.Context, binding RoleBinding) (RoleBinding, error) {
+	if binding.Principal == "" {
+		return RoleBinding{}, ErrEmptyPrincipal
+	}
+	if !binding.Role.IsValid() {
+		return RoleBinding{}, ErrInvalidRole
+	}
+	return s.repo.Upsert(ctx, binding)
+}
+
+func (s *Service) Revoke(ctx context.Context, namespaceID, principal string) error {
+	return s.repo.Delete(ctx, namespaceID, principal)
+}
+
+func (s *Service) List(ctx context.Context, namespaceID string) ([]RoleBinding, error) {
+	return s.repo.List(ctx, namespaceID)
+}
@@ -0,0 +1,120 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/raystack/stencil/core/auth"
+	"github.com/raystack/stencil/core/auth/mocks"
+	"github.com/raystack/stencil/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getSvc() (*auth.Service, *mocks.RoleBindingRepository) {
+	repo := &mocks.RoleBindingRepository{}
+	svc := auth.NewService(auth.StaticTokens{"token1": "user1"}, repo, []string{"admin"})
+	return svc, repo
+}
+
+func TestRoleIncludes(t *testing.T) {
+	assert.True(t, auth.RoleAdmin.Includes(auth.RoleWriter))
+	assert.True(t, auth.RoleWriter.Includes(auth.RoleWriter))
+	assert.False(t, auth.RoleReader.Includes(auth.RoleWriter))
+	assert.False(t, auth.Role("ROLE_OWNER").Includes(auth.RoleReader))
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := getSvc()
+	t.Run("should return principal of the token", func(t *testing.T) {
+		principal, err := svc.Authenticate(ctx, "token1")
+		assert.NoError(t, err)
+		assert.Equal(t, "user1", principal)
+	})
+	t.Run("should return unauthenticated error for unknown or empty token", func(t *testing.T) {
+		_, err := svc.Authenticate(ctx, "token2")
+		assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+		_, err = svc.Authenticate(ctx, "")
+		assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+	})
+}
+
+func TestAuthorize(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should allow admins on every namespace", func(t *testing.T) {
+		svc, repo := getSvc()
+		assert.NoError(t, svc.Authorize(ctx, "admin", "ns", auth.RoleAdmin))
+		assert.NoError(t, svc.Authorize(ctx, "admin", "", auth.RoleAdmin))
+		repo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("should deny server wide operations for non admins", func(t *testing.T) {
+		svc, _ := getSvc()
+		assert.ErrorIs(t, svc.Authorize(ctx, "user1", "", auth.RoleReader), auth.ErrPermissionDenied)
+	})
+	t.Run("should check role bound on the namespace", func(t *testing.T) {
+		svc, repo := getSvc()
+		repo.On("Get", mock.Anything, "ns", "user1").Return(auth.RoleBinding{NamespaceID: "ns", Principal: "user1", Role: auth.RoleWriter}, nil)
+		assert.NoError(t, svc.Authorize(ctx, "user1", "ns", auth.RoleReader))
+		assert.NoError(t, svc.Authorize(ctx, "user1", "ns", auth.RoleWriter))
+		assert.ErrorIs(t, svc.Authorize(ctx, "user1", "ns", auth.RoleAdmin), auth.ErrPermissionDenied)
+	})
+	t.Run("should deny if principal has no role on the namespace", func(t *testing.T) {
+		svc, repo := getSvc()
+		repo.On("Get", mock.Anything, "ns", "user1").Return(auth.RoleBinding{}, store.NoRowsErr.WithErr(errors.New("no rows"), "role binding"))
+		assert.ErrorIs(t, svc.Authorize(ctx, "user1", "ns", auth.RoleReader), auth.ErrPermissionDenied)
+	})
+	t.Run("should return repository errors", func(t *testing.T) {
+		svc, repo := getSvc()
+		repo.On("Get", mock.Anything, "ns", "user1").Return(auth.RoleBinding{}, errors.New("db error"))
+		assert.EqualError(t, svc.Authorize(ctx, "user1", "ns", auth.RoleReader), "db error")
+	})
+}
+
+func TestAuthorizedNamespaces(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should return all namespaces for admins", func(t *testing.T) {
+		svc, repo := getSvc()
+		_, all, err := svc.AuthorizedNamespaces(ctx, "admin", auth.RoleReader)
+		assert.NoError(t, err)
+		assert.True(t, all)
+		repo.AssertNotCalled(t, "ListByPrincipal", mock.Anything, mock.Anything)
+	})
+	t.Run("should return namespaces principal has required role on", func(t *testing.T) {
+		svc, repo := getSvc()
+		repo.On("ListByPrincipal", mock.Anything, "user1").Return([]auth.RoleBinding{
+			{NamespaceID: "ns1", Principal: "user1", Role: auth.RoleReader},
+			{NamespaceID: "ns2", Principal: "user1", Role: auth.RoleAdmin},
+		}, nil)
+		namespaces, all, err := svc.AuthorizedNamespaces(ctx, "user1", auth.RoleWriter)
+		assert.NoError(t, err)
+		assert.False(t, all)
+		assert.Equal(t, map[string]bool{"ns2": true}, namespaces)
+	})
+	t.Run("should return repository errors", func(t *testing.T) {
+		svc, repo := getSvc()
+		repo.On("ListByPrincipal", mock.Anything, "user1").Return(nil, errors.New("db error"))
+		_, _, err := svc.AuthorizedNamespaces(ctx, "user1", auth.RoleReader)
+		assert.EqualError(t, err, "db error")
+	})
+}
+
+func TestGrant(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should validate role and principal", func(t *testing.T) {
+		svc, _ := getSvc()
+		_, err := svc.Grant(ctx, auth.RoleBinding{NamespaceID: "ns", Principal: "user1", Role: "ROLE_OWNER"})
+		assert.ErrorIs(t, err, auth.ErrInvalidRole)
+		_, err = svc.Grant(ctx, auth.RoleBinding{NamespaceID: "ns", Role: auth.RoleReader})
+		assert.ErrorIs(t, err, auth.ErrEmptyPrincipal)
+	})
+	t.Run("should store role binding", func(t *testing.T) {
+		svc, repo := getSvc()
+		binding := auth.RoleBinding{NamespaceID: "ns", Principal: "user1", Role: auth.RoleReader}
+		repo.On("Upsert", mock.Anything, binding).Return(binding, nil)
+		got, err := svc.Grant(ctx, binding)
+		assert.NoError(t, err)
+		assert.Equal(t, binding, got)
+	})
+}
@@ -5,13 +5,31 @@ import (
 	"time"
 )
 
+// Lint rule sets, each set includes rules of the previous one
+const (
+	LintRuleSetMinimal = "MINIMAL"
+	LintRuleSetBasic   = "BASIC"
+	LintRuleSetDefault = "DEFAULT"
+)
+
+// Lint rule severities. Schemas failing rules with error severity are refused.
+const (
+	LintSeverityError   = "ERROR"
+	LintSeverityWarning = "WARNING"
+	LintSeverityOff     = "OFF"
+)
+
 type Namespace struct {
 	ID            string
 	Format        string
 	Compatibility string
 	Description   string
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	// LintRuleSet is set of lint rules run on schemas of the namespace, lint is disabled if empty
+	LintRuleSet string
+	// LintRules overrides severity of individual lint rules
+	LintRules map[string]string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type Repository interface {
@@ -20,4 +38,5 @@ type Repository interface {
 	List(context.Context) ([]Namespace, error)
 	Get(context.Context, string) (Namespace, error)
 	Delete(context.Context, string) error
+	Restore(context.Context, string) (Namespace, error)
 }
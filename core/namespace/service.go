@@ -2,6 +2,9 @@ package namespace
 
 import (
 	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Service struct {
@@ -15,13 +18,35 @@ func NewService(repository Repository) *Service {
 }
 
 func (s Service) Create(ctx context.Context, ns Namespace) (Namespace, error) {
+	if err := validateLint(ns); err != nil {
+		return Namespace{}, err
+	}
 	return s.repo.Create(ctx, ns)
 }
 
 func (s Service) Update(ctx context.Context, ns Namespace) (Namespace, error) {
+	if err := validateLint(ns); err != nil {
+		return Namespace{}, err
+	}
 	return s.repo.Update(ctx, ns)
 }
 
+func validateLint(ns Namespace) error {
+	switch ns.LintRuleSet {
+	case "", LintRuleSetMinimal, LintRuleSetBasic, LintRuleSetDefault:
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid lint rule set %q, should be one of MINIMAL, BASIC or DEFAULT", ns.LintRuleSet)
+	}
+	for rule, severity := range ns.LintRules {
+		switch severity {
+		case LintSeverityError, LintSeverityWarning, LintSeverityOff:
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid severity %q of lint rule %s, should be one of ERROR, WARNING or OFF", severity, rule)
+		}
+	}
+	return nil
+}
+
 func (s Service) List(ctx context.Context) ([]Namespace, error) {
 	return s.repo.List(ctx)
 }
@@ -33,3 +58,7 @@ func (s Service) Get(ctx context.Context, name string) (Namespace, error) {
 func (s Service) Delete(ctx context.Context, name string) error {
 	return s.repo.Delete(ctx, name)
 }
+
+func (s Service) Restore(ctx context.Context, name string) (Namespace, error) {
+	return s.repo.Restore(ctx, name)
+}
@@ -0,0 +1,76 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	retention "github.com/raystack/stencil/core/retention"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RetentionRepository is an autogenerated mock type for the Repository type
+type RetentionRepository struct {
+	mock.Mock
+}
+
+// ListDeleted provides a mock function with given fields: ctx, namespaceID
+func (_m *RetentionRepository) ListDeleted(ctx context.Context, namespaceID string) ([]retention.Item, error) {
+	ret := _m.Called(ctx, namespaceID)
+
+	var r0 []retention.Item
+	if rf, ok := ret.Get(0).(func(context.Context, string) []retention.Item); ok {
+		r0 = rf(ctx, namespaceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]retention.Item)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespaceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Purge provides a mock function with given fields: ctx, olderThan
+func (_m *RetentionRepository) Purge(ctx context.Context, olderThan time.Duration) (int, error) {
+	ret := _m.Called(ctx, olderThan)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) int); ok {
+		r0 = rf(ctx, olderThan)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, olderThan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRetentionRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRetentionRepository creates a new instance of RetentionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRetentionRepository(t mockConstructorTestingTNewRetentionRepository) *RetentionRepository {
+	mock := &RetentionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
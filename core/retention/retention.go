@@ -0,0 +1,33 @@
+package retention
+
+import (
+	"context"
+	"time"
+)
+
+// Kind of deleted item
+type Kind string
+
+const (
+	KindNamespace Kind = "NAMESPACE"
+	KindSchema    Kind = "SCHEMA"
+	KindVersion   Kind = "VERSION"
+)
+
+// Item is namespace, schema or schema version that is deleted but not purged yet.
+// Items deleted along with their parent are not listed separately, they are restored along with the parent.
+type Item struct {
+	Kind        Kind
+	NamespaceID string
+	SchemaName  string
+	Version     int32
+	DeletedAt   time.Time
+	// PurgeAt time after which the item is permanently deleted
+	PurgeAt time.Time `db:"-"`
+}
+
+type Repository interface {
+	ListDeleted(ctx context.Context, namespaceID string) ([]Item, error)
+	// Purge permanently deletes items deleted longer than olderThan ago and returns number of purged items
+	Purge(ctx context.Context, olderThan time.Duration) (int, error)
+}
@@ -0,0 +1,61 @@
+package retention
+
+import (
+	"context"
+	"time"
+
+	"github.com/raystack/stencil/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type Service struct {
+	repo   Repository
+	period time.Duration
+}
+
+// NewService creates service for deleted items, which are retained for given period before being purged
+func NewService(repo Repository, period time.Duration) *Service {
+	return &Service{
+		repo:   repo,
+		period: period,
+	}
+}
+
+// List returns deleted items of the namespace along with time they will be purged at, latest deletion first.
+// Items of all namespaces are returned if namespaceID is empty.
+func (s *Service) List(ctx context.Context, namespaceID string) ([]Item, error) {
+	items, err := s.repo.ListDeleted(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].PurgeAt = items[i].DeletedAt.Add(s.period)
+	}
+	return items, nil
+}
+
+// Purge permanently deletes items whose retention period is over
+func (s *Service) Purge(ctx context.Context) (int, error) {
+	return s.repo.Purge(ctx, s.period)
+}
+
+// RunPurger purges expired items every interval until ctx is done
+func (s *Service) RunPurger(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := s.Purge(ctx)
+			if err != nil {
+				logger.Logger.Error("failed to purge deleted items", zap.Error(err))
+				continue
+			}
+			if count > 0 {
+				logger.Logger.Info("purged deleted items", zap.Int("count", count))
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package retention_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/raystack/stencil/core/retention"
+	"github.com/raystack/stencil/core/retention/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should set purge time of items", func(t *testing.T) {
+		repo := &mocks.RetentionRepository{}
+		svc := retention.NewService(repo, 24*time.Hour)
+		deletedAt := time.Date(2022, 3, 14, 10, 0, 0, 0, time.UTC)
+		repo.On("ListDeleted", ctx, "ns").Return([]retention.Item{{Kind: retention.KindSchema, NamespaceID: "ns", SchemaName: "sc", DeletedAt: deletedAt}}, nil)
+		items, err := svc.List(ctx, "ns")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(items))
+		assert.Equal(t, deletedAt.Add(24*time.Hour), items[0].PurgeAt)
+	})
+	t.Run("should return error if list fails", func(t *testing.T) {
+		repo := &mocks.RetentionRepository{}
+		svc := retention.NewService(repo, time.Hour)
+		repo.On("ListDeleted", ctx, "").Return(nil, errors.New("list error"))
+		_, err := svc.List(ctx, "")
+		assert.Error(t, err)
+	})
+}
+
+func TestPurge(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should purge items deleted before retention period", func(t *testing.T) {
+		repo := &mocks.RetentionRepository{}
+		svc := retention.NewService(repo, 24*time.Hour)
+		repo.On("Purge", ctx, 24*time.Hour).Return(3, nil)
+		count, err := svc.Purge(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestRunPurger(t *testing.T) {
+	t.Run("should purge periodically until context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		repo := &mocks.RetentionRepository{}
+		svc := retention.NewService(repo, time.Hour)
+		purged := make(chan struct{}, 1)
+		repo.On("Purge", ctx, mock.Anything).Return(0, nil).Run(func(args mock.Arguments) {
+			select {
+			case purged <- struct{}{}:
+			default:
+			}
+		})
+		done := make(chan struct{})
+		go func() {
+			svc.RunPurger(ctx, 10*time.Millisecond)
+			close(done)
+		}()
+		select {
+		case <-purged:
+		case <-time.After(time.Second):
+			t.Fatal("purge was not run")
+		}
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("purger did not stop")
+		}
+	})
+}
@@ -1,6 +1,205 @@
 package schema
 
-import "go.uber.org/multierr"
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Violation describes a single change rejected by compatibility check
+type Violation struct {
+	Kind          string `json:"kind"`
+	Path          string `json:"path"`
+	Message       string `json:"message"`
+	OldValue      string `json:"old_value"`
+	NewValue      string `json:"new_value"`
+	Compatibility string `json:"compatibility"`
+}
+
+// ViolationReporter implemented by format specific compatibility errors which can list each rejected change
+type ViolationReporter interface {
+	Violations() []Violation
+}
+
+// ViolationCollector collects changes rejected by compatibility check of a schema format, K is the format specific kind of change.
+// Formats embed it in their compatibility error and return the error once any violation is collected.
+type ViolationCollector[K fmt.Stringer] struct {
+	kinds      []K
+	violations []Violation
+}
+
+// Add records rejected change of kind at path, msg is complete message of the violation
+func (c *ViolationCollector[K]) Add(kind K, path, msg, oldValue, newValue string) {
+	c.kinds = append(c.kinds, kind)
+	c.violations = append(c.violations, Violation{
+		Kind:     kind.String(),
+		Path:     path,
+		Message:  msg,
+		OldValue: oldValue,
+		NewValue: newValue,
+	})
+}
+
+// Kinds returns kinds of collected violations in the order they are added
+func (c *ViolationCollector[K]) Kinds() []K {
+	return c.kinds
+}
+
+func (c *ViolationCollector[K]) IsEmpty() bool {
+	return len(c.violations) == 0
+}
+
+// Violations returns each rejected change along with its path
+func (c *ViolationCollector[K]) Violations() []Violation {
+	return c.violations
+}
+
+func (c *ViolationCollector[K]) Error() string {
+	msgs := make([]string, 0, len(c.violations))
+	for _, v := range c.violations {
+		msgs = append(msgs, v.Message)
+	}
+	return strings.Join(msgs, ";")
+}
+
+func (c *ViolationCollector[K]) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, c.Error())
+}
+
+// DiffKind is format specific kind of change found while comparing schemas
+type DiffKind interface {
+	comparable
+	fmt.Stringer
+}
+
+// DiffRecorder records changes found while walking two schemas, msg is complete message of the change.
+// ViolationCollector records them as violations and ChangeSet as changes listed by diff.
+type DiffRecorder[K DiffKind] interface {
+	Add(kind K, path, msg, oldValue, newValue string)
+}
+
+// RejectedChanges passes only changes of kinds in notAllowed on to recorder, used to collect violations of a compatibility mode
+func RejectedChanges[K DiffKind](recorder DiffRecorder[K], notAllowed []K) DiffRecorder[K] {
+	return &rejectedChanges[K]{recorder: recorder, notAllowed: notAllowed}
+}
+
+type rejectedChanges[K DiffKind] struct {
+	recorder   DiffRecorder[K]
+	notAllowed []K
+}
+
+func (r *rejectedChanges[K]) Add(kind K, path, msg, oldValue, newValue string) {
+	if slices.Contains(r.notAllowed, kind) {
+		r.recorder.Add(kind, path, msg, oldValue, newValue)
+	}
+}
+
+// CompatibilityModes lists kinds of changes rejected by each non transitive compatibility mode of a format
+type CompatibilityModes[K DiffKind] struct {
+	Backward []K
+	Forward  []K
+	Full     []K
+}
+
+// BreakingFor returns compatibility modes rejecting changes of kind
+func (m CompatibilityModes[K]) BreakingFor(kind K) []string {
+	var modes []string
+	for _, mode := range []struct {
+		name       string
+		notAllowed []K
+	}{
+		{"COMPATIBILITY_BACKWARD", m.Backward},
+		{"COMPATIBILITY_FORWARD", m.Forward},
+		{"COMPATIBILITY_FULL", m.Full},
+	} {
+		if slices.Contains(mode.notAllowed, kind) {
+			modes = append(modes, mode.name)
+		}
+	}
+	return modes
+}
+
+// ChangeSet records every change found while walking schemas along with compatibility modes rejecting it,
+// unlike ViolationCollector which keeps only rejected changes. Same change recorded again is ignored.
+type ChangeSet[K DiffKind] struct {
+	modes   CompatibilityModes[K]
+	seen    map[string]bool
+	changes []Change
+}
+
+func NewChangeSet[K DiffKind](modes CompatibilityModes[K]) *ChangeSet[K] {
+	return &ChangeSet[K]{modes: modes, seen: map[string]bool{}}
+}
+
+func (c *ChangeSet[K]) Add(kind K, path, msg, oldValue, newValue string) {
+	key := strings.Join([]string{kind.String(), path, msg}, "|")
+	if c.seen[key] {
+		return
+	}
+	c.seen[key] = true
+	c.changes = append(c.changes, Change{
+		Kind:        kind.String(),
+		Path:        path,
+		Message:     msg,
+		OldValue:    oldValue,
+		NewValue:    newValue,
+		BreakingFor: c.modes.BreakingFor(kind),
+	})
+}
+
+// Changes returns recorded changes ordered by path
+func (c *ChangeSet[K]) Changes() []Change {
+	sort.SliceStable(c.changes, func(i, j int) bool {
+		return c.changes[i].Path < c.changes[j].Path
+	})
+	return c.changes
+}
+
+// CompatibilityErr returned when schema fails compatibility check against previous schemas
+type CompatibilityErr struct {
+	Compatibility string
+	Violations    []Violation
+}
+
+func (e *CompatibilityErr) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Message)
+	}
+	return strings.Join(msgs, ";")
+}
+
+// GRPCStatus used by gateway interceptor to return appropriate http status code and message
+func (e *CompatibilityErr) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+func newCompatibilityErr(compatibility string, err error) error {
+	if err == nil {
+		return nil
+	}
+	compErr := &CompatibilityErr{Compatibility: compatibility}
+	for _, e := range multierr.Errors(err) {
+		var violations []Violation
+		var reporter ViolationReporter
+		if errors.As(e, &reporter) {
+			violations = reporter.Violations()
+		} else {
+			violations = []Violation{{Kind: "incompatibleChange", Message: e.Error()}}
+		}
+		for _, v := range violations {
+			v.Compatibility = compatibility
+			compErr.Violations = append(compErr.Violations, v)
+		}
+	}
+	return compErr
+}
 
 type ValidationStrategy func(ParsedSchema, ParsedSchema) error
 type CompatibilityFn func(ParsedSchema, []ParsedSchema) error
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Change describes a single difference between two versions of schema
+type Change struct {
+	Kind     string `json:"kind"`
+	Path     string `json:"path"`
+	Message  string `json:"message"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+	// BreakingFor has compatibility modes rejecting the change, change is safe for modes not listed
+	BreakingFor []string `json:"breaking_for"`
+	// Breaking is set if change is rejected by compatibility mode the diff is computed for
+	Breaking bool `json:"breaking"`
+}
+
+// Differ is implemented by parsed schemas of formats which can list changes made since an earlier schema
+type Differ interface {
+	Diff(against ParsedSchema) ([]Change, error)
+}
+
+// SchemaDiff has changes made between two versions of schema
+type SchemaDiff struct {
+	FromVersion   int32
+	ToVersion     int32
+	Compatibility string
+	Changes       []Change
+}
+
+// Diff returns changes made in toVersion of schema since fromVersion.
+// Changes are marked breaking against given compatibility mode, schema compatibility is used if it is empty.
+func (s *Service) Diff(ctx context.Context, namespace, schemaName string, fromVersion, toVersion int32, compatibility string) (*SchemaDiff, error) {
+	if fromVersion <= 0 || toVersion <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "versions to diff should be greater than 0")
+	}
+	meta, err := s.repo.GetMetadata(ctx, namespace, schemaName)
+	if err != nil {
+		return nil, err
+	}
+	compatibility = getNonEmpty(compatibility, meta.Compatibility)
+	from, err := s.cachedParseSchema(ctx, namespace, schemaName, meta.Format, fromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.cachedParseSchema(ctx, namespace, schemaName, meta.Format, toVersion)
+	if err != nil {
+		return nil, err
+	}
+	differ, ok := to.(Differ)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "diff is not supported for %s", meta.Format)
+	}
+	changes, err := differ.Diff(from)
+	if err != nil {
+		return nil, err
+	}
+	mode := strings.TrimSuffix(compatibility, "_TRANSITIVE")
+	for i := range changes {
+		for _, breakingFor := range changes[i].BreakingFor {
+			if breakingFor == mode {
+				changes[i].Breaking = true
+			}
+		}
+	}
+	return &SchemaDiff{FromVersion: fromVersion, ToVersion: toVersion, Compatibility: compatibility, Changes: changes}, nil
+}
@@ -0,0 +1,110 @@
+package schema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/core/schema/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type diffedSchema struct {
+	*mocks.ParsedSchema
+	changes []schema.Change
+	against schema.ParsedSchema
+}
+
+func (s *diffedSchema) Diff(against schema.ParsedSchema) ([]schema.Change, error) {
+	s.against = against
+	return s.changes, nil
+}
+
+func TestSchemaDiff(t *testing.T) {
+	ctx := context.Background()
+	fieldDelete := schema.Change{Kind: "fieldDelete", Path: "a.Test.name", BreakingFor: []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FULL"}}
+	fieldAdd := schema.Change{Kind: "fieldAdd", Path: "a.Test.count"}
+	setup := func(t *testing.T, to schema.ParsedSchema) (*schema.Service, schema.ParsedSchema) {
+		t.Helper()
+		svc, _, schemaProvider, schemaRepo := getSvc()
+		from := &mocks.ParsedSchema{}
+		schemaRepo.On("GetMetadata", mock.Anything, "ns", "sc").Return(&schema.Metadata{Format: "FORMAT_PROTOBUF", Compatibility: "COMPATIBILITY_BACKWARD_TRANSITIVE"}, nil)
+		schemaRepo.On("ListReferences", mock.Anything, "ns", "sc", mock.Anything).Return(nil, nil)
+		schemaRepo.On("Get", mock.Anything, "ns", "sc", int32(1)).Return([]byte("one"), nil)
+		schemaRepo.On("Get", mock.Anything, "ns", "sc", int32(2)).Return([]byte("two"), nil)
+		schemaProvider.On("ParseSchema", "FORMAT_PROTOBUF", []byte("one")).Return(from, nil)
+		schemaProvider.On("ParseSchema", "FORMAT_PROTOBUF", []byte("two")).Return(to, nil)
+		return svc, from
+	}
+	t.Run("should mark changes breaking for schema compatibility", func(t *testing.T) {
+		to := &diffedSchema{ParsedSchema: &mocks.ParsedSchema{}, changes: []schema.Change{fieldDelete, fieldAdd}}
+		svc, from := setup(t, to)
+		diff, err := svc.Diff(ctx, "ns", "sc", 1, 2, "")
+		assert.NoError(t, err)
+		assert.Equal(t, from, to.against)
+		assert.Equal(t, "COMPATIBILITY_BACKWARD_TRANSITIVE", diff.Compatibility)
+		assert.Equal(t, int32(1), diff.FromVersion)
+		assert.Equal(t, int32(2), diff.ToVersion)
+		assert.True(t, diff.Changes[0].Breaking)
+		assert.False(t, diff.Changes[1].Breaking)
+	})
+	t.Run("should mark changes breaking for given compatibility", func(t *testing.T) {
+		to := &diffedSchema{ParsedSchema: &mocks.ParsedSchema{}, changes: []schema.Change{fieldDelete}}
+		svc, _ := setup(t, to)
+		diff, err := svc.Diff(ctx, "ns", "sc", 1, 2, "COMPATIBILITY_FORWARD")
+		assert.NoError(t, err)
+		assert.Equal(t, "COMPATIBILITY_FORWARD", diff.Compatibility)
+		assert.False(t, diff.Changes[0].Breaking)
+	})
+	t.Run("should return error if format does not support diff", func(t *testing.T) {
+		svc, _ := setup(t, &mocks.ParsedSchema{})
+		_, err := svc.Diff(ctx, "ns", "sc", 1, 2, "")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("should validate versions", func(t *testing.T) {
+		svc, _, _, _ := getSvc()
+		_, err := svc.Diff(ctx, "ns", "sc", 0, 2, "")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+type testKind string
+
+func (k testKind) String() string {
+	return string(k)
+}
+
+func TestChangeSet(t *testing.T) {
+	modes := schema.CompatibilityModes[testKind]{
+		Backward: []testKind{"fieldDelete"},
+		Forward:  []testKind{"fieldAdd"},
+		Full:     []testKind{"fieldDelete", "fieldAdd"},
+	}
+	t.Run("should list changes ordered by path along with modes rejecting them", func(t *testing.T) {
+		changes := schema.NewChangeSet(modes)
+		changes.Add("fieldDelete", "b.name", "b.name: field removed", "string", "")
+		changes.Add("fieldAdd", "a.count", "a.count: field added", "", "int")
+		changes.Add("fieldRename", "c.id", "c.id: field renamed", "id", "key")
+		assert.Equal(t, []schema.Change{
+			{Kind: "fieldAdd", Path: "a.count", Message: "a.count: field added", NewValue: "int", BreakingFor: []string{"COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}},
+			{Kind: "fieldDelete", Path: "b.name", Message: "b.name: field removed", OldValue: "string", BreakingFor: []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FULL"}},
+			{Kind: "fieldRename", Path: "c.id", Message: "c.id: field renamed", OldValue: "id", NewValue: "key"},
+		}, changes.Changes())
+	})
+	t.Run("should ignore same change recorded again", func(t *testing.T) {
+		changes := schema.NewChangeSet(modes)
+		changes.Add("fieldDelete", "b.name", "b.name: field removed", "string", "")
+		changes.Add("fieldDelete", "b.name", "b.name: field removed", "string", "")
+		assert.Len(t, changes.Changes(), 1)
+	})
+	t.Run("should pass only rejected changes on to violation collector", func(t *testing.T) {
+		violations := &schema.ViolationCollector[testKind]{}
+		recorder := schema.RejectedChanges[testKind](violations, modes.Backward)
+		recorder.Add("fieldDelete", "b.name", "b.name: field removed", "string", "")
+		recorder.Add("fieldAdd", "a.count", "a.count: field added", "", "int")
+		assert.Equal(t, []testKind{"fieldDelete"}, violations.Kinds())
+	})
+}
@@ -0,0 +1,113 @@
+package schema
+
+import (
+	"context"
+	"sync"
+)
+
+// EventType kind of change made to a schema
+type EventType string
+
+const (
+	EventCreate         EventType = "CREATE"
+	EventDelete         EventType = "DELETE"
+	EventDeleteVersion  EventType = "DELETE_VERSION"
+	EventUpdateMetadata EventType = "UPDATE_METADATA"
+)
+
+// eventBufferSize number of events buffered per watcher before it is dropped
+const eventBufferSize = 64
+
+// Event describes change made to a schema
+type Event struct {
+	Type        EventType
+	NamespaceID string
+	SchemaName  string
+	Version     int32
+	VersionID   string
+	Actor       string
+	// Summary describes the change in human readable form
+	Summary string
+}
+
+// EventHandler is called for every change made to schemas through this server instance.
+// Handlers are called synchronously after the change is saved, so they should not block.
+type EventHandler func(Event)
+
+type watcher struct {
+	namespace  string
+	schemaName string
+	events     chan Event
+}
+
+func (w *watcher) matches(e Event) bool {
+	return w.namespace == e.NamespaceID && (w.schemaName == "" || w.schemaName == e.SchemaName)
+}
+
+// broker fans out schema events to watchers within this server instance.
+// Events are kept in memory of the process, changes made through other server instances are not published.
+type broker struct {
+	lock     sync.Mutex
+	watchers map[*watcher]struct{}
+	handlers []EventHandler
+}
+
+func newBroker() *broker {
+	return &broker{watchers: make(map[*watcher]struct{})}
+}
+
+func (b *broker) subscribe(ctx context.Context, namespace, schemaName string) <-chan Event {
+	w := &watcher{namespace: namespace, schemaName: schemaName, events: make(chan Event, eventBufferSize)}
+	b.lock.Lock()
+	b.watchers[w] = struct{}{}
+	b.lock.Unlock()
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(w)
+	}()
+	return w.events
+}
+
+func (b *broker) addHandler(h EventHandler) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.handlers = append(b.handlers, h)
+}
+
+func (b *broker) unsubscribe(w *watcher) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if _, ok := b.watchers[w]; ok {
+		delete(b.watchers, w)
+		close(w.events)
+	}
+}
+
+// publish sends event to all handlers and matching watchers.
+// Watchers not keeping up are dropped, so that they can reconnect and reload the schemas.
+// Handlers are called without holding the lock, so they can subscribe or add handlers.
+func (b *broker) publish(e Event) {
+	b.lock.Lock()
+	handlers := make([]EventHandler, len(b.handlers))
+	copy(handlers, b.handlers)
+	b.notify(e)
+	b.lock.Unlock()
+	for _, handler := range handlers {
+		handler(e)
+	}
+}
+
+// notify sends event to matching watchers without blocking, caller must hold the lock
+func (b *broker) notify(e Event) {
+	for w := range b.watchers {
+		if !w.matches(e) {
+			continue
+		}
+		select {
+		case w.events <- e:
+		default:
+			delete(b.watchers, w)
+			close(w.events)
+		}
+	}
+}
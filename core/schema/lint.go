@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"strings"
+
+	"github.com/raystack/stencil/core/namespace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LintIssue describes a single lint rule failed by schema
+type LintIssue struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Path     string `json:"path"`
+	Message  string `json:"message"`
+}
+
+// Linter is implemented by parsed schemas of formats having lint rules.
+// Rules of the rule set have error severity unless overridden by rules.
+type Linter interface {
+	Lint(ruleSet string, rules map[string]string) []LintIssue
+}
+
+// LintErr returned when schema fails lint rules having error severity, Issues has all failed rules including warnings
+type LintErr struct {
+	Issues []LintIssue
+}
+
+func (e *LintErr) Error() string {
+	var msgs []string
+	for _, issue := range e.Issues {
+		if issue.Severity == namespace.LintSeverityError {
+			msgs = append(msgs, issue.Message)
+		}
+	}
+	return "lint failed: " + strings.Join(msgs, ";")
+}
+
+// GRPCStatus used by gateway interceptor to return appropriate http status code and message
+func (e *LintErr) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+// lint runs lint rules configured for the namespace and returns failed rules having warning severity
+func lint(ns namespace.Namespace, parsed ParsedSchema) ([]LintIssue, error) {
+	if ns.LintRuleSet == "" && len(ns.LintRules) == 0 {
+		return nil, nil
+	}
+	linter, ok := parsed.(Linter)
+	if !ok {
+		return nil, nil
+	}
+	issues := linter.Lint(ns.LintRuleSet, ns.LintRules)
+	for _, issue := range issues {
+		if issue.Severity == namespace.LintSeverityError {
+			return nil, &LintErr{Issues: issues}
+		}
+	}
+	return issues, nil
+}
@@ -0,0 +1,110 @@
+package schema_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/core/schema/mocks"
+	"github.com/raystack/stencil/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type lintedSchema struct {
+	*mocks.ParsedSchema
+	issues  []schema.LintIssue
+	ruleSet string
+	rules   map[string]string
+}
+
+func (s *lintedSchema) Lint(ruleSet string, rules map[string]string) []schema.LintIssue {
+	s.ruleSet = ruleSet
+	s.rules = rules
+	return s.issues
+}
+
+func TestSchemaLint(t *testing.T) {
+	ctx := context.Background()
+	nsName := "testNamespace"
+	data := []byte("data")
+	ns := namespace.Namespace{Format: "protobuf", LintRuleSet: namespace.LintRuleSetBasic, LintRules: map[string]string{"PACKAGE_DEFINED": namespace.LintSeverityWarning}}
+	errorIssue := schema.LintIssue{Rule: "FIELD_LOWER_SNAKE_CASE", Severity: namespace.LintSeverityError, Path: "a.Test.fieldName", Message: `1.proto: field name "fieldName" should be lower_snake_case`}
+	warningIssue := schema.LintIssue{Rule: "PACKAGE_DEFINED", Severity: namespace.LintSeverityWarning, Path: "1.proto", Message: "1.proto: package is not defined"}
+	t.Run("should refuse schema failing rules with error severity", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &lintedSchema{ParsedSchema: &mocks.ParsedSchema{}, issues: []schema.LintIssue{warningIssue, errorIssue}}
+		nsService.On("Get", mock.Anything, nsName).Return(ns, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		_, err := svc.Create(ctx, nsName, "a", &schema.Metadata{}, data)
+		var lintErr *schema.LintErr
+		assert.ErrorAs(t, err, &lintErr)
+		assert.Equal(t, []schema.LintIssue{warningIssue, errorIssue}, lintErr.Issues)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "lint failed: "+errorIssue.Message, err.Error())
+		assert.Equal(t, ns.LintRuleSet, parsedSchema.ruleSet)
+		assert.Equal(t, ns.LintRules, parsedSchema.rules)
+		schemaRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("should return failed rules with warning severity", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &lintedSchema{ParsedSchema: &mocks.ParsedSchema{}, issues: []schema.LintIssue{warningIssue}}
+		nsService.On("Get", mock.Anything, nsName).Return(ns, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(0), store.NoRowsErr)
+		parsedSchema.On("GetCanonicalValue").Return(&schema.SchemaFile{ID: "fileID"})
+		versionID := uuid.NewSHA1(uuid.NameSpaceOID, []byte("testNamespace-a-fileID")).String()
+		schemaRepo.On("GetVersionByID", mock.Anything, versionID).Return(schema.VersionRef{}, store.NoRowsErr)
+		schemaRepo.On("GetNextVersion", mock.Anything, nsName, "a").Return(int32(1), nil)
+		scInfo, err := svc.CreateDryRun(ctx, nsName, "a", &schema.Metadata{}, data)
+		assert.NoError(t, err)
+		assert.Equal(t, []schema.LintIssue{warningIssue}, scInfo.LintIssues)
+	})
+	t.Run("should not lint schema if lint is not configured for namespace", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &lintedSchema{ParsedSchema: &mocks.ParsedSchema{}, issues: []schema.LintIssue{errorIssue}}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("ListVersions", mock.Anything, nsName, "a").Return([]int32{}, nil)
+		err := svc.CheckCompatibility(ctx, nsName, "a", &schema.Metadata{Compatibility: "COMPATIBILITY_BACKWARD_TRANSITIVE"}, data)
+		assert.NoError(t, err)
+	})
+	t.Run("should return lint error on compatibility check", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &lintedSchema{ParsedSchema: &mocks.ParsedSchema{}, issues: []schema.LintIssue{errorIssue}}
+		nsService.On("Get", mock.Anything, nsName).Return(ns, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(0), store.NoRowsErr)
+		err := svc.CheckCompatibility(ctx, nsName, "a", &schema.Metadata{Compatibility: "COMPATIBILITY_BACKWARD"}, data)
+		var lintErr *schema.LintErr
+		assert.ErrorAs(t, err, &lintErr)
+		var compErr *schema.CompatibilityErr
+		assert.False(t, errors.As(err, &compErr))
+	})
+	t.Run("should return lint error along with compatibility violations", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &lintedSchema{ParsedSchema: &mocks.ParsedSchema{}, issues: []schema.LintIssue{errorIssue}}
+		prevParsedSchema := &mocks.ParsedSchema{}
+		prevData := []byte("prev data")
+		nsService.On("Get", mock.Anything, nsName).Return(ns, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(1), nil)
+		schemaRepo.On("GetMetadata", mock.Anything, nsName, "a").Return(&schema.Metadata{Format: "protobuf"}, nil)
+		schemaRepo.On("Get", mock.Anything, nsName, "a", int32(1)).Return(prevData, nil)
+		schemaRepo.On("ListReferences", mock.Anything, nsName, "a", int32(1)).Return(nil, nil)
+		schemaProvider.On("ParseSchema", "protobuf", prevData).Return(prevParsedSchema, nil)
+		parsedSchema.ParsedSchema.On("IsBackwardCompatible", prevParsedSchema).Return(errors.New("field removed"))
+		err := svc.CheckCompatibility(ctx, nsName, "a", &schema.Metadata{Compatibility: "COMPATIBILITY_BACKWARD"}, data)
+		var lintErr *schema.LintErr
+		assert.ErrorAs(t, err, &lintErr)
+		assert.Equal(t, []schema.LintIssue{errorIssue}, lintErr.Issues)
+		var compErr *schema.CompatibilityErr
+		assert.ErrorAs(t, err, &compErr)
+		assert.Len(t, compErr.Violations, 1)
+	})
+}
@@ -1,12 +1,10 @@
-// Code generated by mockery v2.12.2. DO NOT EDIT.
+// Code generated by mockery v2.14.0. DO NOT EDIT.
 
 package mocks
 
 import (
 	schema "github.com/raystack/stencil/core/schema"
 	mock "github.com/stretchr/testify/mock"
-
-	testing "testing"
 )
 
 // SchemaProvider is an autogenerated mock type for the Provider type
@@ -14,13 +12,50 @@ type SchemaProvider struct {
 	mock.Mock
 }
 
-// ParseSchema provides a mock function with given fields: format, data
-func (_m *SchemaProvider) ParseSchema(format string, data []byte) (schema.ParsedSchema, error) {
-	ret := _m.Called(format, data)
+// CompileSchema provides a mock function with given fields: format, files, importRoot, dependencies
+func (_m *SchemaProvider) CompileSchema(format string, files map[string][]byte, importRoot string, dependencies ...[]byte) ([]byte, error) {
+	_va := make([]interface{}, len(dependencies))
+	for _i := range dependencies {
+		_va[_i] = dependencies[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, format, files, importRoot)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(string, map[string][]byte, string, ...[]byte) []byte); ok {
+		r0 = rf(format, files, importRoot, dependencies...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, map[string][]byte, string, ...[]byte) error); ok {
+		r1 = rf(format, files, importRoot, dependencies...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ParseSchema provides a mock function with given fields: format, data, dependencies
+func (_m *SchemaProvider) ParseSchema(format string, data []byte, dependencies ...[]byte) (schema.ParsedSchema, error) {
+	_va := make([]interface{}, len(dependencies))
+	for _i := range dependencies {
+		_va[_i] = dependencies[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, format, data)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 schema.ParsedSchema
-	if rf, ok := ret.Get(0).(func(string, []byte) schema.ParsedSchema); ok {
-		r0 = rf(format, data)
+	if rf, ok := ret.Get(0).(func(string, []byte, ...[]byte) schema.ParsedSchema); ok {
+		r0 = rf(format, data, dependencies...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(schema.ParsedSchema)
@@ -28,8 +63,8 @@ func (_m *SchemaProvider) ParseSchema(format string, data []byte) (schema.Parsed
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, []byte) error); ok {
-		r1 = rf(format, data)
+	if rf, ok := ret.Get(1).(func(string, []byte, ...[]byte) error); ok {
+		r1 = rf(format, data, dependencies...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -37,8 +72,13 @@ func (_m *SchemaProvider) ParseSchema(format string, data []byte) (schema.Parsed
 	return r0, r1
 }
 
-// NewSchemaProvider creates a new instance of SchemaProvider. It also registers the testing.TB interface on the mock and a cleanup function to assert the mocks expectations.
-func NewSchemaProvider(t testing.TB) *SchemaProvider {
+type mockConstructorTestingTNewSchemaProvider interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSchemaProvider creates a new instance of SchemaProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSchemaProvider(t mockConstructorTestingTNewSchemaProvider) *SchemaProvider {
 	mock := &SchemaProvider{}
 	mock.Mock.Test(t)
 
@@ -15,7 +15,7 @@ type SchemaRepository struct {
 }
 
 // Create provides a mock function with given fields: ctx, namespace, _a2, metadata, versionID, schemaFile
-func (_m *SchemaRepository) Create(ctx context.Context, namespace string, _a2 string, metadata *schema.Metadata, versionID string, schemaFile *schema.SchemaFile) (int32, error) {
+func (_m *SchemaRepository) Create(ctx context.Context, namespace string, _a2 string, metadata *schema.Metadata, versionID string, schemaFile *schema.SchemaFile) (int32, int32, bool, error) {
 	ret := _m.Called(ctx, namespace, _a2, metadata, versionID, schemaFile)
 
 	var r0 int32
@@ -25,14 +25,28 @@ func (_m *SchemaRepository) Create(ctx context.Context, namespace string, _a2 st
 		r0 = ret.Get(0).(int32)
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, *schema.Metadata, string, *schema.SchemaFile) error); ok {
+	var r1 int32
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *schema.Metadata, string, *schema.SchemaFile) int32); ok {
 		r1 = rf(ctx, namespace, _a2, metadata, versionID, schemaFile)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int32)
 	}
 
-	return r0, r1
+	var r2 bool
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, *schema.Metadata, string, *schema.SchemaFile) bool); ok {
+		r2 = rf(ctx, namespace, _a2, metadata, versionID, schemaFile)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, string, string, *schema.Metadata, string, *schema.SchemaFile) error); ok {
+		r3 = rf(ctx, namespace, _a2, metadata, versionID, schemaFile)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
 }
 
 // Delete provides a mock function with given fields: _a0, _a1, _a2
@@ -86,6 +100,48 @@ func (_m *SchemaRepository) Get(_a0 context.Context, _a1 string, _a2 string, _a3
 	return r0, r1
 }
 
+// GetByGlobalID provides a mock function with given fields: ctx, globalID
+func (_m *SchemaRepository) GetByGlobalID(ctx context.Context, globalID int32) (schema.VersionRef, error) {
+	ret := _m.Called(ctx, globalID)
+
+	var r0 schema.VersionRef
+	if rf, ok := ret.Get(0).(func(context.Context, int32) schema.VersionRef); ok {
+		r0 = rf(ctx, globalID)
+	} else {
+		r0 = ret.Get(0).(schema.VersionRef)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, globalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGlobalID provides a mock function with given fields: ctx, namespace, schemaName, version
+func (_m *SchemaRepository) GetGlobalID(ctx context.Context, namespace string, schemaName string, version int32) (int32, error) {
+	ret := _m.Called(ctx, namespace, schemaName, version)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) int32); ok {
+		r0 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLatestVersion provides a mock function with given fields: _a0, _a1, _a2
 func (_m *SchemaRepository) GetLatestVersion(_a0 context.Context, _a1 string, _a2 string) (int32, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -130,6 +186,69 @@ func (_m *SchemaRepository) GetMetadata(_a0 context.Context, _a1 string, _a2 str
 	return r0, r1
 }
 
+// GetNextVersion provides a mock function with given fields: ctx, namespace, schemaName
+func (_m *SchemaRepository) GetNextVersion(ctx context.Context, namespace string, schemaName string) (int32, error) {
+	ret := _m.Called(ctx, namespace, schemaName)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int32); ok {
+		r0 = rf(ctx, namespace, schemaName)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, schemaName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVersionByID provides a mock function with given fields: ctx, versionID
+func (_m *SchemaRepository) GetVersionByID(ctx context.Context, versionID string) (schema.VersionRef, error) {
+	ret := _m.Called(ctx, versionID)
+
+	var r0 schema.VersionRef
+	if rf, ok := ret.Get(0).(func(context.Context, string) schema.VersionRef); ok {
+		r0 = rf(ctx, versionID)
+	} else {
+		r0 = ret.Get(0).(schema.VersionRef)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, versionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVersionRef provides a mock function with given fields: ctx, namespace, schemaName, version
+func (_m *SchemaRepository) GetVersionRef(ctx context.Context, namespace string, schemaName string, version int32) (schema.VersionRef, error) {
+	ret := _m.Called(ctx, namespace, schemaName, version)
+
+	var r0 schema.VersionRef
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) schema.VersionRef); ok {
+		r0 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r0 = ret.Get(0).(schema.VersionRef)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: _a0, _a1
 func (_m *SchemaRepository) List(_a0 context.Context, _a1 string) ([]schema.Schema, error) {
 	ret := _m.Called(_a0, _a1)
@@ -153,6 +272,75 @@ func (_m *SchemaRepository) List(_a0 context.Context, _a1 string) ([]schema.Sche
 	return r0, r1
 }
 
+// ListReferences provides a mock function with given fields: ctx, namespace, schemaName, version
+func (_m *SchemaRepository) ListReferences(ctx context.Context, namespace string, schemaName string, version int32) ([]schema.Reference, error) {
+	ret := _m.Called(ctx, namespace, schemaName, version)
+
+	var r0 []schema.Reference
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) []schema.Reference); ok {
+		r0 = rf(ctx, namespace, schemaName, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schema.Reference)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListReferrers provides a mock function with given fields: ctx, namespace, schemaName, version
+func (_m *SchemaRepository) ListReferrers(ctx context.Context, namespace string, schemaName string, version int32) ([]schema.Reference, error) {
+	ret := _m.Called(ctx, namespace, schemaName, version)
+
+	var r0 []schema.Reference
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) []schema.Reference); ok {
+		r0 = rf(ctx, namespace, schemaName, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schema.Reference)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListVersionDetails provides a mock function with given fields: ctx, namespace, schemaName, afterVersion, limit
+func (_m *SchemaRepository) ListVersionDetails(ctx context.Context, namespace string, schemaName string, afterVersion int32, limit int) ([]schema.Version, error) {
+	ret := _m.Called(ctx, namespace, schemaName, afterVersion, limit)
+
+	var r0 []schema.Version
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int) []schema.Version); ok {
+		r0 = rf(ctx, namespace, schemaName, afterVersion, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schema.Version)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32, int) error); ok {
+		r1 = rf(ctx, namespace, schemaName, afterVersion, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListVersions provides a mock function with given fields: _a0, _a1, _a2
 func (_m *SchemaRepository) ListVersions(_a0 context.Context, _a1 string, _a2 string) ([]int32, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -176,6 +364,34 @@ func (_m *SchemaRepository) ListVersions(_a0 context.Context, _a1 string, _a2 st
 	return r0, r1
 }
 
+// Restore provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SchemaRepository) Restore(_a0 context.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreVersion provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *SchemaRepository) RestoreVersion(_a0 context.Context, _a1 string, _a2 string, _a3 int32) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateMetadata provides a mock function with given fields: _a0, _a1, _a2, _a3
 func (_m *SchemaRepository) UpdateMetadata(_a0 context.Context, _a1 string, _a2 string, _a3 *schema.Metadata) (*schema.Metadata, error) {
 	ret := _m.Called(_a0, _a1, _a2, _a3)
@@ -199,6 +415,27 @@ func (_m *SchemaRepository) UpdateMetadata(_a0 context.Context, _a1 string, _a2
 	return r0, r1
 }
 
+// UpdateVersionState provides a mock function with given fields: ctx, namespace, schemaName, version, state
+func (_m *SchemaRepository) UpdateVersionState(ctx context.Context, namespace string, schemaName string, version int32, state schema.VersionState) (schema.Version, error) {
+	ret := _m.Called(ctx, namespace, schemaName, version, state)
+
+	var r0 schema.Version
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, schema.VersionState) schema.Version); ok {
+		r0 = rf(ctx, namespace, schemaName, version, state)
+	} else {
+		r0 = ret.Get(0).(schema.Version)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32, schema.VersionState) error); ok {
+		r1 = rf(ctx, namespace, schemaName, version, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewSchemaRepository interface {
 	mock.TestingT
 	Cleanup(func())
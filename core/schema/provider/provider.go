@@ -2,33 +2,88 @@ package provider
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/raystack/stencil/core/schema"
 	"github.com/raystack/stencil/formats/avro"
+	"github.com/raystack/stencil/formats/graphql"
 	"github.com/raystack/stencil/formats/json"
+	"github.com/raystack/stencil/formats/openapi"
 	"github.com/raystack/stencil/formats/protobuf"
+	"github.com/raystack/stencil/formats/thrift"
 )
 
-type parseFn func([]byte) (schema.ParsedSchema, error)
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]schema.Format{}
+)
 
-type SchemaProvider struct {
-	mapper map[string]parseFn
+func init() {
+	Register(protobuf.Format{})
+	Register(avro.Format{})
+	Register(json.Format{})
+	Register(thrift.Format{})
+	Register(graphql.Format{})
+	Register(openapi.Format{})
 }
 
-func (s *SchemaProvider) ParseSchema(format string, data []byte) (schema.ParsedSchema, error) {
-	fn, ok := s.mapper[format]
-	if ok {
-		return fn(data)
+// Register makes schema format available to schema providers by its name.
+// It panics if format with same name is already registered.
+func Register(format schema.Format) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[format.Name()]; ok {
+		panic(fmt.Sprintf("schema format %s is already registered", format.Name()))
 	}
-	return nil, errors.New("unknown schema")
+	registry[format.Name()] = format
 }
 
-func NewSchemaProvider() *SchemaProvider {
-	mp := make(map[string]parseFn)
-	mp["FORMAT_PROTOBUF"] = protobuf.GetParsedSchema
-	mp["FORMAT_AVRO"] = avro.ParseSchema
-	mp["FORMAT_JSON"] = json.GetParsedSchema
-	return &SchemaProvider{
-		mapper: mp,
+// Lookup returns registered schema format by its name
+func Lookup(name string) (schema.Format, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	format, ok := registry[name]
+	return format, ok
+}
+
+// Formats returns sorted names of registered schema formats
+func Formats() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type SchemaProvider struct{}
+
+func (s *SchemaProvider) ParseSchema(format string, data []byte, dependencies ...[]byte) (schema.ParsedSchema, error) {
+	f, ok := Lookup(format)
+	if !ok {
+		return nil, errors.New("unknown schema")
 	}
+	if parser, ok := f.(schema.ReferenceParser); ok {
+		return parser.ParseSchemaWithReferences(data, dependencies...)
+	}
+	if len(dependencies) > 0 {
+		return nil, fmt.Errorf("references are not supported for %s", format)
+	}
+	return f.ParseSchema(data)
+}
+
+func (s *SchemaProvider) CompileSchema(format string, files map[string][]byte, importRoot string, dependencies ...[]byte) ([]byte, error) {
+	f, _ := Lookup(format)
+	if compiler, ok := f.(schema.SourceCompiler); ok {
+		return compiler.CompileSchema(files, importRoot, dependencies...)
+	}
+	return nil, fmt.Errorf("compiling source files is not supported for %s", format)
+}
+
+func NewSchemaProvider() *SchemaProvider {
+	return &SchemaProvider{}
 }
@@ -0,0 +1,165 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/raystack/stencil/internal/store"
+	"github.com/raystack/stencil/pkg/archive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// normalizeReferences sorts references and removes duplicates, so that same set of references always gets same version ID
+func normalizeReferences(refs []Reference) []Reference {
+	if len(refs) == 0 {
+		return nil
+	}
+	sorted := append([]Reference(nil), refs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].String() < sorted[j].String() })
+	unique := sorted[:1]
+	for _, ref := range sorted[1:] {
+		if ref != unique[len(unique)-1] {
+			unique = append(unique, ref)
+		}
+	}
+	return unique
+}
+
+// checkReferences validates schema versions referenced by the version being created or checked.
+// Referenced versions should exist with same format and must not be deleted or disabled.
+func (s *Service) checkReferences(ctx context.Context, nsName, schemaName, format string, refs []Reference) error {
+	for _, ref := range refs {
+		if ref.NamespaceID == nsName && ref.Name == schemaName {
+			return status.Errorf(codes.InvalidArgument, "schema can't reference its own version %s", ref)
+		}
+		vRef, err := s.repo.GetVersionRef(ctx, ref.NamespaceID, ref.Name, ref.Version)
+		if errors.Is(err, store.NoRowsErr) {
+			return status.Errorf(codes.InvalidArgument, "referenced schema version %s not found", ref)
+		}
+		if err != nil {
+			return err
+		}
+		if vRef.Deleted {
+			return status.Errorf(codes.FailedPrecondition, "referenced schema version %s is deleted", ref)
+		}
+		if err := checkRefNotDisabled(vRef); err != nil {
+			return err
+		}
+		meta, err := s.repo.GetMetadata(ctx, ref.NamespaceID, ref.Name)
+		if err != nil {
+			return err
+		}
+		if meta.Format != format {
+			return status.Errorf(codes.InvalidArgument, "referenced schema version %s is in %s format, expected %s", ref, meta.Format, format)
+		}
+	}
+	return nil
+}
+
+// loadDependencies returns data of referenced schema versions along with the versions they reference in turn.
+// Data of a version comes after data of the versions it depends on.
+func (s *Service) loadDependencies(ctx context.Context, refs []Reference) ([][]byte, error) {
+	var deps [][]byte
+	visited := map[Reference]bool{}
+	var visit func(ref Reference) error
+	visit = func(ref Reference) error {
+		if visited[ref] {
+			return nil
+		}
+		visited[ref] = true
+		nested, err := s.repo.ListReferences(ctx, ref.NamespaceID, ref.Name, ref.Version)
+		if err != nil {
+			return err
+		}
+		for _, n := range nested {
+			if err := visit(n); err != nil {
+				return err
+			}
+		}
+		data, err := s.cachedGetSchema(ctx, ref.NamespaceID, ref.Name, ref.Version)
+		if err != nil {
+			return err
+		}
+		deps = append(deps, data)
+		return nil
+	}
+	for _, ref := range refs {
+		if err := visit(ref); err != nil {
+			return nil, err
+		}
+	}
+	return deps, nil
+}
+
+// compile compiles source files in data, if data is an archive of source files, along with data of the schema versions they reference
+func (s *Service) compile(ctx context.Context, format string, data []byte, importRoot string, refs []Reference) ([]byte, error) {
+	if !archive.IsArchive(data) {
+		return data, nil
+	}
+	files, err := archive.Extract(data)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	deps, err := s.loadDependencies(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := s.provider.CompileSchema(format, files, importRoot, deps...)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return compiled, nil
+}
+
+// parse parses data along with data of the schema versions it references
+func (s *Service) parse(ctx context.Context, format string, data []byte, refs []Reference) (ParsedSchema, error) {
+	if len(refs) == 0 {
+		return s.provider.ParseSchema(format, data)
+	}
+	deps, err := s.loadDependencies(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+	return s.provider.ParseSchema(format, data, deps...)
+}
+
+// GetAssembled returns schema data combined with data of all schema versions it references.
+// Stored data is returned as is if version has no references or format can't be assembled.
+func (s *Service) GetAssembled(ctx context.Context, namespace, schemaName string, version int32) (*Metadata, []byte, error) {
+	meta, data, err := s.Get(ctx, namespace, schemaName, version)
+	if err != nil {
+		return meta, data, err
+	}
+	refs, err := s.repo.ListReferences(ctx, namespace, schemaName, version)
+	if err != nil || len(refs) == 0 {
+		return meta, data, err
+	}
+	parsed, err := s.cachedParseSchema(ctx, namespace, schemaName, meta.Format, version)
+	if err != nil {
+		return nil, nil, err
+	}
+	assembler, ok := parsed.(Assembler)
+	if !ok {
+		return meta, data, nil
+	}
+	data, err = assembler.Assemble()
+	return meta, data, err
+}
+
+// ListReferences returns schema versions referenced by the version and versions referring to it
+func (s *Service) ListReferences(ctx context.Context, namespace, schemaName string, version int32) ([]Reference, []Reference, error) {
+	if _, err := s.repo.GetVersionRef(ctx, namespace, schemaName, version); err != nil {
+		return nil, nil, err
+	}
+	refs, err := s.repo.ListReferences(ctx, namespace, schemaName, version)
+	if err != nil {
+		return nil, nil, err
+	}
+	referrers, err := s.repo.ListReferrers(ctx, namespace, schemaName, version)
+	return refs, referrers, err
+}
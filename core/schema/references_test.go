@@ -0,0 +1,236 @@
+package schema_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/core/schema/mocks"
+	"github.com/raystack/stencil/internal/store"
+	"github.com/raystack/stencil/pkg/archive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type assembledSchema struct {
+	*mocks.ParsedSchema
+	data []byte
+}
+
+func (s *assembledSchema) Assemble() ([]byte, error) {
+	return s.data, nil
+}
+
+func TestParseReference(t *testing.T) {
+	ref, err := schema.ParseReference(" shared/common@3 ")
+	assert.NoError(t, err)
+	assert.Equal(t, schema.Reference{NamespaceID: "shared", Name: "common", Version: 3}, ref)
+	assert.Equal(t, "shared/common@3", ref.String())
+	for _, invalid := range []string{"shared/common", "common@3", "/common@3", "shared/@3", "shared/common@0", "shared/common@latest"} {
+		_, err := schema.ParseReference(invalid)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), invalid)
+	}
+}
+
+func TestSchemaCreateWithReferences(t *testing.T) {
+	ctx := context.Background()
+	nsName := "orders"
+	data := []byte("data")
+	common := schema.Reference{NamespaceID: "shared", Name: "common", Version: 3}
+	setup := func() (*schema.Service, *mocks.SchemaProvider, *mocks.SchemaRepository) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "FORMAT_PROTOBUF"}, nil)
+		return svc, schemaProvider, schemaRepo
+	}
+	t.Run("should return error if referenced version not found", func(t *testing.T) {
+		svc, _, schemaRepo := setup()
+		schemaRepo.On("GetVersionRef", mock.Anything, "shared", "common", int32(3)).Return(schema.VersionRef{}, store.NoRowsErr)
+		_, err := svc.Create(ctx, nsName, "a", &schema.Metadata{References: []schema.Reference{common}}, data)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("should refuse reference to deleted or disabled version", func(t *testing.T) {
+		for _, ref := range []schema.VersionRef{
+			{Name: "common", Version: 3, Deleted: true, VersionState: schema.VersionState{State: schema.VersionStateActive}},
+			{Name: "common", Version: 3, VersionState: schema.VersionState{State: schema.VersionStateDisabled}},
+		} {
+			svc, _, schemaRepo := setup()
+			schemaRepo.On("GetVersionRef", mock.Anything, "shared", "common", int32(3)).Return(ref, nil)
+			_, err := svc.Create(ctx, nsName, "a", &schema.Metadata{References: []schema.Reference{common}}, data)
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		}
+	})
+	t.Run("should refuse reference to schema of different format", func(t *testing.T) {
+		svc, _, schemaRepo := setup()
+		schemaRepo.On("GetVersionRef", mock.Anything, "shared", "common", int32(3)).Return(schema.VersionRef{VersionState: schema.VersionState{State: schema.VersionStateActive}}, nil)
+		schemaRepo.On("GetMetadata", mock.Anything, "shared", "common").Return(&schema.Metadata{Format: "FORMAT_AVRO"}, nil)
+		_, err := svc.Create(ctx, nsName, "a", &schema.Metadata{References: []schema.Reference{common}}, data)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("should refuse reference to own version", func(t *testing.T) {
+		svc, _, schemaRepo := setup()
+		_, err := svc.Create(ctx, nsName, "a", &schema.Metadata{References: []schema.Reference{{NamespaceID: nsName, Name: "a", Version: 1}}}, data)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		schemaRepo.AssertNotCalled(t, "GetVersionRef", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("should parse data along with transitive dependencies and store references", func(t *testing.T) {
+		svc, schemaProvider, schemaRepo := setup()
+		money := schema.Reference{NamespaceID: "shared", Name: "money", Version: 1}
+		parsedSchema := &mocks.ParsedSchema{}
+		scFile := &schema.SchemaFile{ID: "file-id"}
+		schemaRepo.On("GetVersionRef", mock.Anything, "shared", "common", int32(3)).Return(schema.VersionRef{VersionState: schema.VersionState{State: schema.VersionStateActive}}, nil)
+		schemaRepo.On("GetMetadata", mock.Anything, "shared", "common").Return(&schema.Metadata{Format: "FORMAT_PROTOBUF"}, nil)
+		schemaRepo.On("ListReferences", mock.Anything, "shared", "common", int32(3)).Return([]schema.Reference{money}, nil)
+		schemaRepo.On("ListReferences", mock.Anything, "shared", "money", int32(1)).Return(nil, nil)
+		schemaRepo.On("Get", mock.Anything, "shared", "common", int32(3)).Return([]byte("common"), nil)
+		schemaRepo.On("Get", mock.Anything, "shared", "money", int32(1)).Return([]byte("money"), nil)
+		schemaProvider.On("ParseSchema", "FORMAT_PROTOBUF", data, []byte("money"), []byte("common")).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(0), store.NoRowsErr)
+		parsedSchema.On("GetCanonicalValue").Return(scFile)
+		schemaRepo.On("GetVersionByID", mock.Anything, mock.Anything).Return(schema.VersionRef{}, store.NoRowsErr)
+		expectedMeta := &schema.Metadata{Format: "FORMAT_PROTOBUF", References: []schema.Reference{common}}
+		schemaRepo.On("Create", mock.Anything, nsName, "a", expectedMeta, mock.Anything, scFile).Return(int32(1), int32(5), true, nil)
+		withRefs, err := svc.Create(ctx, nsName, "a", &schema.Metadata{References: []schema.Reference{common, common}}, data)
+		assert.NoError(t, err)
+		schemaRepo.AssertExpectations(t)
+		schemaProvider.AssertExpectations(t)
+
+		svc, schemaProvider, schemaRepo = setup()
+		schemaProvider.On("ParseSchema", "FORMAT_PROTOBUF", data).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(0), store.NoRowsErr)
+		schemaRepo.On("GetVersionByID", mock.Anything, mock.Anything).Return(schema.VersionRef{}, store.NoRowsErr)
+		schemaRepo.On("Create", mock.Anything, nsName, "a", mock.Anything, mock.Anything, scFile).Return(int32(2), int32(6), true, nil)
+		withoutRefs, err := svc.Create(ctx, nsName, "a", &schema.Metadata{}, data)
+		assert.NoError(t, err)
+		assert.NotEqual(t, withRefs.ID, withoutRefs.ID)
+	})
+}
+
+func TestSchemaCheckCompatibilityWithReferences(t *testing.T) {
+	ctx := context.Background()
+	nsName := "orders"
+	data := []byte("data")
+	common := schema.Reference{NamespaceID: "shared", Name: "common", Version: 3}
+	t.Run("should refuse reference to deleted version", func(t *testing.T) {
+		svc, nsService, _, schemaRepo := getSvc()
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "FORMAT_PROTOBUF"}, nil)
+		schemaRepo.On("GetVersionRef", mock.Anything, "shared", "common", int32(3)).Return(schema.VersionRef{Deleted: true}, nil)
+		err := svc.CheckCompatibility(ctx, nsName, "a", &schema.Metadata{References: []schema.Reference{common}}, data)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("should parse data along with referenced versions", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "FORMAT_PROTOBUF"}, nil)
+		schemaRepo.On("GetVersionRef", mock.Anything, "shared", "common", int32(3)).Return(schema.VersionRef{VersionState: schema.VersionState{State: schema.VersionStateActive}}, nil)
+		schemaRepo.On("GetMetadata", mock.Anything, "shared", "common").Return(&schema.Metadata{Format: "FORMAT_PROTOBUF"}, nil)
+		schemaRepo.On("ListReferences", mock.Anything, "shared", "common", int32(3)).Return(nil, nil)
+		schemaRepo.On("Get", mock.Anything, "shared", "common", int32(3)).Return([]byte("common"), nil)
+		schemaProvider.On("ParseSchema", "FORMAT_PROTOBUF", data, []byte("common")).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(0), store.NoRowsErr)
+		err := svc.CheckCompatibility(ctx, nsName, "a", &schema.Metadata{References: []schema.Reference{common}}, data)
+		assert.NoError(t, err)
+		schemaProvider.AssertExpectations(t)
+	})
+}
+
+func TestGetAssembled(t *testing.T) {
+	ctx := context.Background()
+	meta := &schema.Metadata{Format: "FORMAT_PROTOBUF"}
+	t.Run("should return stored data if version has no references", func(t *testing.T) {
+		svc, _, schemaProvider, schemaRepo := getSvc()
+		schemaRepo.On("GetMetadata", mock.Anything, "orders", "order").Return(meta, nil)
+		schemaRepo.On("Get", mock.Anything, "orders", "order", int32(2)).Return([]byte("order"), nil)
+		schemaRepo.On("ListReferences", mock.Anything, "orders", "order", int32(2)).Return(nil, nil)
+		_, data, err := svc.GetAssembled(ctx, "orders", "order", 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("order"), data)
+		schemaProvider.AssertNotCalled(t, "ParseSchema", mock.Anything, mock.Anything)
+	})
+	t.Run("should return data assembled along with referenced schemas", func(t *testing.T) {
+		svc, _, schemaProvider, schemaRepo := getSvc()
+		common := schema.Reference{NamespaceID: "shared", Name: "common", Version: 3}
+		schemaRepo.On("GetMetadata", mock.Anything, "orders", "order").Return(meta, nil)
+		schemaRepo.On("Get", mock.Anything, "orders", "order", int32(2)).Return([]byte("order"), nil)
+		schemaRepo.On("ListReferences", mock.Anything, "orders", "order", int32(2)).Return([]schema.Reference{common}, nil)
+		schemaRepo.On("ListReferences", mock.Anything, "shared", "common", int32(3)).Return(nil, nil)
+		schemaRepo.On("Get", mock.Anything, "shared", "common", int32(3)).Return([]byte("common"), nil)
+		schemaProvider.On("ParseSchema", "FORMAT_PROTOBUF", []byte("order"), []byte("common")).Return(&assembledSchema{data: []byte("assembled")}, nil)
+		actualMeta, data, err := svc.GetAssembled(ctx, "orders", "order", 2)
+		assert.NoError(t, err)
+		assert.Equal(t, meta, actualMeta)
+		assert.Equal(t, []byte("assembled"), data)
+	})
+}
+
+func TestListReferences(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should return not found error if version not found", func(t *testing.T) {
+		svc, _, _, schemaRepo := getSvc()
+		schemaRepo.On("GetVersionRef", mock.Anything, "shared", "common", int32(9)).Return(schema.VersionRef{}, store.NoRowsErr)
+		_, _, err := svc.ListReferences(ctx, "shared", "common", 9)
+		assert.ErrorIs(t, err, store.NoRowsErr)
+	})
+	t.Run("should return references and referrers of version", func(t *testing.T) {
+		svc, _, _, schemaRepo := getSvc()
+		refs := []schema.Reference{{NamespaceID: "shared", Name: "money", Version: 1}}
+		referrers := []schema.Reference{{NamespaceID: "orders", Name: "order", Version: 2}}
+		schemaRepo.On("GetVersionRef", mock.Anything, "shared", "common", int32(3)).Return(schema.VersionRef{}, nil)
+		schemaRepo.On("ListReferences", mock.Anything, "shared", "common", int32(3)).Return(refs, nil)
+		schemaRepo.On("ListReferrers", mock.Anything, "shared", "common", int32(3)).Return(referrers, nil)
+		actualRefs, actualReferrers, err := svc.ListReferences(ctx, "shared", "common", 3)
+		assert.NoError(t, err)
+		assert.Equal(t, refs, actualRefs)
+		assert.Equal(t, referrers, actualReferrers)
+	})
+}
+
+func TestSchemaCreateFromSources(t *testing.T) {
+	ctx := context.Background()
+	nsName := "orders"
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "proto"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "proto", "order.proto"), []byte("syntax = \"proto3\";"), 0644))
+	sources, err := archive.Pack(dir, ".proto")
+	assert.NoError(t, err)
+	files := map[string][]byte{"proto/order.proto": []byte("syntax = \"proto3\";")}
+	t.Run("should compile sources and create version from compiled data", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		scFile := &schema.SchemaFile{ID: "file-id"}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "FORMAT_PROTOBUF"}, nil)
+		schemaProvider.On("CompileSchema", "FORMAT_PROTOBUF", files, "proto").Return([]byte("compiled"), nil)
+		schemaProvider.On("ParseSchema", "FORMAT_PROTOBUF", []byte("compiled")).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(0), store.NoRowsErr)
+		parsedSchema.On("GetCanonicalValue").Return(scFile)
+		schemaRepo.On("GetVersionByID", mock.Anything, mock.Anything).Return(schema.VersionRef{}, store.NoRowsErr)
+		schemaRepo.On("Create", mock.Anything, nsName, "a", mock.Anything, mock.Anything, scFile).Return(int32(1), int32(5), true, nil)
+		_, err := svc.Create(ctx, nsName, "a", &schema.Metadata{ImportRoot: "proto"}, sources)
+		assert.NoError(t, err)
+		schemaProvider.AssertExpectations(t)
+	})
+	t.Run("should return invalid argument error if compile fails", func(t *testing.T) {
+		svc, nsService, schemaProvider, _ := getSvc()
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "FORMAT_AVRO"}, nil)
+		schemaProvider.On("CompileSchema", "FORMAT_AVRO", files, "").Return(nil, errors.New("compiling source files is not supported for FORMAT_AVRO"))
+		_, err := svc.Create(ctx, nsName, "a", &schema.Metadata{}, sources)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		schemaProvider.AssertNotCalled(t, "ParseSchema", mock.Anything, mock.Anything)
+	})
+	t.Run("should compile sources before checking compatibility", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "FORMAT_PROTOBUF"}, nil)
+		schemaProvider.On("CompileSchema", "FORMAT_PROTOBUF", files, "proto").Return([]byte("compiled"), nil)
+		schemaProvider.On("ParseSchema", "FORMAT_PROTOBUF", []byte("compiled")).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(0), store.NoRowsErr)
+		err := svc.CheckCompatibility(ctx, nsName, "a", &schema.Metadata{ImportRoot: "proto"}, sources)
+		assert.NoError(t, err)
+		schemaProvider.AssertExpectations(t)
+	})
+}
@@ -1,17 +1,105 @@
 package schema
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Lifecycle states of a schema version
+const (
+	VersionStateActive     = "ACTIVE"
+	VersionStateDeprecated = "DEPRECATED"
+	VersionStateDisabled   = "DISABLED"
+)
 
 type Metadata struct {
 	Authority     string
 	Format        string
 	Compatibility string
+	// Message is optional commit message of the version being created, it is not part of schema metadata
+	Message string
+	// References are schema versions the version being created depends on, they are not part of schema metadata
+	References []Reference
+	// ImportRoot is path of directory in uploaded source archive, which imports are relative to
+	ImportRoot string
 }
 
 type SchemaInfo struct {
-	ID       string `json:"id"`
-	Version  int32  `json:"version"`
-	Location string `json:"location"`
+	ID        string `json:"id"`
+	Version   int32  `json:"version"`
+	GlobalID  int32  `json:"global_id,omitempty"`
+	Location  string `json:"location"`
+	Duplicate bool   `json:"duplicate,omitempty"`
+	// Restorable is set on dry run if same schema is registered as a deleted version, creating it restores that version
+	Restorable bool `json:"restorable,omitempty"`
+	// LintIssues has lint rules with warning severity failed by the schema
+	LintIssues []LintIssue `json:"lint_issues,omitempty"`
+}
+
+// VersionRef identifies a stored schema version by its global ID
+type VersionRef struct {
+	NamespaceID string
+	Name        string
+	Version     int32
+	GlobalID    int32
+	// Deleted is set if version is deleted but retained until it's purged
+	Deleted bool
+	VersionState
+}
+
+// VersionState is lifecycle state of a schema version
+type VersionState struct {
+	State    string
+	Reason   string
+	SunsetAt *time.Time
+}
+
+// Version is a schema version along with its lifecycle state
+type Version struct {
+	Version      int32
+	ID           string
+	SchemaFileID string
+	CreatedAt    time.Time
+	Creator      string
+	Message      string
+	// Size is length of stored schema data in bytes
+	Size int64
+	VersionState
+}
+
+// Reference points to a schema version, possibly in another namespace
+type Reference struct {
+	NamespaceID string
+	Name        string
+	Version     int32
+}
+
+func (r Reference) String() string {
+	return fmt.Sprintf("%s/%s@%d", r.NamespaceID, r.Name, r.Version)
+}
+
+// ParseReference parses reference in namespace/schema@version form
+func ParseReference(s string) (Reference, error) {
+	invalidErr := status.Errorf(codes.InvalidArgument, "invalid reference %q, should be in namespace/schema@version form", s)
+	path, versionStr, ok := strings.Cut(strings.TrimSpace(s), "@")
+	if !ok {
+		return Reference{}, invalidErr
+	}
+	nsName, schemaName, ok := strings.Cut(path, "/")
+	if !ok || nsName == "" || schemaName == "" {
+		return Reference{}, invalidErr
+	}
+	version, err := strconv.ParseInt(versionStr, 10, 32)
+	if err != nil || version <= 0 {
+		return Reference{}, invalidErr
+	}
+	return Reference{NamespaceID: nsName, Name: schemaName, Version: int32(version)}, nil
 }
 
 type SchemaFile struct {
@@ -22,15 +110,29 @@ type SchemaFile struct {
 }
 
 type Repository interface {
-	Create(ctx context.Context, namespace string, schema string, metadata *Metadata, versionID string, schemaFile *SchemaFile) (version int32, err error)
+	// Create stores schema version, created is false if version with same ID is already stored.
+	// Storing data of a deleted version restores it, which is reported as created.
+	Create(ctx context.Context, namespace string, schema string, metadata *Metadata, versionID string, schemaFile *SchemaFile) (version int32, globalID int32, created bool, err error)
 	List(context.Context, string) ([]Schema, error)
 	ListVersions(context.Context, string, string) ([]int32, error)
+	ListVersionDetails(ctx context.Context, namespace, schemaName string, afterVersion int32, limit int) ([]Version, error)
 	Get(context.Context, string, string, int32) ([]byte, error)
 	GetLatestVersion(context.Context, string, string) (int32, error)
+	// GetNextVersion returns version number next stored version of schema gets, counting deleted versions as well
+	GetNextVersion(ctx context.Context, namespace, schemaName string) (int32, error)
+	GetVersionByID(ctx context.Context, versionID string) (VersionRef, error)
+	GetGlobalID(ctx context.Context, namespace, schemaName string, version int32) (int32, error)
+	GetVersionRef(ctx context.Context, namespace, schemaName string, version int32) (VersionRef, error)
+	GetByGlobalID(ctx context.Context, globalID int32) (VersionRef, error)
 	GetMetadata(context.Context, string, string) (*Metadata, error)
 	UpdateMetadata(context.Context, string, string, *Metadata) (*Metadata, error)
 	Delete(context.Context, string, string) error
 	DeleteVersion(context.Context, string, string, int32) error
+	Restore(context.Context, string, string) error
+	RestoreVersion(context.Context, string, string, int32) error
+	UpdateVersionState(ctx context.Context, namespace, schemaName string, version int32, state VersionState) (Version, error)
+	ListReferences(ctx context.Context, namespace, schemaName string, version int32) ([]Reference, error)
+	ListReferrers(ctx context.Context, namespace, schemaName string, version int32) ([]Reference, error)
 }
 
 type ParsedSchema interface {
@@ -41,8 +143,43 @@ type ParsedSchema interface {
 	GetCanonicalValue() *SchemaFile
 }
 
+// Assembler is implemented by parsed schemas that can be served along with the schemas they reference
+type Assembler interface {
+	Assemble() ([]byte, error)
+}
+
+// Format is a schema format plugin registered with schema provider.
+// Canonical value, search data and compatibility checks come from the parsed schema.
+type Format interface {
+	// Name returns format name stored in schema metadata, like FORMAT_PROTOBUF
+	Name() string
+	// ContentType returns media type of schema data served for download
+	ContentType() string
+	ParseSchema(data []byte) (ParsedSchema, error)
+}
+
+// ReferenceParser is implemented by formats supporting references to other schemas,
+// dependencies are data of referenced schema versions
+type ReferenceParser interface {
+	ParseSchemaWithReferences(data []byte, dependencies ...[]byte) (ParsedSchema, error)
+}
+
+// SourceCompiler is implemented by formats which can compile source files into schema data
+type SourceCompiler interface {
+	CompileSchema(files map[string][]byte, importRoot string, dependencies ...[]byte) ([]byte, error)
+}
+
+// Printer is implemented by formats which can render schema data in human readable form
+type Printer interface {
+	// PrintSchema returns source of schema files having path prefixed by filter, along with language of the source used for highlighting
+	PrintSchema(data []byte, filter string) (source string, language string, err error)
+}
+
+// Provider parses schema data, dependencies are data of referenced schema versions
 type Provider interface {
-	ParseSchema(format string, data []byte) (ParsedSchema, error)
+	ParseSchema(format string, data []byte, dependencies ...[]byte) (ParsedSchema, error)
+	// CompileSchema compiles source files under importRoot, like .proto files, into schema data of the format
+	CompileSchema(format string, files map[string][]byte, importRoot string, dependencies ...[]byte) ([]byte, error)
 }
 
 type Cache interface {
@@ -4,18 +4,30 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/raystack/stencil/core/namespace"
 	"github.com/raystack/stencil/internal/store"
+	"github.com/raystack/stencil/pkg/actor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// ErrInvalidVersionState is returned when setting unknown lifecycle state on a version
+var ErrInvalidVersionState = status.Error(codes.InvalidArgument, "version state should be one of ACTIVE, DEPRECATED or DISABLED")
+
+// ErrInvalidPageToken is returned when listing versions with malformed page token
+var ErrInvalidPageToken = status.Error(codes.InvalidArgument, "invalid page token")
+
 func NewService(repo Repository, provider Provider, nsSvc NamespaceService, cache Cache) *Service {
 	return &Service{
 		repo:             repo,
 		provider:         provider,
 		cache:            cache,
 		namespaceService: nsSvc,
+		events:           newBroker(),
 	}
 }
 
@@ -28,6 +40,7 @@ type Service struct {
 	repo             Repository
 	cache            Cache
 	namespaceService NamespaceService
+	events           *broker
 }
 
 func (s *Service) cachedGetSchema(ctx context.Context, nsName, schemaName string, version int32) ([]byte, error) {
@@ -46,62 +59,284 @@ func (s *Service) cachedGetSchema(ctx context.Context, nsName, schemaName string
 	return getBytes(val), nil
 }
 
-func (s *Service) CheckCompatibility(ctx context.Context, nsName, schemaName, compatibility string, data []byte) error {
+// CheckCompatibility checks data against schema versions stored earlier and lint rules of the namespace.
+// Lint failure and compatibility violations are both reported, returned error wraps *LintErr and *CompatibilityErr as applicable.
+func (s *Service) CheckCompatibility(ctx context.Context, nsName, schemaName string, metadata *Metadata, data []byte) error {
+	return s.check(ctx, nsName, schemaName, metadata, data, func(format, compatibility string, current ParsedSchema) error {
+		return s.checkCompatibility(ctx, nsName, schemaName, format, compatibility, current)
+	})
+}
+
+// CheckCompatibilityWithVersion checks data against given version only, transitive compatibility modes are checked
+// as their non transitive modes. Errors are reported same as CheckCompatibility.
+func (s *Service) CheckCompatibilityWithVersion(ctx context.Context, nsName, schemaName string, version int32, metadata *Metadata, data []byte) error {
+	return s.check(ctx, nsName, schemaName, metadata, data, func(format, compatibility string, current ParsedSchema) error {
+		prev, err := s.cachedParseSchema(ctx, nsName, schemaName, format, version)
+		if err != nil {
+			return err
+		}
+		compatibility = strings.TrimSuffix(compatibility, "_TRANSITIVE")
+		return newCompatibilityErr(compatibility, getCompatibilityChecker(compatibility)(current, []ParsedSchema{prev}))
+	})
+}
+
+// check parses data as it would be created and runs lint rules along with given compatibility check
+func (s *Service) check(ctx context.Context, nsName, schemaName string, metadata *Metadata, data []byte,
+	checkFn func(format, compatibility string, current ParsedSchema) error) error {
 	ns, err := s.namespaceService.Get(ctx, nsName)
 	if err != nil {
 		return err
 	}
-	compatibility = getNonEmpty(compatibility, ns.Compatibility)
-	parsedSchema, err := s.provider.ParseSchema(ns.Format, data)
+	format := getNonEmpty(metadata.Format, ns.Format)
+	compatibility := getNonEmpty(metadata.Compatibility, ns.Compatibility)
+	refs := normalizeReferences(metadata.References)
+	if err := s.checkReferences(ctx, nsName, schemaName, format, refs); err != nil {
+		return err
+	}
+	data, err = s.compile(ctx, format, data, metadata.ImportRoot, refs)
+	if err != nil {
+		return err
+	}
+	parsedSchema, err := s.parse(ctx, format, data, refs)
 	if err != nil {
 		return err
 	}
-	return s.checkCompatibility(ctx, nsName, schemaName, ns.Format, compatibility, parsedSchema)
+	_, lintErr := lint(ns, parsedSchema)
+	compErr := checkFn(format, compatibility, parsedSchema)
+	var violationsErr *CompatibilityErr
+	if compErr != nil && !errors.As(compErr, &violationsErr) {
+		return compErr
+	}
+	return errors.Join(lintErr, compErr)
+}
+
+func (s *Service) cachedParseSchema(ctx context.Context, nsName, schemaName, format string, version int32) (ParsedSchema, error) {
+	key := parsedSchemaKeyFunc(nsName, schemaName, format, version)
+	if val, found := s.cache.Get(key); found {
+		if parsed, ok := val.(ParsedSchema); ok {
+			return parsed, nil
+		}
+	}
+	data, err := s.cachedGetSchema(ctx, nsName, schemaName, version)
+	if err != nil {
+		return nil, err
+	}
+	refs, err := s.repo.ListReferences(ctx, nsName, schemaName, version)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := s.parse(ctx, format, data, refs)
+	if err != nil {
+		return nil, err
+	}
+	s.cache.Set(key, parsed, int64(len(data)))
+	return parsed, nil
+}
+
+// getPreviousSchemas returns parsed schemas to validate against.
+// Transitive compatibility modes need every stored version, others only need the latest one.
+func (s *Service) getPreviousSchemas(ctx context.Context, nsName, schemaName, compatibility string) ([]ParsedSchema, error) {
+	var versions []int32
+	if isTransitive(compatibility) {
+		allVersions, err := s.repo.ListVersions(ctx, nsName, schemaName)
+		if err != nil {
+			return nil, err
+		}
+		versions = allVersions
+	} else {
+		latest, err := s.repo.GetLatestVersion(ctx, nsName, schemaName)
+		if err != nil {
+			return nil, err
+		}
+		versions = []int32{latest}
+	}
+	if len(versions) == 0 {
+		return nil, nil
+	}
+	meta, err := s.repo.GetMetadata(ctx, nsName, schemaName)
+	if err != nil {
+		return nil, err
+	}
+	prevSchemas := make([]ParsedSchema, 0, len(versions))
+	for _, version := range versions {
+		prev, err := s.cachedParseSchema(ctx, nsName, schemaName, meta.Format, version)
+		if err != nil {
+			return nil, err
+		}
+		prevSchemas = append(prevSchemas, prev)
+	}
+	return prevSchemas, nil
 }
 
 func (s *Service) checkCompatibility(ctx context.Context, nsName, schemaName, format, compatibility string, current ParsedSchema) error {
-	prevMeta, prevSchemaData, err := s.GetLatest(ctx, nsName, schemaName)
+	prevSchemas, err := s.getPreviousSchemas(ctx, nsName, schemaName, compatibility)
 	if err != nil {
 		if errors.Is(err, store.NoRowsErr) {
 			return nil
 		}
 		return err
 	}
-	prevSchema, err := s.provider.ParseSchema(prevMeta.Format, prevSchemaData)
+	checkerFn := getCompatibilityChecker(compatibility)
+	return newCompatibilityErr(compatibility, checkerFn(current, prevSchemas))
+}
+
+func (s *Service) prepare(ctx context.Context, nsName string, schemaName string, metadata *Metadata, data []byte) (*Metadata, string, *SchemaFile, []LintIssue, error) {
+	ns, err := s.namespaceService.Get(ctx, nsName)
+	if err != nil {
+		return nil, "", nil, nil, err
+	}
+	format := getNonEmpty(metadata.Format, ns.Format)
+	compatibility := getNonEmpty(metadata.Compatibility, ns.Compatibility)
+	refs := normalizeReferences(metadata.References)
+	if err := s.checkReferences(ctx, nsName, schemaName, format, refs); err != nil {
+		return nil, "", nil, nil, err
+	}
+	data, err = s.compile(ctx, format, data, metadata.ImportRoot, refs)
+	if err != nil {
+		return nil, "", nil, nil, err
+	}
+	parsedSchema, err := s.parse(ctx, format, data, refs)
+	if err != nil {
+		return nil, "", nil, nil, err
+	}
+	lintIssues, err := lint(ns, parsedSchema)
+	if err != nil {
+		return nil, "", nil, nil, err
+	}
+	if err := s.checkCompatibility(ctx, nsName, schemaName, format, compatibility, parsedSchema); err != nil {
+		return nil, "", nil, nil, err
+	}
+	sf := parsedSchema.GetCanonicalValue()
+	mergedMetadata := &Metadata{
+		Authority:     actor.FromContext(ctx),
+		Format:        format,
+		Compatibility: compatibility,
+		Message:       metadata.Message,
+		References:    refs,
+	}
+	return mergedMetadata, getIDforSchema(nsName, schemaName, sf.ID, refs), sf, lintIssues, nil
+}
+
+// checkNotDisabled refuses registering data of a disabled version again
+func (s *Service) checkNotDisabled(ctx context.Context, versionID string) error {
+	ref, err := s.repo.GetVersionByID(ctx, versionID)
+	if errors.Is(err, store.NoRowsErr) {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
-	checkerFn := getCompatibilityChecker(compatibility)
-	return checkerFn(current, []ParsedSchema{prevSchema})
+	return checkRefNotDisabled(ref)
+}
+
+func checkRefNotDisabled(ref VersionRef) error {
+	if ref.State != VersionStateDisabled {
+		return nil
+	}
+	return status.Errorf(codes.FailedPrecondition, "version %d of %s is disabled: %s", ref.Version, ref.Name, ref.Reason)
 }
 
 func (s *Service) Create(ctx context.Context, nsName string, schemaName string, metadata *Metadata, data []byte) (SchemaInfo, error) {
+	var scInfo SchemaInfo
+	mergedMetadata, versionID, sf, lintIssues, err := s.prepare(ctx, nsName, schemaName, metadata, data)
+	if err != nil {
+		return scInfo, err
+	}
+	if err := s.checkNotDisabled(ctx, versionID); err != nil {
+		return scInfo, err
+	}
+	version, globalID, created, err := s.repo.Create(ctx, nsName, schemaName, mergedMetadata, versionID, sf)
+	if err == nil && created {
+		s.events.publish(Event{
+			Type:        EventCreate,
+			NamespaceID: nsName,
+			SchemaName:  schemaName,
+			Version:     version,
+			VersionID:   versionID,
+			Actor:       actor.FromContext(ctx),
+			Summary:     fmt.Sprintf("version %d created", version),
+		})
+	}
+	return SchemaInfo{
+		Version:    version,
+		ID:         versionID,
+		GlobalID:   globalID,
+		Location:   getLocation(nsName, schemaName, version),
+		LintIssues: lintIssues,
+	}, err
+}
+
+// CreateDryRun runs all validations done by Create without storing the schema.
+// Returns version and ID the schema would get, Duplicate is set if same schema is already registered
+// and Restorable if it's registered as a deleted version.
+func (s *Service) CreateDryRun(ctx context.Context, nsName string, schemaName string, metadata *Metadata, data []byte) (SchemaInfo, error) {
+	var scInfo SchemaInfo
+	_, versionID, _, lintIssues, err := s.prepare(ctx, nsName, schemaName, metadata, data)
+	if err != nil {
+		return scInfo, err
+	}
+	ref, err := s.repo.GetVersionByID(ctx, versionID)
+	if err == nil {
+		if err := checkRefNotDisabled(ref); err != nil {
+			return scInfo, err
+		}
+		return SchemaInfo{
+			Version:    ref.Version,
+			ID:         versionID,
+			GlobalID:   ref.GlobalID,
+			Location:   getLocation(nsName, schemaName, ref.Version),
+			Duplicate:  !ref.Deleted,
+			Restorable: ref.Deleted,
+			LintIssues: lintIssues,
+		}, nil
+	}
+	if !errors.Is(err, store.NoRowsErr) {
+		return scInfo, err
+	}
+	version, err := s.repo.GetNextVersion(ctx, nsName, schemaName)
+	if err != nil {
+		return scInfo, err
+	}
+	return SchemaInfo{
+		Version:    version,
+		ID:         versionID,
+		Location:   getLocation(nsName, schemaName, version),
+		LintIssues: lintIssues,
+	}, nil
+}
+
+// LookupVersion finds live version registered with same content as data, without running lint or compatibility checks.
+// Returns not found error if schema is not registered or registered version is deleted.
+func (s *Service) LookupVersion(ctx context.Context, nsName string, schemaName string, metadata *Metadata, data []byte) (SchemaInfo, error) {
 	var scInfo SchemaInfo
 	ns, err := s.namespaceService.Get(ctx, nsName)
 	if err != nil {
 		return scInfo, err
 	}
 	format := getNonEmpty(metadata.Format, ns.Format)
-	compatibility := getNonEmpty(metadata.Compatibility, ns.Compatibility)
-	parsedSchema, err := s.provider.ParseSchema(format, data)
+	refs := normalizeReferences(metadata.References)
+	data, err = s.compile(ctx, format, data, metadata.ImportRoot, refs)
 	if err != nil {
 		return scInfo, err
 	}
-	if err := s.checkCompatibility(ctx, nsName, schemaName, format, compatibility, parsedSchema); err != nil {
+	parsedSchema, err := s.parse(ctx, format, data, refs)
+	if err != nil {
 		return scInfo, err
 	}
-	sf := parsedSchema.GetCanonicalValue()
-	mergedMetadata := &Metadata{
-		Format:        format,
-		Compatibility: compatibility,
+	versionID := getIDforSchema(nsName, schemaName, parsedSchema.GetCanonicalValue().ID, refs)
+	ref, err := s.repo.GetVersionByID(ctx, versionID)
+	if err != nil {
+		return scInfo, err
+	}
+	if ref.Deleted {
+		return scInfo, store.NoRowsErr.WithErr(fmt.Errorf("version %d of %s is deleted", ref.Version, schemaName), "version")
 	}
-	versionID := getIDforSchema(nsName, schemaName, sf.ID)
-	version, err := s.repo.Create(ctx, nsName, schemaName, mergedMetadata, versionID, sf)
 	return SchemaInfo{
-		Version:  version,
+		Version:  ref.Version,
 		ID:       versionID,
-		Location: fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/versions/%d", nsName, schemaName, version),
-	}, err
+		GlobalID: ref.GlobalID,
+		Location: getLocation(nsName, schemaName, ref.Version),
+	}, nil
 }
 
 func (s *Service) withMetadata(ctx context.Context, namespace, schemaName string, getData func() ([]byte, error)) (*Metadata, []byte, error) {
@@ -119,11 +354,42 @@ func (s *Service) Get(ctx context.Context, namespace string, schemaName string,
 }
 
 func (s *Service) Delete(ctx context.Context, namespace string, schemaName string) error {
-	return s.repo.Delete(ctx, namespace, schemaName)
+	err := s.repo.Delete(ctx, namespace, schemaName)
+	if err == nil {
+		s.events.publish(Event{
+			Type:        EventDelete,
+			NamespaceID: namespace,
+			SchemaName:  schemaName,
+			Actor:       actor.FromContext(ctx),
+			Summary:     "schema deleted",
+		})
+	}
+	return err
 }
 
 func (s *Service) DeleteVersion(ctx context.Context, namespace string, schemaName string, version int32) error {
-	return s.repo.DeleteVersion(ctx, namespace, schemaName, version)
+	err := s.repo.DeleteVersion(ctx, namespace, schemaName, version)
+	if err == nil {
+		s.events.publish(Event{
+			Type:        EventDeleteVersion,
+			NamespaceID: namespace,
+			SchemaName:  schemaName,
+			Version:     version,
+			Actor:       actor.FromContext(ctx),
+			Summary:     fmt.Sprintf("version %d deleted", version),
+		})
+	}
+	return err
+}
+
+// Restore undoes deletion of schema along with versions deleted with it
+func (s *Service) Restore(ctx context.Context, namespace string, schemaName string) error {
+	return s.repo.Restore(ctx, namespace, schemaName)
+}
+
+// RestoreVersion undoes deletion of the schema version
+func (s *Service) RestoreVersion(ctx context.Context, namespace string, schemaName string, version int32) error {
+	return s.repo.RestoreVersion(ctx, namespace, schemaName, version)
 }
 
 func (s *Service) GetLatest(ctx context.Context, namespace string, schemaName string) (*Metadata, []byte, error) {
@@ -134,12 +400,67 @@ func (s *Service) GetLatest(ctx context.Context, namespace string, schemaName st
 	return s.Get(ctx, namespace, schemaName, version)
 }
 
+func (s *Service) GetGlobalID(ctx context.Context, namespace, schemaName string, version int32) (int32, error) {
+	return s.repo.GetGlobalID(ctx, namespace, schemaName, version)
+}
+
+// GetVersionRef returns reference of the schema version, Deleted is set if version is deleted but not purged yet
+func (s *Service) GetVersionRef(ctx context.Context, namespace, schemaName string, version int32) (VersionRef, error) {
+	return s.repo.GetVersionRef(ctx, namespace, schemaName, version)
+}
+
+// GetLatestVersionRef returns reference of the latest version of the schema
+func (s *Service) GetLatestVersionRef(ctx context.Context, namespace, schemaName string) (VersionRef, error) {
+	version, err := s.repo.GetLatestVersion(ctx, namespace, schemaName)
+	if err != nil {
+		return VersionRef{}, err
+	}
+	return s.repo.GetVersionRef(ctx, namespace, schemaName, version)
+}
+
+// GetByGlobalID returns schema version referred by global ID along with its metadata and data
+func (s *Service) GetByGlobalID(ctx context.Context, globalID int32) (VersionRef, *Metadata, []byte, error) {
+	ref, err := s.repo.GetByGlobalID(ctx, globalID)
+	if err != nil {
+		return ref, nil, nil, err
+	}
+	meta, data, err := s.Get(ctx, ref.NamespaceID, ref.Name, ref.Version)
+	return ref, meta, data, err
+}
+
 func (s *Service) GetMetadata(ctx context.Context, namespace, schemaName string) (*Metadata, error) {
 	return s.repo.GetMetadata(ctx, namespace, schemaName)
 }
 
 func (s *Service) UpdateMetadata(ctx context.Context, namespace, schemaName string, meta *Metadata) (*Metadata, error) {
-	return s.repo.UpdateMetadata(ctx, namespace, schemaName, meta)
+	previous, err := s.repo.GetMetadata(ctx, namespace, schemaName)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.repo.UpdateMetadata(ctx, namespace, schemaName, meta)
+	if err == nil {
+		s.events.publish(Event{
+			Type:        EventUpdateMetadata,
+			NamespaceID: namespace,
+			SchemaName:  schemaName,
+			Actor:       actor.FromContext(ctx),
+			Summary:     fmt.Sprintf("compatibility changed from %s to %s", previous.Compatibility, updated.Compatibility),
+		})
+	}
+	return updated, err
+}
+
+// AddEventHandler registers handler called for every change made to schemas through this server instance
+func (s *Service) AddEventHandler(h EventHandler) {
+	s.events.addHandler(h)
+}
+
+// Watch returns events of changes made to schemas in the namespace through this server instance.
+// Changes made through other server instances are not included, clients behind a load balancer
+// should reload schemas on reconnect. Events are filtered by schemaName if specified.
+// Channel is closed once ctx is done or if the receiver is not keeping up with the events.
+func (s *Service) Watch(ctx context.Context, namespace, schemaName string) <-chan Event {
+	return s.events.subscribe(ctx, namespace, schemaName)
 }
 
 func (s *Service) List(ctx context.Context, namespaceID string) ([]Schema, error) {
@@ -150,7 +471,54 @@ func (s *Service) ListVersions(ctx context.Context, namespaceID string, schemaNa
 	return s.repo.ListVersions(ctx, namespaceID, schemaName)
 }
 
-func getIDforSchema(ns, schema, dataUUID string) string {
+// ListVersionDetails returns page of versions of the schema in ascending order, along with token to fetch next page.
+// All versions are returned if page size is not set. Next page token is empty if there are no more versions.
+func (s *Service) ListVersionDetails(ctx context.Context, namespaceID string, schemaName string, pageSize int, pageToken string) ([]Version, string, error) {
+	var afterVersion int64
+	if pageToken != "" {
+		var err error
+		afterVersion, err = strconv.ParseInt(pageToken, 10, 32)
+		if err != nil || afterVersion <= 0 {
+			return nil, "", ErrInvalidPageToken
+		}
+	}
+	if pageSize <= 0 {
+		versions, err := s.repo.ListVersionDetails(ctx, namespaceID, schemaName, int32(afterVersion), 0)
+		return versions, "", err
+	}
+	// fetch one extra version to know whether next page exists
+	versions, err := s.repo.ListVersionDetails(ctx, namespaceID, schemaName, int32(afterVersion), pageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(versions) <= pageSize {
+		return versions, "", nil
+	}
+	versions = versions[:pageSize]
+	return versions, strconv.Itoa(int(versions[pageSize-1].Version)), nil
+}
+
+// UpdateVersionState sets lifecycle state of the version, reason and sunset time are cleared for active versions
+func (s *Service) UpdateVersionState(ctx context.Context, namespaceID, schemaName string, version int32, state VersionState) (Version, error) {
+	switch state.State {
+	case VersionStateActive:
+		state = VersionState{State: VersionStateActive}
+	case VersionStateDeprecated, VersionStateDisabled:
+		if state.SunsetAt != nil {
+			sunsetAt := state.SunsetAt.UTC()
+			state.SunsetAt = &sunsetAt
+		}
+	default:
+		return Version{}, ErrInvalidVersionState
+	}
+	return s.repo.UpdateVersionState(ctx, namespaceID, schemaName, version, state)
+}
+
+// getIDforSchema derives version ID from schema data and references, same data with different references gets different ID
+func getIDforSchema(ns, schema, dataUUID string, refs []Reference) string {
 	key := fmt.Sprintf("%s-%s-%s", ns, schema, dataUUID)
+	for _, ref := range refs {
+		key += "-" + ref.String()
+	}
 	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(key)).String()
 }
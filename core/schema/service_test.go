@@ -3,14 +3,20 @@ package schema_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/raystack/stencil/core/namespace"
 	"github.com/raystack/stencil/core/schema"
 	"github.com/raystack/stencil/core/schema/mocks"
 	"github.com/raystack/stencil/internal/store"
+	"github.com/raystack/stencil/pkg/actor"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func getSvc() (*schema.Service, *mocks.NamespaceService, *mocks.SchemaProvider, *mocks.SchemaRepository) {
@@ -69,13 +75,68 @@ func TestSchemaCreate(t *testing.T) {
 		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
 		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(2), store.NoRowsErr)
 		parsedSchema.On("GetCanonicalValue").Return(scFile)
-		schemaRepo.On("Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int32(1), nil)
+		schemaRepo.On("GetVersionByID", mock.Anything, mock.Anything).Return(schema.VersionRef{}, store.NoRowsErr)
+		schemaRepo.On("Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int32(1), int32(12), true, nil)
 		scInfo, err := svc.Create(ctx, nsName, "a", &schema.Metadata{}, data)
 		assert.NoError(t, err)
 		assert.Equal(t, scInfo.Version, int32(1))
+		assert.Equal(t, scInfo.GlobalID, int32(12))
 		schemaRepo.AssertExpectations(t)
 		nsService.AssertExpectations(t)
 	})
+	t.Run("should publish create event only if version is stored", func(t *testing.T) {
+		for _, created := range []bool{false, true} {
+			svc, nsService, schemaProvider, schemaRepo := getSvc()
+			parsedSchema := &mocks.ParsedSchema{}
+			nsName := "testNamespace"
+			data := []byte("data")
+			nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+			schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+			schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(2), store.NoRowsErr)
+			parsedSchema.On("GetCanonicalValue").Return(&schema.SchemaFile{})
+			schemaRepo.On("GetVersionByID", mock.Anything, mock.Anything).Return(schema.VersionRef{}, store.NoRowsErr)
+			schemaRepo.On("Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int32(1), int32(12), created, nil)
+			watchCtx, cancel := context.WithCancel(ctx)
+			events := svc.Watch(watchCtx, nsName, "a")
+			_, err := svc.Create(ctx, nsName, "a", &schema.Metadata{}, data)
+			assert.NoError(t, err)
+			assert.Equal(t, created, len(events) == 1, "created: %v", created)
+			cancel()
+		}
+	})
+	t.Run("should set authenticated caller as schema authority along with version message", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		scFile := &schema.SchemaFile{}
+		parsedSchema := &mocks.ParsedSchema{}
+		nsName := "testNamespace"
+		data := []byte("data")
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(2), store.NoRowsErr)
+		parsedSchema.On("GetCanonicalValue").Return(scFile)
+		expectedMeta := &schema.Metadata{Authority: "user@example.com", Format: "protobuf", Message: "add field"}
+		schemaRepo.On("GetVersionByID", mock.Anything, mock.Anything).Return(schema.VersionRef{}, store.NoRowsErr)
+		schemaRepo.On("Create", mock.Anything, nsName, "a", expectedMeta, mock.Anything, scFile).Return(int32(1), int32(12), true, nil)
+		_, err := svc.Create(actor.WithActor(ctx, "user@example.com"), nsName, "a", &schema.Metadata{Message: "add field"}, data)
+		assert.NoError(t, err)
+		schemaRepo.AssertExpectations(t)
+	})
+	t.Run("should refuse registering data of disabled version", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		nsName := "testNamespace"
+		data := []byte("data")
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(2), store.NoRowsErr)
+		parsedSchema.On("GetCanonicalValue").Return(&schema.SchemaFile{})
+		disabled := schema.VersionRef{NamespaceID: nsName, Name: "a", Version: 1, VersionState: schema.VersionState{State: schema.VersionStateDisabled, Reason: "leaks pii"}}
+		schemaRepo.On("GetVersionByID", mock.Anything, mock.Anything).Return(disabled, nil)
+		_, err := svc.Create(ctx, nsName, "a", &schema.Metadata{}, data)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "leaks pii")
+		schemaRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
 	t.Run("should return error if unable to get prev latest schema", func(t *testing.T) {
 		svc, nsService, schemaProvider, schemaRepo := getSvc()
 		parsedSchema := &mocks.ParsedSchema{}
@@ -99,6 +160,7 @@ func TestSchemaCreate(t *testing.T) {
 		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf", Compatibility: "COMPATIBILITY_BACKWARD"}, nil)
 		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil).Once()
 		schemaRepo.On("GetMetadata", mock.Anything, nsName, "a").Return(&schema.Metadata{Format: "protobuf"}, nil)
+		schemaRepo.On("ListReferences", mock.Anything, nsName, "a", mock.Anything).Return(nil, nil)
 		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(3), nil)
 		schemaRepo.On("Get", mock.Anything, nsName, "a", int32(3)).Return(prevData, nil)
 		schemaProvider.On("ParseSchema", "protobuf", prevData).Return(prevParsedSchema, errors.New("parse error")).Once()
@@ -133,12 +195,16 @@ func TestSchemaCreate(t *testing.T) {
 				nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf", Compatibility: "COMPATIBILITY_BACKWARD"}, nil)
 				schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil).Once()
 				schemaRepo.On("GetMetadata", mock.Anything, nsName, "a").Return(&schema.Metadata{Format: "protobuf"}, nil)
+				schemaRepo.On("ListReferences", mock.Anything, nsName, "a", mock.Anything).Return(nil, nil)
 				schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(3), nil)
 				schemaRepo.On("Get", mock.Anything, nsName, "a", int32(3)).Return(prevData, nil)
 				schemaProvider.On("ParseSchema", "protobuf", prevData).Return(prevParsedSchema, nil).Once()
 				parsedSchema.On(test.compFn, prevParsedSchema).Return(compErr)
 				_, err := svc.Create(ctx, nsName, "a", &schema.Metadata{Compatibility: test.compatibility}, data)
 				assert.Error(t, err)
+				var violationsErr *schema.CompatibilityErr
+				assert.ErrorAs(t, err, &violationsErr)
+				assert.Equal(t, []schema.Violation{{Kind: "incompatibleChange", Message: "compatibilit error", Compatibility: test.compatibility}}, violationsErr.Violations)
 				schemaRepo.AssertExpectations(t)
 				nsService.AssertExpectations(t)
 				parsedSchema.AssertExpectations(t)
@@ -147,6 +213,231 @@ func TestSchemaCreate(t *testing.T) {
 	})
 }
 
+func TestSchemaCreateDryRun(t *testing.T) {
+	ctx := context.Background()
+	nsName := "testNamespace"
+	data := []byte("data")
+	versionID := uuid.NewSHA1(uuid.NameSpaceOID, []byte("testNamespace-a-fileID")).String()
+	t.Run("should return next version without creating schema", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(2), nil)
+		parsedSchema.On("GetCanonicalValue").Return(&schema.SchemaFile{ID: "fileID"})
+		schemaRepo.On("GetMetadata", mock.Anything, nsName, "a").Return(&schema.Metadata{Format: "protobuf"}, nil)
+		schemaRepo.On("ListReferences", mock.Anything, nsName, "a", mock.Anything).Return(nil, nil)
+		schemaRepo.On("Get", mock.Anything, nsName, "a", int32(2)).Return([]byte("prev"), nil)
+		prevParsedSchema := &mocks.ParsedSchema{}
+		schemaProvider.On("ParseSchema", "protobuf", []byte("prev")).Return(prevParsedSchema, nil)
+		schemaRepo.On("GetVersionByID", mock.Anything, versionID).Return(schema.VersionRef{}, store.NoRowsErr)
+		schemaRepo.On("GetNextVersion", mock.Anything, nsName, "a").Return(int32(3), nil)
+		scInfo, err := svc.CreateDryRun(ctx, nsName, "a", &schema.Metadata{}, data)
+		assert.NoError(t, err)
+		assert.Equal(t, schema.SchemaInfo{ID: versionID, Version: 3, Location: "/v1beta1/namespaces/testNamespace/schemas/a/versions/3"}, scInfo)
+		schemaRepo.AssertExpectations(t)
+		schemaRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("should return first version if schema not present", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(0), store.NoRowsErr)
+		parsedSchema.On("GetCanonicalValue").Return(&schema.SchemaFile{ID: "fileID"})
+		schemaRepo.On("GetVersionByID", mock.Anything, versionID).Return(schema.VersionRef{}, store.NoRowsErr)
+		schemaRepo.On("GetNextVersion", mock.Anything, nsName, "a").Return(int32(1), nil)
+		scInfo, err := svc.CreateDryRun(ctx, nsName, "a", &schema.Metadata{}, data)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), scInfo.Version)
+		assert.False(t, scInfo.Duplicate)
+	})
+	t.Run("should return version after deleted latest version", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		// version 2 is deleted, so version 1 is latest one
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(1), nil)
+		schemaRepo.On("GetMetadata", mock.Anything, nsName, "a").Return(&schema.Metadata{Format: "protobuf"}, nil)
+		schemaRepo.On("ListReferences", mock.Anything, nsName, "a", mock.Anything).Return(nil, nil)
+		schemaRepo.On("Get", mock.Anything, nsName, "a", int32(1)).Return([]byte("prev"), nil)
+		schemaProvider.On("ParseSchema", "protobuf", []byte("prev")).Return(&mocks.ParsedSchema{}, nil)
+		parsedSchema.On("GetCanonicalValue").Return(&schema.SchemaFile{ID: "fileID"})
+		schemaRepo.On("GetVersionByID", mock.Anything, versionID).Return(schema.VersionRef{}, store.NoRowsErr)
+		schemaRepo.On("GetNextVersion", mock.Anything, nsName, "a").Return(int32(3), nil)
+		scInfo, err := svc.CreateDryRun(ctx, nsName, "a", &schema.Metadata{}, data)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), scInfo.Version)
+		assert.Equal(t, "/v1beta1/namespaces/testNamespace/schemas/a/versions/3", scInfo.Location)
+	})
+	t.Run("should report duplicate if schema already registered", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(0), store.NoRowsErr)
+		parsedSchema.On("GetCanonicalValue").Return(&schema.SchemaFile{ID: "fileID"})
+		schemaRepo.On("GetVersionByID", mock.Anything, versionID).Return(schema.VersionRef{NamespaceID: nsName, Name: "a", Version: 1, GlobalID: 4}, nil)
+		scInfo, err := svc.CreateDryRun(ctx, nsName, "a", &schema.Metadata{}, data)
+		assert.NoError(t, err)
+		assert.Equal(t, schema.SchemaInfo{ID: versionID, Version: 1, GlobalID: 4, Location: "/v1beta1/namespaces/testNamespace/schemas/a/versions/1", Duplicate: true}, scInfo)
+	})
+	t.Run("should report restorable instead of duplicate if schema registered as deleted version", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(0), store.NoRowsErr)
+		parsedSchema.On("GetCanonicalValue").Return(&schema.SchemaFile{ID: "fileID"})
+		schemaRepo.On("GetVersionByID", mock.Anything, versionID).Return(schema.VersionRef{NamespaceID: nsName, Name: "a", Version: 1, GlobalID: 4, Deleted: true}, nil)
+		scInfo, err := svc.CreateDryRun(ctx, nsName, "a", &schema.Metadata{}, data)
+		assert.NoError(t, err)
+		assert.Equal(t, schema.SchemaInfo{ID: versionID, Version: 1, GlobalID: 4, Location: "/v1beta1/namespaces/testNamespace/schemas/a/versions/1", Restorable: true}, scInfo)
+	})
+	t.Run("should return error if compatibility check fails", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		prevParsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf", Compatibility: "COMPATIBILITY_BACKWARD"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("GetLatestVersion", mock.Anything, nsName, "a").Return(int32(1), nil)
+		schemaRepo.On("GetMetadata", mock.Anything, nsName, "a").Return(&schema.Metadata{Format: "protobuf"}, nil)
+		schemaRepo.On("ListReferences", mock.Anything, nsName, "a", mock.Anything).Return(nil, nil)
+		schemaRepo.On("Get", mock.Anything, nsName, "a", int32(1)).Return([]byte("prev"), nil)
+		schemaProvider.On("ParseSchema", "protobuf", []byte("prev")).Return(prevParsedSchema, nil)
+		parsedSchema.On("IsBackwardCompatible", prevParsedSchema).Return(errors.New("incompatible"))
+		_, err := svc.CreateDryRun(ctx, nsName, "a", &schema.Metadata{}, data)
+		assert.Error(t, err)
+		schemaRepo.AssertNotCalled(t, "GetVersionByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestLookupVersion(t *testing.T) {
+	ctx := context.Background()
+	nsName := "testNamespace"
+	data := []byte("data")
+	versionID := uuid.NewSHA1(uuid.NameSpaceOID, []byte("testNamespace-a-fileID")).String()
+	t.Run("should return registered version without running checks", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf", Compatibility: "COMPATIBILITY_BACKWARD"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		parsedSchema.On("GetCanonicalValue").Return(&schema.SchemaFile{ID: "fileID"})
+		schemaRepo.On("GetVersionByID", mock.Anything, versionID).Return(schema.VersionRef{NamespaceID: nsName, Name: "a", Version: 2, GlobalID: 4}, nil)
+		scInfo, err := svc.LookupVersion(ctx, nsName, "a", &schema.Metadata{}, data)
+		assert.NoError(t, err)
+		assert.Equal(t, schema.SchemaInfo{ID: versionID, Version: 2, GlobalID: 4, Location: "/v1beta1/namespaces/testNamespace/schemas/a/versions/2"}, scInfo)
+		schemaRepo.AssertNotCalled(t, "GetLatestVersion", mock.Anything, mock.Anything, mock.Anything)
+		parsedSchema.AssertNotCalled(t, "IsBackwardCompatible", mock.Anything)
+	})
+	t.Run("should return not found error if registered version is deleted", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		parsedSchema.On("GetCanonicalValue").Return(&schema.SchemaFile{ID: "fileID"})
+		schemaRepo.On("GetVersionByID", mock.Anything, versionID).Return(schema.VersionRef{NamespaceID: nsName, Name: "a", Version: 2, GlobalID: 4, Deleted: true}, nil)
+		_, err := svc.LookupVersion(ctx, nsName, "a", &schema.Metadata{}, data)
+		assert.ErrorIs(t, err, store.NoRowsErr)
+	})
+}
+
+func TestSchemaCheckCompatibility(t *testing.T) {
+	ctx := context.Background()
+	nsName := "testNamespace"
+	schemaName := "a"
+	data := []byte("data")
+	t.Run("should validate against all previous versions for transitive compatibility", func(t *testing.T) {
+		for _, test := range []struct {
+			compatibility string
+			compFn        string
+		}{
+			{"COMPATIBILITY_BACKWARD_TRANSITIVE", "IsBackwardCompatible"},
+			{"COMPATIBILITY_FORWARD_TRANSITIVE", "IsForwardCompatible"},
+			{"COMPATIBILITY_FULL_TRANSITIVE", "IsFullCompatible"},
+		} {
+			t.Run(test.compatibility, func(t *testing.T) {
+				svc, nsService, schemaProvider, schemaRepo := getSvc()
+				parsedSchema := &mocks.ParsedSchema{}
+				nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+				schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil).Once()
+				schemaRepo.On("ListVersions", mock.Anything, nsName, schemaName).Return([]int32{1, 2, 3}, nil)
+				schemaRepo.On("GetMetadata", mock.Anything, nsName, schemaName).Return(&schema.Metadata{Format: "protobuf"}, nil)
+				schemaRepo.On("ListReferences", mock.Anything, nsName, schemaName, mock.Anything).Return(nil, nil)
+				for _, version := range []int32{1, 2, 3} {
+					prevData := []byte(fmt.Sprintf("prev data %d", version))
+					prevParsedSchema := &mocks.ParsedSchema{}
+					schemaRepo.On("Get", mock.Anything, nsName, schemaName, version).Return(prevData, nil)
+					schemaProvider.On("ParseSchema", "protobuf", prevData).Return(prevParsedSchema, nil).Once()
+					var compErr error
+					if version == 1 {
+						compErr = errors.New("field removed in version 1 added back")
+					}
+					parsedSchema.On(test.compFn, prevParsedSchema).Return(compErr).Once()
+				}
+				err := svc.CheckCompatibility(ctx, nsName, schemaName, &schema.Metadata{Compatibility: test.compatibility}, data)
+				var violationsErr *schema.CompatibilityErr
+				assert.ErrorAs(t, err, &violationsErr)
+				assert.Equal(t, []schema.Violation{{Kind: "incompatibleChange", Message: "field removed in version 1 added back", Compatibility: test.compatibility}}, violationsErr.Violations)
+				schemaRepo.AssertExpectations(t)
+				schemaProvider.AssertExpectations(t)
+				parsedSchema.AssertExpectations(t)
+			})
+		}
+	})
+	t.Run("should skip transitive check if no previous versions present", func(t *testing.T) {
+		svc, nsService, schemaProvider, schemaRepo := getSvc()
+		parsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("ListVersions", mock.Anything, nsName, schemaName).Return([]int32{}, nil)
+		err := svc.CheckCompatibility(ctx, nsName, schemaName, &schema.Metadata{Compatibility: "COMPATIBILITY_BACKWARD_TRANSITIVE"}, data)
+		assert.NoError(t, err)
+		schemaRepo.AssertExpectations(t)
+	})
+	t.Run("should use cached parsed schema for previous versions", func(t *testing.T) {
+		nsService := &mocks.NamespaceService{}
+		schemaProvider := &mocks.SchemaProvider{}
+		schemaRepo := &mocks.SchemaRepository{}
+		cache := &mocks.SchemaCache{}
+		svc := schema.NewService(schemaRepo, schemaProvider, nsService, cache)
+		parsedSchema := &mocks.ParsedSchema{}
+		prevParsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		schemaRepo.On("ListVersions", mock.Anything, nsName, schemaName).Return([]int32{1}, nil)
+		schemaRepo.On("GetMetadata", mock.Anything, nsName, schemaName).Return(&schema.Metadata{Format: "protobuf"}, nil)
+		schemaRepo.On("ListReferences", mock.Anything, nsName, schemaName, mock.Anything).Return(nil, nil)
+		cache.On("Get", "testNamespace-a-1-protobuf").Return(prevParsedSchema, true)
+		parsedSchema.On("IsBackwardCompatible", prevParsedSchema).Return(nil)
+		err := svc.CheckCompatibility(ctx, nsName, schemaName, &schema.Metadata{Compatibility: "COMPATIBILITY_BACKWARD_TRANSITIVE"}, data)
+		assert.NoError(t, err)
+		schemaRepo.AssertNotCalled(t, "Get", mock.Anything, nsName, schemaName, int32(1))
+		schemaProvider.AssertNumberOfCalls(t, "ParseSchema", 1)
+		cache.AssertExpectations(t)
+	})
+	t.Run("should validate only against given version", func(t *testing.T) {
+		nsService := &mocks.NamespaceService{}
+		schemaProvider := &mocks.SchemaProvider{}
+		schemaRepo := &mocks.SchemaRepository{}
+		cache := &mocks.SchemaCache{}
+		svc := schema.NewService(schemaRepo, schemaProvider, nsService, cache)
+		parsedSchema := &mocks.ParsedSchema{}
+		prevParsedSchema := &mocks.ParsedSchema{}
+		nsService.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Format: "protobuf"}, nil)
+		schemaProvider.On("ParseSchema", "protobuf", data).Return(parsedSchema, nil)
+		cache.On("Get", "testNamespace-a-2-protobuf").Return(prevParsedSchema, true)
+		parsedSchema.On("IsBackwardCompatible", prevParsedSchema).Return(errors.New("field removed"))
+		err := svc.CheckCompatibilityWithVersion(ctx, nsName, schemaName, 2, &schema.Metadata{Compatibility: "COMPATIBILITY_BACKWARD_TRANSITIVE"}, data)
+		var violationsErr *schema.CompatibilityErr
+		assert.ErrorAs(t, err, &violationsErr)
+		assert.Equal(t, []schema.Violation{{Kind: "incompatibleChange", Message: "field removed", Compatibility: "COMPATIBILITY_BACKWARD"}}, violationsErr.Violations)
+		schemaRepo.AssertNotCalled(t, "ListVersions", mock.Anything, nsName, schemaName)
+		parsedSchema.AssertExpectations(t)
+	})
+}
+
 func TestGetSchema(t *testing.T) {
 	ctx := context.Background()
 	nsName := "testNamespace"
@@ -222,3 +513,162 @@ func TestGetSchema(t *testing.T) {
 		cache.AssertExpectations(t)
 	})
 }
+
+func TestGetByGlobalID(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should return error if global ID not found", func(t *testing.T) {
+		svc, _, _, repo := getSvc()
+		repo.On("GetByGlobalID", mock.Anything, int32(10)).Return(schema.VersionRef{}, store.NoRowsErr)
+		_, _, _, err := svc.GetByGlobalID(ctx, int32(10))
+		assert.ErrorIs(t, err, store.NoRowsErr)
+	})
+	t.Run("should return schema version referred by global ID", func(t *testing.T) {
+		svc, _, _, repo := getSvc()
+		ref := schema.VersionRef{NamespaceID: "testNamespace", Name: "testSchema", Version: 2, GlobalID: 10}
+		meta := &schema.Metadata{Format: "avro"}
+		data := []byte("data")
+		repo.On("GetByGlobalID", mock.Anything, int32(10)).Return(ref, nil)
+		repo.On("GetMetadata", mock.Anything, "testNamespace", "testSchema").Return(meta, nil)
+		repo.On("Get", mock.Anything, "testNamespace", "testSchema", int32(2)).Return(data, nil)
+		actualRef, actualMeta, actualData, err := svc.GetByGlobalID(ctx, int32(10))
+		assert.NoError(t, err)
+		assert.Equal(t, ref, actualRef)
+		assert.Equal(t, meta, actualMeta)
+		assert.Equal(t, data, actualData)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestListVersionDetails(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should return all versions if page size is not set", func(t *testing.T) {
+		svc, _, _, schemaRepo := getSvc()
+		versions := []schema.Version{{Version: 1}, {Version: 2}}
+		schemaRepo.On("ListVersionDetails", mock.Anything, "ns", "sc", int32(0), 0).Return(versions, nil)
+		actual, next, err := svc.ListVersionDetails(ctx, "ns", "sc", 0, "")
+		assert.NoError(t, err)
+		assert.Equal(t, versions, actual)
+		assert.Empty(t, next)
+	})
+	t.Run("should return next page token if more versions exist", func(t *testing.T) {
+		svc, _, _, schemaRepo := getSvc()
+		schemaRepo.On("ListVersionDetails", mock.Anything, "ns", "sc", int32(2), 3).Return([]schema.Version{{Version: 3}, {Version: 5}, {Version: 6}}, nil)
+		actual, next, err := svc.ListVersionDetails(ctx, "ns", "sc", 2, "2")
+		assert.NoError(t, err)
+		assert.Equal(t, []schema.Version{{Version: 3}, {Version: 5}}, actual)
+		assert.Equal(t, "5", next)
+	})
+	t.Run("should return empty token on last page", func(t *testing.T) {
+		svc, _, _, schemaRepo := getSvc()
+		schemaRepo.On("ListVersionDetails", mock.Anything, "ns", "sc", int32(5), 3).Return([]schema.Version{{Version: 6}}, nil)
+		actual, next, err := svc.ListVersionDetails(ctx, "ns", "sc", 2, "5")
+		assert.NoError(t, err)
+		assert.Equal(t, []schema.Version{{Version: 6}}, actual)
+		assert.Empty(t, next)
+	})
+	t.Run("should validate page token", func(t *testing.T) {
+		svc, _, _, _ := getSvc()
+		_, _, err := svc.ListVersionDetails(ctx, "ns", "sc", 2, "abc")
+		assert.ErrorIs(t, err, schema.ErrInvalidPageToken)
+	})
+}
+
+func TestUpdateVersionState(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should return error for unknown state", func(t *testing.T) {
+		svc, _, _, schemaRepo := getSvc()
+		_, err := svc.UpdateVersionState(ctx, "ns", "sc", 1, schema.VersionState{State: "RETIRED"})
+		assert.ErrorIs(t, err, schema.ErrInvalidVersionState)
+		schemaRepo.AssertNotCalled(t, "UpdateVersionState", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("should clear reason and sunset time when activating version", func(t *testing.T) {
+		svc, _, _, schemaRepo := getSvc()
+		sunset := time.Now()
+		expected := schema.Version{Version: 1, VersionState: schema.VersionState{State: schema.VersionStateActive}}
+		schemaRepo.On("UpdateVersionState", mock.Anything, "ns", "sc", int32(1), schema.VersionState{State: schema.VersionStateActive}).Return(expected, nil)
+		v, err := svc.UpdateVersionState(ctx, "ns", "sc", 1, schema.VersionState{State: schema.VersionStateActive, Reason: "r", SunsetAt: &sunset})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, v)
+		schemaRepo.AssertExpectations(t)
+	})
+	t.Run("should deprecate version with reason and sunset time", func(t *testing.T) {
+		svc, _, _, schemaRepo := getSvc()
+		sunset := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+		state := schema.VersionState{State: schema.VersionStateDeprecated, Reason: "use v2", SunsetAt: &sunset}
+		expected := schema.Version{Version: 1, VersionState: state}
+		schemaRepo.On("UpdateVersionState", mock.Anything, "ns", "sc", int32(1), state).Return(expected, nil)
+		v, err := svc.UpdateVersionState(ctx, "ns", "sc", 1, state)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, v)
+		schemaRepo.AssertExpectations(t)
+	})
+}
+
+func TestWatch(t *testing.T) {
+	t.Run("should emit events for changes made to schemas in namespace", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		svc, _, _, repo := getSvc()
+		events := svc.Watch(ctx, "testNamespace", "")
+		repo.On("DeleteVersion", mock.Anything, "testNamespace", "a", int32(2)).Return(nil)
+		repo.On("GetMetadata", mock.Anything, "testNamespace", "a").Return(&schema.Metadata{Compatibility: "COMPATIBILITY_FULL"}, nil)
+		repo.On("UpdateMetadata", mock.Anything, "testNamespace", "a", mock.Anything).Return(&schema.Metadata{Compatibility: "COMPATIBILITY_BACKWARD"}, nil)
+		repo.On("Delete", mock.Anything, "testNamespace", "a").Return(nil)
+		repo.On("Delete", mock.Anything, "otherNamespace", "a").Return(nil)
+		actorCtx := actor.WithActor(ctx, "user@example.com")
+		assert.NoError(t, svc.DeleteVersion(actorCtx, "testNamespace", "a", 2))
+		_, err := svc.UpdateMetadata(ctx, "testNamespace", "a", &schema.Metadata{Compatibility: "COMPATIBILITY_BACKWARD"})
+		assert.NoError(t, err)
+		assert.NoError(t, svc.Delete(ctx, "otherNamespace", "a"))
+		assert.NoError(t, svc.Delete(ctx, "testNamespace", "a"))
+		assert.Equal(t, schema.Event{Type: schema.EventDeleteVersion, NamespaceID: "testNamespace", SchemaName: "a", Version: 2, Actor: "user@example.com", Summary: "version 2 deleted"}, <-events)
+		assert.Equal(t, schema.Event{Type: schema.EventUpdateMetadata, NamespaceID: "testNamespace", SchemaName: "a", Summary: "compatibility changed from COMPATIBILITY_FULL to COMPATIBILITY_BACKWARD"}, <-events)
+		assert.Equal(t, schema.Event{Type: schema.EventDelete, NamespaceID: "testNamespace", SchemaName: "a", Summary: "schema deleted"}, <-events)
+	})
+	t.Run("should filter events by schema name", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		svc, _, _, repo := getSvc()
+		events := svc.Watch(ctx, "testNamespace", "b")
+		repo.On("Delete", mock.Anything, "testNamespace", mock.Anything).Return(nil)
+		assert.NoError(t, svc.Delete(ctx, "testNamespace", "a"))
+		assert.NoError(t, svc.Delete(ctx, "testNamespace", "b"))
+		assert.Equal(t, schema.Event{Type: schema.EventDelete, NamespaceID: "testNamespace", SchemaName: "b", Summary: "schema deleted"}, <-events)
+	})
+	t.Run("should not emit event if change fails", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		svc, _, _, repo := getSvc()
+		events := svc.Watch(ctx, "testNamespace", "")
+		repo.On("Delete", mock.Anything, "testNamespace", "a").Return(errors.New("delete error"))
+		assert.Error(t, svc.Delete(ctx, "testNamespace", "a"))
+		cancel()
+		_, ok := <-events
+		assert.False(t, ok)
+	})
+	t.Run("should call registered event handlers for all namespaces", func(t *testing.T) {
+		ctx := context.Background()
+		svc, _, _, repo := getSvc()
+		var handled []schema.Event
+		svc.AddEventHandler(func(e schema.Event) { handled = append(handled, e) })
+		repo.On("Delete", mock.Anything, mock.Anything, "a").Return(nil)
+		assert.NoError(t, svc.Delete(ctx, "testNamespace", "a"))
+		assert.NoError(t, svc.Delete(ctx, "otherNamespace", "a"))
+		assert.Equal(t, 2, len(handled))
+		assert.Equal(t, "otherNamespace", handled[1].NamespaceID)
+	})
+	t.Run("should allow event handlers to watch schemas", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		svc, _, _, repo := getSvc()
+		var watched <-chan schema.Event
+		svc.AddEventHandler(func(e schema.Event) {
+			if watched == nil {
+				watched = svc.Watch(ctx, e.NamespaceID, "")
+			}
+		})
+		repo.On("Delete", mock.Anything, "testNamespace", mock.Anything).Return(nil)
+		assert.NoError(t, svc.Delete(ctx, "testNamespace", "a"))
+		assert.NoError(t, svc.Delete(ctx, "testNamespace", "b"))
+		assert.Equal(t, schema.Event{Type: schema.EventDelete, NamespaceID: "testNamespace", SchemaName: "b", Summary: "schema deleted"}, <-watched)
+	})
+}
@@ -1,6 +1,9 @@
 package schema
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 func getNonEmpty(args ...string) string {
 	for _, a := range args {
@@ -15,6 +18,18 @@ func schemaKeyFunc(nsName, schema string, version int32) string {
 	return fmt.Sprintf("%s-%s-%d", nsName, schema, version)
 }
 
+func getLocation(nsName, schema string, version int32) string {
+	return fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/versions/%d", nsName, schema, version)
+}
+
+func parsedSchemaKeyFunc(nsName, schema, format string, version int32) string {
+	return fmt.Sprintf("%s-%s-%d-%s", nsName, schema, version, format)
+}
+
+func isTransitive(compatibility string) bool {
+	return strings.HasSuffix(compatibility, "_TRANSITIVE")
+}
+
 func getBytes(key interface{}) []byte {
 	buf, _ := key.([]byte)
 	return buf
@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/raystack/stencil/core/audit"
+	"github.com/raystack/stencil/core/schema"
+)
+
+// auditState has fields of audited schema and version states used by webhook events
+type auditState struct {
+	VersionID     string `json:"version_id"`
+	Compatibility string `json:"compatibility"`
+}
+
+func parseAuditState(data []byte) auditState {
+	var state auditState
+	if len(data) > 0 {
+		_ = json.Unmarshal(data, &state)
+	}
+	return state
+}
+
+// eventFromAudit returns schema event of audited mutation, false is returned for mutations which are not delivered to webhooks
+func eventFromAudit(e audit.Event) (schema.Event, bool) {
+	before, after := parseAuditState(e.Before), parseAuditState(e.After)
+	event := schema.Event{NamespaceID: e.NamespaceID, SchemaName: e.SchemaName, Version: e.Version, Actor: e.Actor}
+	switch e.Operation {
+	case audit.OperationCreateVersion:
+		event.Type, event.VersionID = schema.EventCreate, after.VersionID
+		event.Summary = fmt.Sprintf("version %d created", e.Version)
+	case audit.OperationRestoreVersion:
+		event.Type, event.VersionID = schema.EventCreate, after.VersionID
+		event.Summary = fmt.Sprintf("version %d restored", e.Version)
+	case audit.OperationDeleteSchema:
+		event.Type, event.Summary = schema.EventDelete, "schema deleted"
+	case audit.OperationDeleteVersion:
+		event.Type, event.VersionID = schema.EventDeleteVersion, before.VersionID
+		event.Summary = fmt.Sprintf("version %d deleted", e.Version)
+	case audit.OperationUpdateSchemaMetadata:
+		event.Type = schema.EventUpdateMetadata
+		event.Summary = fmt.Sprintf("compatibility changed from %s to %s", before.Compatibility, after.Compatibility)
+	default:
+		return event, false
+	}
+	return event, true
+}
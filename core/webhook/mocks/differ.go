@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	schema "github.com/raystack/stencil/core/schema"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Differ is an autogenerated mock type for the Differ type
+type Differ struct {
+	mock.Mock
+}
+
+// Diff provides a mock function with given fields: ctx, namespace, schemaName, fromVersion, toVersion, compatibility
+func (_m *Differ) Diff(ctx context.Context, namespace string, schemaName string, fromVersion int32, toVersion int32, compatibility string) (*schema.SchemaDiff, error) {
+	ret := _m.Called(ctx, namespace, schemaName, fromVersion, toVersion, compatibility)
+
+	var r0 *schema.SchemaDiff
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32, string) *schema.SchemaDiff); ok {
+		r0 = rf(ctx, namespace, schemaName, fromVersion, toVersion, compatibility)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.SchemaDiff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32, int32, string) error); ok {
+		r1 = rf(ctx, namespace, schemaName, fromVersion, toVersion, compatibility)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewDiffer interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDiffer creates a new instance of Differ. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDiffer(t mockConstructorTestingTNewDiffer) *Differ {
+	mock := &Differ{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,174 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	audit "github.com/raystack/stencil/core/audit"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	webhook "github.com/raystack/stencil/core/webhook"
+)
+
+// WebhookRepository is an autogenerated mock type for the Repository type
+type WebhookRepository struct {
+	mock.Mock
+}
+
+// ClaimDeliveries provides a mock function with given fields: ctx, limit, lease
+func (_m *WebhookRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]webhook.PendingDelivery, error) {
+	ret := _m.Called(ctx, limit, lease)
+
+	var r0 []webhook.PendingDelivery
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration) []webhook.PendingDelivery); ok {
+		r0 = rf(ctx, limit, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhook.PendingDelivery)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Duration) error); ok {
+		r1 = rf(ctx, limit, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, sub
+func (_m *WebhookRepository) Create(ctx context.Context, sub webhook.Subscription) (webhook.Subscription, error) {
+	ret := _m.Called(ctx, sub)
+
+	var r0 webhook.Subscription
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.Subscription) webhook.Subscription); ok {
+		r0 = rf(ctx, sub)
+	} else {
+		r0 = ret.Get(0).(webhook.Subscription)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, webhook.Subscription) error); ok {
+		r1 = rf(ctx, sub)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, namespaceID, id
+func (_m *WebhookRepository) Delete(ctx context.Context, namespaceID string, id int64) error {
+	ret := _m.Called(ctx, namespaceID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, namespaceID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx, namespaceID
+func (_m *WebhookRepository) List(ctx context.Context, namespaceID string) ([]webhook.Subscription, error) {
+	ret := _m.Called(ctx, namespaceID)
+
+	var r0 []webhook.Subscription
+	if rf, ok := ret.Get(0).(func(context.Context, string) []webhook.Subscription); ok {
+		r0 = rf(ctx, namespaceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhook.Subscription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespaceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDeliveries provides a mock function with given fields: ctx, namespaceID, subscriptionID
+func (_m *WebhookRepository) ListDeliveries(ctx context.Context, namespaceID string, subscriptionID int64) ([]webhook.Delivery, error) {
+	ret := _m.Called(ctx, namespaceID, subscriptionID)
+
+	var r0 []webhook.Delivery
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []webhook.Delivery); ok {
+		r0 = rf(ctx, namespaceID, subscriptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhook.Delivery)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, namespaceID, subscriptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordAttempt provides a mock function with given fields: ctx, pendingID, attempt, retryAfter
+func (_m *WebhookRepository) RecordAttempt(ctx context.Context, pendingID int64, attempt webhook.Delivery, retryAfter time.Duration) error {
+	ret := _m.Called(ctx, pendingID, attempt, retryAfter)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, webhook.Delivery, time.Duration) error); ok {
+		r0 = rf(ctx, pendingID, attempt, retryAfter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RelayEvents provides a mock function with given fields: ctx, limit, relay
+func (_m *WebhookRepository) RelayEvents(ctx context.Context, limit int, relay func(audit.Event) ([]webhook.PendingDelivery, error)) (int, error) {
+	ret := _m.Called(ctx, limit, relay)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, int, func(audit.Event) ([]webhook.PendingDelivery, error)) int); ok {
+		r0 = rf(ctx, limit, relay)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, func(audit.Event) ([]webhook.PendingDelivery, error)) error); ok {
+		r1 = rf(ctx, limit, relay)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewWebhookRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewWebhookRepository(t mockConstructorTestingTNewWebhookRepository) *WebhookRepository {
+	mock := &WebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
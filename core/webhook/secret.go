@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+)
+
+var errInvalidCiphertext = errors.New("invalid encrypted secret")
+
+// secretBox encrypts webhook secrets with AES-256-GCM, key is derived from configured secret key
+type secretBox struct {
+	aead cipher.AEAD
+}
+
+func newSecretBox(key string) *secretBox {
+	if key == "" {
+		return nil
+	}
+	sum := sha256.Sum256([]byte(key))
+	block, _ := aes.NewCipher(sum[:])
+	aead, _ := cipher.NewGCM(block)
+	return &secretBox{aead: aead}
+}
+
+// encrypt returns base64 encoded nonce followed by sealed secret
+func (b *secretBox) encrypt(secret string) (string, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := b.aead.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (b *secretBox) decrypt(encrypted string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil || len(data) < b.aead.NonceSize() {
+		return "", errInvalidCiphertext
+	}
+	nonce, sealed := data[:b.aead.NonceSize()], data[b.aead.NonceSize():]
+	secret, err := b.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", errInvalidCiphertext
+	}
+	return string(secret), nil
+}
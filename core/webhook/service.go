@@ -0,0 +1,355 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/raystack/stencil/core/audit"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// SignatureHeader carries hex encoded HMAC-SHA256 of the payload signed with subscription secret
+	SignatureHeader = "X-Stencil-Signature"
+	// EventHeader carries event type of the payload
+	EventHeader = "X-Stencil-Event"
+)
+
+var (
+	ErrInvalidURL      = status.Error(codes.InvalidArgument, "webhook url should be an absolute http or https url")
+	ErrEmptySecret     = status.Error(codes.InvalidArgument, "webhook secret cannot be empty")
+	ErrInvalidEvent    = status.Error(codes.InvalidArgument, "unknown webhook event type")
+	ErrSecretKeyNotSet = status.Error(codes.FailedPrecondition, "webhook secret key is not configured on server")
+)
+
+var eventTypes = []schema.EventType{schema.EventCreate, schema.EventDelete, schema.EventDeleteVersion, schema.EventUpdateMetadata}
+
+const (
+	// claimBatchSize number of pending deliveries claimed at once
+	claimBatchSize = 100
+	// summaryChanges number of changes listed in payload summary
+	summaryChanges = 5
+)
+
+// Config webhook delivery options
+type Config struct {
+	// MaxAttempts number of delivery attempts for each event
+	MaxAttempts int
+	// Backoff delay before first retry, doubled for every retry
+	Backoff time.Duration
+	// MaxBackoff upper limit of delay between retries, delay is not limited if it is zero
+	MaxBackoff time.Duration
+	// Timeout for each delivery request
+	Timeout time.Duration
+	// SecretKey used to encrypt webhook secrets, webhooks can not be created if it is empty
+	SecretKey string
+}
+
+type Service struct {
+	repo    Repository
+	differ  Differ
+	client  *http.Client
+	config  Config
+	secrets *secretBox
+	wakeup  chan struct{}
+}
+
+func NewService(repo Repository, differ Differ, config Config) *Service {
+	return &Service{
+		repo:    repo,
+		differ:  differ,
+		client:  &http.Client{Timeout: config.Timeout},
+		config:  config,
+		secrets: newSecretBox(config.SecretKey),
+		wakeup:  make(chan struct{}, 1),
+	}
+}
+
+func (s *Service) Create(ctx context.Context, sub Subscription) (Subscription, error) {
+	u, err := url.Parse(sub.URL)
+	if err != nil || !u.IsAbs() || (u.Scheme != "http" && u.Scheme != "https") {
+		return Subscription{}, ErrInvalidURL
+	}
+	if sub.Secret == "" {
+		return Subscription{}, ErrEmptySecret
+	}
+	for _, e := range sub.Events {
+		if !isKnownEvent(e) {
+			return Subscription{}, ErrInvalidEvent
+		}
+	}
+	if s.secrets == nil {
+		return Subscription{}, ErrSecretKeyNotSet
+	}
+	sub.Secret, err = s.secrets.encrypt(sub.Secret)
+	if err != nil {
+		return Subscription{}, err
+	}
+	return s.repo.Create(ctx, sub)
+}
+
+func (s *Service) List(ctx context.Context, namespaceID string) ([]Subscription, error) {
+	return s.repo.List(ctx, namespaceID)
+}
+
+func (s *Service) Delete(ctx context.Context, namespaceID string, id int64) error {
+	return s.repo.Delete(ctx, namespaceID, id)
+}
+
+func (s *Service) ListDeliveries(ctx context.Context, namespaceID string, subscriptionID int64) ([]Delivery, error) {
+	return s.repo.ListDeliveries(ctx, namespaceID, subscriptionID)
+}
+
+// Notify wakes deliverer up to relay changes made through this server instance without waiting for next poll.
+// Events are read from audit event outbox, which is written along with the change, so no event is lost
+// if server stops before it is relayed. It can be registered as schema.EventHandler.
+func (s *Service) Notify(schema.Event) {
+	s.wake()
+}
+
+func (s *Service) wake() {
+	select {
+	case s.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// relayEvents queues deliveries of schema events in outbox until none are left
+func (s *Service) relayEvents(ctx context.Context) {
+	for ctx.Err() == nil {
+		relayed, err := s.repo.RelayEvents(ctx, claimBatchSize, func(e audit.Event) ([]PendingDelivery, error) {
+			return s.deliveriesFor(ctx, e)
+		})
+		if err != nil {
+			logger.Logger.Error("failed to relay schema events to webhooks", zap.Error(err))
+			return
+		}
+		if relayed < claimBatchSize {
+			return
+		}
+	}
+}
+
+// deliveriesFor returns deliveries of audited schema event for every subscription of the namespace subscribed to it
+func (s *Service) deliveriesFor(ctx context.Context, ae audit.Event) ([]PendingDelivery, error) {
+	e, ok := eventFromAudit(ae)
+	if !ok {
+		return nil, nil
+	}
+	subs, err := s.repo.List(ctx, e.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	var subscribed []Subscription
+	for _, sub := range subs {
+		if sub.subscribedTo(string(e.Type)) {
+			subscribed = append(subscribed, sub)
+		}
+	}
+	if len(subscribed) == 0 {
+		return nil, nil
+	}
+	summary, changes := s.summarize(ctx, e)
+	body, err := json.Marshal(Payload{
+		Event:     string(e.Type),
+		Namespace: e.NamespaceID,
+		Schema:    e.SchemaName,
+		Version:   e.Version,
+		VersionID: e.VersionID,
+		Actor:     e.Actor,
+		Summary:   summary,
+		Changes:   changes,
+		Timestamp: ae.CreatedAt.UTC(),
+	})
+	if err != nil {
+		logger.Logger.Error("failed to marshal webhook payload, event is not delivered", zap.Int64("audit_event", ae.ID), zap.Error(err))
+		return nil, nil
+	}
+	deliveries := make([]PendingDelivery, 0, len(subscribed))
+	for _, sub := range subscribed {
+		deliveries = append(deliveries, PendingDelivery{SubscriptionID: sub.ID, EventType: string(e.Type), Payload: body})
+	}
+	return deliveries, nil
+}
+
+// summarize returns summary of event along with changes made by created version since previous version.
+// Event summary is used as is for other events and for formats not supporting diff.
+func (s *Service) summarize(ctx context.Context, e schema.Event) (string, []schema.Change) {
+	if e.Type != schema.EventCreate || e.Version <= 1 || s.differ == nil {
+		return e.Summary, nil
+	}
+	diff, err := s.differ.Diff(ctx, e.NamespaceID, e.SchemaName, e.Version-1, e.Version, "")
+	if err != nil {
+		return e.Summary, nil
+	}
+	if len(diff.Changes) == 0 {
+		return fmt.Sprintf("%s, no changes since version %d", e.Summary, diff.FromVersion), nil
+	}
+	breaking := 0
+	var msgs []string
+	for _, c := range diff.Changes {
+		if c.Breaking {
+			breaking++
+		}
+		if len(msgs) < summaryChanges {
+			msgs = append(msgs, c.Message)
+		}
+	}
+	if more := len(diff.Changes) - len(msgs); more > 0 {
+		msgs = append(msgs, fmt.Sprintf("and %d more", more))
+	}
+	summary := fmt.Sprintf("%s, changes since version %d: %s (breaking for %s: %d of %d)",
+		e.Summary, diff.FromVersion, strings.Join(msgs, "; "), diff.Compatibility, breaking, len(diff.Changes))
+	return summary, diff.Changes
+}
+
+// RunDeliverer relays schema events from outbox and delivers pending events every interval and whenever
+// notified of a change, until ctx is cancelled. Events and deliveries are claimed from repository,
+// so deliverers of all server instances share the work and pending retries survive restarts.
+func (s *Service) RunDeliverer(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.relayEvents(ctx)
+		s.deliverPending(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.wakeup:
+		}
+	}
+}
+
+// deliverPending attempts due deliveries until none are left
+func (s *Service) deliverPending(ctx context.Context) {
+	for ctx.Err() == nil {
+		pending, err := s.repo.ClaimDeliveries(ctx, claimBatchSize, 2*s.config.Timeout)
+		if err != nil {
+			logger.Logger.Error("failed to claim webhook deliveries", zap.Error(err))
+			return
+		}
+		var wg sync.WaitGroup
+		for _, p := range pending {
+			wg.Add(1)
+			go func(p PendingDelivery) {
+				defer wg.Done()
+				s.attempt(ctx, p)
+			}(p)
+		}
+		wg.Wait()
+		if len(pending) < claimBatchSize {
+			return
+		}
+	}
+}
+
+// attempt posts pending delivery and records the attempt, failed deliveries are retried
+// with exponential backoff until attempts are exhausted.
+func (s *Service) attempt(ctx context.Context, p PendingDelivery) {
+	var delivery Delivery
+	if secret, err := s.decryptSecret(p.Secret); err != nil {
+		delivery = Delivery{SubscriptionID: p.SubscriptionID, EventType: p.EventType, Payload: p.Payload, Error: err.Error()}
+	} else {
+		delivery = s.post(ctx, p.SubscriptionID, p.URL, secret, p.EventType, p.Payload)
+	}
+	delivery.Attempt = p.Attempts + 1
+	var retryAfter time.Duration
+	if !delivery.Success && int(delivery.Attempt) < s.config.MaxAttempts {
+		retryAfter = s.retryDelay(delivery.Attempt)
+	}
+	if err := s.repo.RecordAttempt(ctx, p.ID, delivery, retryAfter); err != nil {
+		logger.Logger.Error("failed to record webhook delivery", zap.Int64("subscription", p.SubscriptionID), zap.Error(err))
+	}
+}
+
+// retryDelay returns backoff doubled for every failed attempt, limited by configured max backoff
+func (s *Service) retryDelay(attempt int32) time.Duration {
+	delay := s.config.Backoff
+	for i := int32(1); i < attempt && delay <= math.MaxInt64/2; i++ {
+		delay *= 2
+	}
+	if s.config.MaxBackoff > 0 && delay > s.config.MaxBackoff {
+		return s.config.MaxBackoff
+	}
+	return delay
+}
+
+func (s *Service) decryptSecret(encrypted string) (string, error) {
+	if s.secrets == nil {
+		return "", errors.New("webhook secret key is not configured on server")
+	}
+	secret, err := s.secrets.decrypt(encrypted)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt webhook secret, recreate the webhook: %w", err)
+	}
+	return secret, nil
+}
+
+func (s *Service) post(ctx context.Context, subscriptionID int64, hookURL, secret, eventType string, body []byte) Delivery {
+	delivery := Delivery{SubscriptionID: subscriptionID, EventType: eventType, Payload: body}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hookURL, bytes.NewReader(body))
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, eventType)
+	req.Header.Set(SignatureHeader, Sign(secret, body))
+	res, err := s.client.Do(req)
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+	defer res.Body.Close()
+	delivery.StatusCode = int32(res.StatusCode)
+	delivery.Success = res.StatusCode >= 200 && res.StatusCode < 300
+	if !delivery.Success {
+		delivery.Error = fmt.Sprintf("unexpected status code %d", res.StatusCode)
+	}
+	return delivery
+}
+
+// Sign returns signature of the payload in `sha256=<hex encoded HMAC-SHA256>` form
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (sub Subscription) subscribedTo(eventType string) bool {
+	if len(sub.Events) == 0 {
+		return true
+	}
+	for _, e := range sub.Events {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func isKnownEvent(e string) bool {
+	for _, t := range eventTypes {
+		if string(t) == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,306 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/raystack/stencil/core/audit"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/core/webhook"
+	"github.com/raystack/stencil/core/webhook/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getSvc() (*webhook.Service, *mocks.WebhookRepository, *mocks.Differ) {
+	repo := &mocks.WebhookRepository{}
+	differ := &mocks.Differ{}
+	svc := webhook.NewService(repo, differ, webhook.Config{MaxAttempts: 3, Backoff: time.Millisecond, Timeout: time.Second, SecretKey: "key"})
+	return svc, repo, differ
+}
+
+// createSubscription creates subscription through service and returns it along with secret stored in repository
+func createSubscription(t *testing.T, svc *webhook.Service, repo *mocks.WebhookRepository, sub webhook.Subscription) string {
+	t.Helper()
+	var stored string
+	repo.On("Create", mock.Anything, mock.Anything).Return(sub, nil).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(webhook.Subscription).Secret
+	}).Once()
+	_, err := svc.Create(context.Background(), sub)
+	assert.NoError(t, err)
+	return stored
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should validate url", func(t *testing.T) {
+		svc, _, _ := getSvc()
+		_, err := svc.Create(ctx, webhook.Subscription{NamespaceID: "ns", URL: "localhost/hook", Secret: "secret"})
+		assert.ErrorIs(t, err, webhook.ErrInvalidURL)
+		_, err = svc.Create(ctx, webhook.Subscription{NamespaceID: "ns", URL: "ftp://localhost/hook", Secret: "secret"})
+		assert.ErrorIs(t, err, webhook.ErrInvalidURL)
+	})
+	t.Run("should validate secret", func(t *testing.T) {
+		svc, _, _ := getSvc()
+		_, err := svc.Create(ctx, webhook.Subscription{NamespaceID: "ns", URL: "http://localhost/hook"})
+		assert.ErrorIs(t, err, webhook.ErrEmptySecret)
+	})
+	t.Run("should validate event types", func(t *testing.T) {
+		svc, _, _ := getSvc()
+		_, err := svc.Create(ctx, webhook.Subscription{NamespaceID: "ns", URL: "http://localhost/hook", Secret: "secret", Events: []string{"UNKNOWN"}})
+		assert.ErrorIs(t, err, webhook.ErrInvalidEvent)
+	})
+	t.Run("should return error if secret key is not configured", func(t *testing.T) {
+		svc := webhook.NewService(&mocks.WebhookRepository{}, nil, webhook.Config{})
+		_, err := svc.Create(ctx, webhook.Subscription{NamespaceID: "ns", URL: "http://localhost/hook", Secret: "secret"})
+		assert.ErrorIs(t, err, webhook.ErrSecretKeyNotSet)
+	})
+	t.Run("should create subscription with encrypted secret", func(t *testing.T) {
+		svc, repo, _ := getSvc()
+		sub := webhook.Subscription{NamespaceID: "ns", URL: "http://localhost/hook", Secret: "secret", Events: []string{"CREATE"}}
+		repo.On("Create", mock.Anything, mock.MatchedBy(func(s webhook.Subscription) bool {
+			return s.URL == sub.URL && s.Secret != "" && s.Secret != "secret" && assert.ObjectsAreEqual(sub.Events, s.Events)
+		})).Return(webhook.Subscription{ID: 1}, nil)
+		created, err := svc.Create(ctx, sub)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), created.ID)
+		repo.AssertExpectations(t)
+	})
+}
+
+// relayOnce makes repository relay given audit event once and returns channel receiving deliveries queued for it
+func relayOnce(repo *mocks.WebhookRepository, e audit.Event) <-chan []webhook.PendingDelivery {
+	queued := make(chan []webhook.PendingDelivery, 1)
+	repo.On("RelayEvents", mock.Anything, mock.Anything, mock.Anything).Return(1, nil).Run(func(args mock.Arguments) {
+		relay := args.Get(2).(func(audit.Event) ([]webhook.PendingDelivery, error))
+		deliveries, err := relay(e)
+		if err == nil {
+			queued <- deliveries
+		}
+	}).Once()
+	repo.On("RelayEvents", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+	repo.On("ClaimDeliveries", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	return queued
+}
+
+func TestRelayEvents(t *testing.T) {
+	createdAt := time.Date(2022, 3, 14, 10, 20, 30, 0, time.UTC)
+	created := audit.Event{ID: 7, Operation: audit.OperationCreateVersion, NamespaceID: "ns", SchemaName: "sc", Version: 2, Actor: "user",
+		After: []byte(`{"version_id": "uuid"}`), CreatedAt: createdAt}
+	subs := []webhook.Subscription{
+		{ID: 1, NamespaceID: "ns", URL: "http://localhost/hook"},
+		{ID: 2, NamespaceID: "ns", URL: "http://localhost/hook", Events: []string{"DELETE"}},
+	}
+	t.Run("should queue payload with changes for subscribed webhooks", func(t *testing.T) {
+		svc, repo, differ := getSvc()
+		repo.On("List", mock.Anything, "ns").Return(subs, nil)
+		differ.On("Diff", mock.Anything, "ns", "sc", int32(1), int32(2), "").Return(&schema.SchemaDiff{
+			FromVersion:   1,
+			ToVersion:     2,
+			Compatibility: "COMPATIBILITY_BACKWARD",
+			Changes: []schema.Change{
+				{Kind: "fieldDelete", Path: "a.One.id", Message: `field "id" is deleted`, Breaking: true},
+				{Kind: "fieldAdd", Path: "a.One.name", Message: `field "name" is added`},
+			},
+		}, nil)
+		queued := relayOnce(repo, created)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.RunDeliverer(ctx, time.Hour)
+		deliveries := <-queued
+		assert.Equal(t, 1, len(deliveries))
+		assert.Equal(t, int64(1), deliveries[0].SubscriptionID)
+		assert.Equal(t, "CREATE", deliveries[0].EventType)
+		payload := webhook.Payload{}
+		assert.NoError(t, json.Unmarshal(deliveries[0].Payload, &payload))
+		assert.Equal(t, "ns", payload.Namespace)
+		assert.Equal(t, "sc", payload.Schema)
+		assert.Equal(t, int32(2), payload.Version)
+		assert.Equal(t, "uuid", payload.VersionID)
+		assert.Equal(t, "user", payload.Actor)
+		assert.Equal(t, createdAt, payload.Timestamp)
+		assert.Equal(t, `version 2 created, changes since version 1: field "id" is deleted; field "name" is added (breaking for COMPATIBILITY_BACKWARD: 1 of 2)`, payload.Summary)
+		assert.Equal(t, 2, len(payload.Changes))
+		assert.True(t, payload.Changes[0].Breaking)
+	})
+	t.Run("should use event summary if diff is not supported", func(t *testing.T) {
+		svc, repo, differ := getSvc()
+		repo.On("List", mock.Anything, "ns").Return(subs, nil)
+		differ.On("Diff", mock.Anything, "ns", "sc", int32(1), int32(2), "").Return(nil, errors.New("diff is not supported"))
+		queued := relayOnce(repo, created)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.RunDeliverer(ctx, time.Hour)
+		payload := webhook.Payload{}
+		assert.NoError(t, json.Unmarshal((<-queued)[0].Payload, &payload))
+		assert.Equal(t, "version 2 created", payload.Summary)
+		assert.Nil(t, payload.Changes)
+	})
+	t.Run("should describe metadata update from audited states", func(t *testing.T) {
+		svc, repo, _ := getSvc()
+		repo.On("List", mock.Anything, "ns").Return(subs, nil)
+		queued := relayOnce(repo, audit.Event{ID: 8, Operation: audit.OperationUpdateSchemaMetadata, NamespaceID: "ns", SchemaName: "sc",
+			Before: []byte(`{"compatibility": "COMPATIBILITY_BACKWARD"}`), After: []byte(`{"compatibility": "COMPATIBILITY_FULL"}`)})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.RunDeliverer(ctx, time.Hour)
+		deliveries := <-queued
+		payload := webhook.Payload{}
+		assert.NoError(t, json.Unmarshal(deliveries[0].Payload, &payload))
+		assert.Equal(t, "UPDATE_METADATA", payload.Event)
+		assert.Equal(t, "compatibility changed from COMPATIBILITY_BACKWARD to COMPATIBILITY_FULL", payload.Summary)
+	})
+	t.Run("should not queue deliveries for mutations other than schema changes", func(t *testing.T) {
+		svc, repo, _ := getSvc()
+		queued := relayOnce(repo, audit.Event{ID: 9, Operation: audit.OperationCreateNamespace, NamespaceID: "ns"})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.RunDeliverer(ctx, time.Hour)
+		assert.Empty(t, <-queued)
+		repo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+	t.Run("should keep events in outbox if subscriptions can not be listed", func(t *testing.T) {
+		svc, repo, _ := getSvc()
+		repo.On("List", mock.Anything, "ns").Return(nil, errors.New("connection refused"))
+		relayErr := make(chan error, 1)
+		repo.On("RelayEvents", mock.Anything, mock.Anything, mock.Anything).Return(0, errors.New("connection refused")).Run(func(args mock.Arguments) {
+			_, err := args.Get(2).(func(audit.Event) ([]webhook.PendingDelivery, error))(created)
+			relayErr <- err
+		}).Once()
+		repo.On("ClaimDeliveries", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.RunDeliverer(ctx, time.Hour)
+		assert.EqualError(t, <-relayErr, "connection refused")
+	})
+}
+
+func TestRunDeliverer(t *testing.T) {
+	payload := []byte(`{"event":"CREATE"}`)
+	t.Run("should post signed payload of claimed deliveries", func(t *testing.T) {
+		received := make(chan *http.Request, 1)
+		bodies := make(chan []byte, 1)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			received <- r
+			bodies <- body
+		}))
+		defer ts.Close()
+		svc, repo, _ := getSvc()
+		secret := createSubscription(t, svc, repo, webhook.Subscription{ID: 1, NamespaceID: "ns", URL: ts.URL, Secret: "secret"})
+		repo.On("RelayEvents", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+		repo.On("ClaimDeliveries", mock.Anything, mock.Anything, 2*time.Second).Return([]webhook.PendingDelivery{
+			{ID: 10, SubscriptionID: 1, EventType: "CREATE", Payload: payload, URL: ts.URL, Secret: secret},
+		}, nil).Once()
+		repo.On("ClaimDeliveries", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		delivered := make(chan webhook.Delivery, 1)
+		repo.On("RecordAttempt", mock.Anything, int64(10), mock.Anything, time.Duration(0)).Return(nil).Run(func(args mock.Arguments) {
+			delivered <- args.Get(2).(webhook.Delivery)
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.RunDeliverer(ctx, time.Hour)
+		req := <-received
+		body := <-bodies
+		assert.Equal(t, "CREATE", req.Header.Get(webhook.EventHeader))
+		assert.Equal(t, webhook.Sign("secret", body), req.Header.Get(webhook.SignatureHeader))
+		assert.Equal(t, payload, body)
+		d := <-delivered
+		assert.Equal(t, int64(1), d.SubscriptionID)
+		assert.Equal(t, int32(1), d.Attempt)
+		assert.Equal(t, int32(200), d.StatusCode)
+		assert.True(t, d.Success)
+	})
+	t.Run("should retry failed deliveries with backoff until attempts are exhausted", func(t *testing.T) {
+		var calls int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+		svc, repo, _ := getSvc()
+		secret := createSubscription(t, svc, repo, webhook.Subscription{ID: 1, NamespaceID: "ns", URL: ts.URL, Secret: "secret"})
+		repo.On("RelayEvents", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+		for attempts := int32(0); attempts < 3; attempts++ {
+			repo.On("ClaimDeliveries", mock.Anything, mock.Anything, mock.Anything).Return([]webhook.PendingDelivery{
+				{ID: 10, SubscriptionID: 1, EventType: "CREATE", Payload: payload, Attempts: attempts, URL: ts.URL, Secret: secret},
+			}, nil).Once()
+		}
+		repo.On("ClaimDeliveries", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		type recorded struct {
+			delivery   webhook.Delivery
+			retryAfter time.Duration
+		}
+		attempts := make(chan recorded, 3)
+		repo.On("RecordAttempt", mock.Anything, int64(10), mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			attempts <- recorded{args.Get(2).(webhook.Delivery), args.Get(3).(time.Duration)}
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.RunDeliverer(ctx, time.Millisecond)
+		for attempt, retryAfter := range []time.Duration{time.Millisecond, 2 * time.Millisecond, 0} {
+			r := <-attempts
+			assert.Equal(t, int32(attempt+1), r.delivery.Attempt)
+			assert.False(t, r.delivery.Success)
+			assert.Equal(t, int32(500), r.delivery.StatusCode)
+			assert.Equal(t, "unexpected status code 500", r.delivery.Error)
+			assert.Equal(t, retryAfter, r.retryAfter)
+		}
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+	t.Run("should limit retry delay to max backoff", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+		repo := &mocks.WebhookRepository{}
+		svc := webhook.NewService(repo, &mocks.Differ{}, webhook.Config{MaxAttempts: 100, Backoff: time.Second, MaxBackoff: time.Minute, Timeout: time.Second, SecretKey: "key"})
+		secret := createSubscription(t, svc, repo, webhook.Subscription{ID: 1, NamespaceID: "ns", URL: ts.URL, Secret: "secret"})
+		repo.On("RelayEvents", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+		for _, attempts := range []int32{4, 6, 70} {
+			repo.On("ClaimDeliveries", mock.Anything, mock.Anything, mock.Anything).Return([]webhook.PendingDelivery{
+				{ID: 10, SubscriptionID: 1, EventType: "CREATE", Payload: payload, Attempts: attempts, URL: ts.URL, Secret: secret},
+			}, nil).Once()
+		}
+		repo.On("ClaimDeliveries", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		delays := make(chan time.Duration, 3)
+		repo.On("RecordAttempt", mock.Anything, int64(10), mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			delays <- args.Get(3).(time.Duration)
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.RunDeliverer(ctx, time.Millisecond)
+		for _, expected := range []time.Duration{16 * time.Second, time.Minute, time.Minute} {
+			assert.Equal(t, expected, <-delays)
+		}
+	})
+	t.Run("should record failed attempt if secret can not be decrypted", func(t *testing.T) {
+		svc, repo, _ := getSvc()
+		repo.On("RelayEvents", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+		repo.On("ClaimDeliveries", mock.Anything, mock.Anything, mock.Anything).Return([]webhook.PendingDelivery{
+			{ID: 10, SubscriptionID: 1, EventType: "CREATE", Payload: payload, URL: "http://localhost/hook", Secret: "secret"},
+		}, nil).Once()
+		repo.On("ClaimDeliveries", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		delivered := make(chan webhook.Delivery, 1)
+		repo.On("RecordAttempt", mock.Anything, int64(10), mock.Anything, time.Millisecond).Return(nil).Run(func(args mock.Arguments) {
+			delivered <- args.Get(2).(webhook.Delivery)
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go svc.RunDeliverer(ctx, time.Hour)
+		d := <-delivered
+		assert.False(t, d.Success)
+		assert.Contains(t, d.Error, "failed to decrypt webhook secret")
+	})
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, "sha256=8b5f48702995c1598c573db1e21866a9b825d4a794d169d7060a03605796360b", webhook.Sign("secret", []byte("message")))
+}
@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/raystack/stencil/core/audit"
+	"github.com/raystack/stencil/core/schema"
+)
+
+// Subscription namespace scoped webhook registration
+type Subscription struct {
+	ID          int64
+	NamespaceID string
+	URL         string
+	// Secret used to sign payloads with HMAC-SHA256, it is stored encrypted
+	Secret string
+	// Events list of event types to be delivered, all events are delivered if empty
+	Events    []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Delivery records single attempt to deliver event to subscription
+type Delivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventType      string
+	Payload        []byte
+	Attempt        int32
+	StatusCode     int32
+	Success        bool
+	Error          string
+	CreatedAt      time.Time
+}
+
+// PendingDelivery is event queued for delivery to subscription, it is kept until delivered or attempts are exhausted
+type PendingDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventType      string
+	Payload        []byte
+	// Attempts number of attempts made so far
+	Attempts      int32
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	// URL and encrypted Secret of subscription, set on claimed deliveries
+	URL    string
+	Secret string
+}
+
+// Payload is JSON body posted to subscribed URL
+type Payload struct {
+	Event     string `json:"event"`
+	Namespace string `json:"namespace"`
+	Schema    string `json:"schema"`
+	Version   int32  `json:"version,omitempty"`
+	VersionID string `json:"version_id,omitempty"`
+	Actor     string `json:"actor,omitempty"`
+	Summary   string `json:"summary"`
+	// Changes made by created version since previous version, set for formats supporting diff
+	Changes   []schema.Change `json:"changes,omitempty"`
+	Timestamp time.Time       `json:"timestamp"`
+}
+
+type Repository interface {
+	Create(ctx context.Context, sub Subscription) (Subscription, error)
+	List(ctx context.Context, namespaceID string) ([]Subscription, error)
+	Delete(ctx context.Context, namespaceID string, id int64) error
+	ListDeliveries(ctx context.Context, namespaceID string, subscriptionID int64) ([]Delivery, error)
+	// RelayEvents takes up to limit oldest audit events from outbox and queues deliveries returned by relay for each of them,
+	// in a single transaction. Events taken by other server instances are skipped. Returns number of events taken.
+	RelayEvents(ctx context.Context, limit int, relay func(audit.Event) ([]PendingDelivery, error)) (int, error)
+	// ClaimDeliveries returns pending deliveries due for an attempt, claimed deliveries are not returned
+	// again until lease expires, so that every server instance can deliver without sending an event twice
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]PendingDelivery, error)
+	// RecordAttempt adds attempt to delivery log, pending delivery is retried after retryAfter or removed if it is zero
+	RecordAttempt(ctx context.Context, pendingID int64, attempt Delivery, retryAfter time.Duration) error
+}
+
+// Differ lists changes made between versions of a schema
+type Differ interface {
+	Diff(ctx context.Context, namespace, schemaName string, fromVersion, toVersion int32, compatibility string) (*schema.SchemaDiff, error)
+}
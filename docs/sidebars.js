@@ -22,6 +22,9 @@ module.exports = {
         "formats/protobuf",
         "formats/avro",
         "formats/json",
+        "formats/thrift",
+        "formats/graphql",
+        "formats/openapi",
       ],
     },
     {
@@ -30,6 +33,11 @@ module.exports = {
       items: [
         "server/overview",
         "server/rules",
+        "server/confluent",
+        "server/webhooks",
+        "server/auth",
+        "server/audit",
+        "server/retention",
       ],
     },
     {
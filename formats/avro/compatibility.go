@@ -0,0 +1,204 @@
+package avro
+
+import (
+	"encoding/json"
+	"strings"
+
+	av "github.com/hamba/avro"
+)
+
+// aliases keeps aliases of named types and record fields, since avro library leaves them out of parsed schema.
+// Type aliases are keyed by type full name, field aliases by record full name and field name.
+type aliases struct {
+	types  map[string][]string
+	fields map[string][]string
+}
+
+func parseAliases(data []byte) *aliases {
+	a := &aliases{types: map[string][]string{}, fields: map[string][]string{}}
+	var node interface{}
+	if err := json.Unmarshal(data, &node); err == nil {
+		a.walk(node, "")
+	}
+	return a
+}
+
+func (a *aliases) walk(node interface{}, namespace string) {
+	switch v := node.(type) {
+	case []interface{}:
+		for _, branch := range v {
+			a.walk(branch, namespace)
+		}
+	case map[string]interface{}:
+		switch typ := v["type"].(type) {
+		case string:
+			switch typ {
+			case "record", "error", "enum", "fixed":
+				name, _ := v["name"].(string)
+				if ns, ok := v["namespace"].(string); ok && !strings.Contains(name, ".") {
+					namespace = ns
+				}
+				fullName := qualify(name, namespace)
+				namespace = ""
+				if i := strings.LastIndex(fullName, "."); i >= 0 {
+					namespace = fullName[:i]
+				}
+				a.types[fullName] = qualifyAll(v["aliases"], namespace)
+				fields, _ := v["fields"].([]interface{})
+				for _, f := range fields {
+					field, _ := f.(map[string]interface{})
+					fieldName, _ := field["name"].(string)
+					a.fields[fullName+"."+fieldName] = qualifyAll(field["aliases"], "")
+					a.walk(field["type"], namespace)
+				}
+			case "array":
+				a.walk(v["items"], namespace)
+			case "map":
+				a.walk(v["values"], namespace)
+			}
+		default:
+			a.walk(typ, namespace)
+		}
+	}
+}
+
+func qualify(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func qualifyAll(node interface{}, namespace string) []string {
+	values, _ := node.([]interface{})
+	var names []string
+	for _, v := range values {
+		if name, ok := v.(string); ok {
+			names = append(names, qualify(name, namespace))
+		}
+	}
+	return names
+}
+
+// resolver checks whether data written with writer schema can be read with reader schema following avro schema resolution,
+// recording every mismatch instead of stopping at the first one
+type resolver struct {
+	readerAliases *aliases
+	// visited keeps record pairs already checked, to stop on recursive types
+	visited map[string]bool
+	diffs   *compatibilityErr
+}
+
+func checkCompatibility(reader, writer *Schema) error {
+	r := &resolver{readerAliases: reader.aliases, visited: map[string]bool{}, diffs: &compatibilityErr{}}
+	r.check(rootPath(reader.sc), reader.sc, writer.sc)
+	if r.diffs.IsEmpty() {
+		return nil
+	}
+	return r.diffs
+}
+
+func (r *resolver) check(path string, reader, writer av.Schema) {
+	reader, writer = deref(reader), deref(writer)
+	if union, ok := writer.(*av.UnionSchema); ok {
+		for _, branch := range union.Types() {
+			r.check(path, reader, branch)
+		}
+		return
+	}
+	if union, ok := reader.(*av.UnionSchema); ok {
+		branch := r.matchBranch(union, writer)
+		if branch == nil {
+			r.diffs.add(unionTypeMissing, path, typeName(writer), typeName(reader), "reader union %s has no type for writer type %q", typeName(reader), typeName(writer))
+			return
+		}
+		r.check(path, branch, writer)
+		return
+	}
+	if reader.Type() != writer.Type() {
+		if !isPromotion(writer.Type(), reader.Type()) {
+			r.diffs.add(typeMismatch, path, typeName(writer), typeName(reader), "reader type %q can not read writer type %q", typeName(reader), typeName(writer))
+		}
+		return
+	}
+	if readerNamed, ok := reader.(av.NamedSchema); ok {
+		writerNamed := writer.(av.NamedSchema)
+		if !r.namesMatch(readerNamed, writerNamed) {
+			r.diffs.add(nameMismatch, path, writerNamed.FullName(), readerNamed.FullName(), "reader type %q does not match writer type %q by name or alias", readerNamed.FullName(), writerNamed.FullName())
+			return
+		}
+	}
+	if readerLogical, writerLogical := logicalType(reader), logicalType(writer); readerLogical != writerLogical {
+		r.diffs.add(logicalTypeMismatch, path, writerLogical, readerLogical, "reader logical type %q does not match writer logical type %q", readerLogical, writerLogical)
+	}
+	switch rd := reader.(type) {
+	case *av.RecordSchema:
+		r.checkRecords(path, rd, writer.(*av.RecordSchema))
+	case *av.EnumSchema:
+		for _, symbol := range writer.(*av.EnumSchema).Symbols() {
+			if !contains(rd.Symbols(), symbol) {
+				r.diffs.add(enumSymbolMissing, path, symbol, nil, "writer symbol %q is missing in reader enum", symbol)
+			}
+		}
+	case *av.FixedSchema:
+		if w := writer.(*av.FixedSchema); rd.Size() != w.Size() {
+			r.diffs.add(fixedSizeMismatch, path, w.Size(), rd.Size(), "reader fixed size %d does not match writer fixed size %d", rd.Size(), w.Size())
+		}
+	case *av.ArraySchema:
+		r.check(path+"[]", rd.Items(), writer.(*av.ArraySchema).Items())
+	case *av.MapSchema:
+		r.check(path+"{}", rd.Values(), writer.(*av.MapSchema).Values())
+	}
+}
+
+// matchBranch returns first reader union branch of same type and name as writer, else first branch writer type promotes to
+func (r *resolver) matchBranch(union *av.UnionSchema, writer av.Schema) av.Schema {
+	var promoted av.Schema
+	for _, branch := range union.Types() {
+		branch = deref(branch)
+		if branch.Type() != writer.Type() {
+			if promoted == nil && isPromotion(writer.Type(), branch.Type()) {
+				promoted = branch
+			}
+			continue
+		}
+		named, ok := branch.(av.NamedSchema)
+		if !ok || r.namesMatch(named, writer.(av.NamedSchema)) {
+			return branch
+		}
+	}
+	return promoted
+}
+
+func (r *resolver) namesMatch(reader, writer av.NamedSchema) bool {
+	return reader.FullName() == writer.FullName() || contains(r.readerAliases.types[reader.FullName()], writer.FullName())
+}
+
+func (r *resolver) checkRecords(path string, reader, writer *av.RecordSchema) {
+	key := reader.FullName() + "|" + writer.FullName()
+	if r.visited[key] {
+		return
+	}
+	r.visited[key] = true
+	writerFields := map[string]*av.Field{}
+	for _, field := range writer.Fields() {
+		writerFields[field.Name()] = field
+	}
+	for _, field := range reader.Fields() {
+		fieldPath := path + "." + field.Name()
+		writerField, ok := writerFields[field.Name()]
+		for _, alias := range r.readerAliases.fields[reader.FullName()+"."+field.Name()] {
+			if ok {
+				break
+			}
+			writerField, ok = writerFields[alias]
+		}
+		if !ok {
+			if !field.HasDefault() {
+				r.diffs.add(missingDefault, fieldPath, nil, typeName(field.Type()), "reader field %q is missing in writer schema and has no default", field.Name())
+			}
+			continue
+		}
+		r.check(fieldPath, field.Type(), writerField.Type())
+	}
+}
@@ -0,0 +1,149 @@
+package avro_test
+
+import (
+	"testing"
+
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/formats/avro"
+	"github.com/raystack/stencil/formats/formattest"
+	"github.com/stretchr/testify/assert"
+)
+
+const bookingSchema = `{
+	"type": "record",
+	"name": "Booking",
+	"namespace": "raystack",
+	"fields": [
+		{ "name": "id", "type": "string" },
+		{ "name": "count", "type": "int" },
+		{ "name": "note", "type": ["null", "string"], "default": null },
+		{ "name": "status", "type": { "type": "enum", "name": "Status", "symbols": ["OPEN", "CLOSED"] } },
+		{ "name": "driver", "type": { "type": "record", "name": "Driver", "fields": [{ "name": "name", "type": "string" }] } },
+		{ "name": "hash", "type": { "type": "fixed", "name": "Hash", "size": 16 } },
+		{ "name": "tags", "type": { "type": "array", "items": "string" } }
+	]
+}`
+
+var parse = formattest.Parser(avro.ParseSchema)
+
+func TestCompatibility(t *testing.T) {
+	prev := parse(t, bookingSchema)
+	for _, test := range []struct {
+		name     string
+		current  string
+		backward string
+		forward  string
+	}{
+		{"should report every missing default and type mismatch", `{
+			"type": "record",
+			"name": "Booking",
+			"namespace": "raystack",
+			"fields": [
+				{ "name": "id", "type": "long" },
+				{ "name": "count", "type": "long" },
+				{ "name": "region", "type": "string" },
+				{ "name": "status", "type": { "type": "enum", "name": "Status", "symbols": ["OPEN"] } },
+				{ "name": "driver", "type": { "type": "record", "name": "Driver", "fields": [{ "name": "name", "type": "string" }, { "name": "phone", "type": "string" }] } },
+				{ "name": "hash", "type": { "type": "fixed", "name": "Hash", "size": 32 } },
+				{ "name": "tags", "type": { "type": "array", "items": "int" } }
+			]
+		}`,
+			`raystack.Booking.id: reader type "long" can not read writer type "string";` +
+				`raystack.Booking.region: reader field "region" is missing in writer schema and has no default;` +
+				`raystack.Booking.status: writer symbol "CLOSED" is missing in reader enum;` +
+				`raystack.Booking.driver.phone: reader field "phone" is missing in writer schema and has no default;` +
+				`raystack.Booking.hash: reader fixed size 32 does not match writer fixed size 16;` +
+				`raystack.Booking.tags[]: reader type "int" can not read writer type "string"`,
+			`raystack.Booking.id: reader type "string" can not read writer type "long";` +
+				`raystack.Booking.count: reader type "int" can not read writer type "long";` +
+				`raystack.Booking.hash: reader fixed size 16 does not match writer fixed size 32;` +
+				`raystack.Booking.tags[]: reader type "string" can not read writer type "int"`},
+		{"should match renamed field and record by aliases", `{
+			"type": "record",
+			"name": "Reservation",
+			"namespace": "raystack",
+			"aliases": ["Booking"],
+			"fields": [
+				{ "name": "id", "type": "string" },
+				{ "name": "quantity", "type": "int", "aliases": ["count"] },
+				{ "name": "note", "type": ["null", "string"], "default": null },
+				{ "name": "status", "type": { "type": "enum", "name": "State", "aliases": ["raystack.Status"], "symbols": ["OPEN", "CLOSED"] } },
+				{ "name": "driver", "type": { "type": "record", "name": "Driver", "fields": [{ "name": "name", "type": "string" }] } },
+				{ "name": "hash", "type": { "type": "fixed", "name": "Hash", "size": 16 } },
+				{ "name": "tags", "type": { "type": "array", "items": "string" } }
+			]
+		}`, "",
+			`raystack.Booking: reader type "raystack.Booking" does not match writer type "raystack.Reservation" by name or alias`},
+		{"should report union without writer type", `{
+			"type": "record",
+			"name": "Booking",
+			"namespace": "raystack",
+			"fields": [
+				{ "name": "id", "type": "string" },
+				{ "name": "count", "type": ["null", "int"] },
+				{ "name": "note", "type": "string", "default": "" },
+				{ "name": "status", "type": { "type": "enum", "name": "Status", "symbols": ["OPEN", "CLOSED"] } },
+				{ "name": "driver", "type": { "type": "record", "name": "Driver", "fields": [{ "name": "name", "type": "string" }] } },
+				{ "name": "hash", "type": { "type": "fixed", "name": "Hash", "size": 16 } },
+				{ "name": "tags", "type": { "type": "array", "items": "string" } }
+			]
+		}`,
+			`raystack.Booking.note: reader type "string" can not read writer type "null"`,
+			`raystack.Booking.count: reader type "int" can not read writer type "null"`},
+		{"should allow type promotion into union but not narrowing", `{
+			"type": "record",
+			"name": "Booking",
+			"namespace": "raystack",
+			"fields": [
+				{ "name": "id", "type": "string" },
+				{ "name": "count", "type": ["null", "double"] },
+				{ "name": "note", "type": ["null", "string"], "default": null },
+				{ "name": "status", "type": { "type": "enum", "name": "Status", "symbols": ["OPEN", "CLOSED"] } },
+				{ "name": "driver", "type": { "type": "record", "name": "Driver", "fields": [{ "name": "name", "type": "string" }] } },
+				{ "name": "hash", "type": { "type": "fixed", "name": "Hash", "size": 16 } },
+				{ "name": "tags", "type": { "type": "array", "items": "string" } }
+			]
+		}`, "",
+			`raystack.Booking.count: reader type "int" can not read writer type "null";raystack.Booking.count: reader type "int" can not read writer type "double"`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			current := parse(t, test.current)
+			for _, check := range []struct {
+				expected string
+				err      error
+			}{
+				{test.backward, current.IsBackwardCompatible(prev)},
+				{test.forward, current.IsForwardCompatible(prev)},
+			} {
+				if check.expected == "" {
+					assert.NoError(t, check.err)
+				} else if assert.Error(t, check.err) {
+					assert.Equal(t, check.expected, check.err.Error())
+				}
+			}
+		})
+	}
+	t.Run("should return violations with reader and writer types", func(t *testing.T) {
+		current := parse(t, `{"type": "record", "name": "Booking", "namespace": "raystack", "fields": [{ "name": "id", "type": "int" }]}`)
+		err := current.IsBackwardCompatible(prev)
+		reporter, ok := err.(schema.ViolationReporter)
+		if assert.True(t, ok) {
+			assert.Equal(t, []schema.Violation{{
+				Kind:     "typeMismatch",
+				Path:     "raystack.Booking.id",
+				Message:  `raystack.Booking.id: reader type "int" can not read writer type "string"`,
+				OldValue: "string",
+				NewValue: "int",
+			}}, reporter.Violations())
+		}
+	})
+	t.Run("should check recursive records", func(t *testing.T) {
+		list := `{"type": "record", "name": "Node", "fields": [{ "name": "value", "type": "int" }, { "name": "next", "type": ["null", "Node"] }]}`
+		changed := `{"type": "record", "name": "Node", "fields": [{ "name": "value", "type": "string" }, { "name": "next", "type": ["null", "Node"] }]}`
+		err := parse(t, changed).IsBackwardCompatible(parse(t, list))
+		if assert.Error(t, err) {
+			assert.Equal(t, `Node.value: reader type "string" can not read writer type "int"`, err.Error())
+		}
+		assert.NoError(t, parse(t, list).IsFullCompatible(parse(t, list)))
+	})
+}
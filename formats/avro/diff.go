@@ -0,0 +1,368 @@
+package avro
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	av "github.com/hamba/avro"
+	"github.com/raystack/stencil/core/schema"
+)
+
+type diffKind int
+
+const (
+	_ diffKind = iota
+	fieldAdd
+	fieldAddWithoutDefault
+	fieldDelete
+	fieldDeleteWithoutDefault
+	typeChange
+	typePromotion
+	logicalTypeChange
+	nameChange
+	defaultChange
+	enumSymbolAdd
+	enumSymbolDelete
+	fixedSizeChange
+	unionTypeAdd
+	unionTypeDelete
+	// kinds reported by compatibility checks, from the point of view of reader schema
+	missingDefault
+	typeMismatch
+	nameMismatch
+	logicalTypeMismatch
+	enumSymbolMissing
+	fixedSizeMismatch
+	unionTypeMissing
+)
+
+var diffKindNames = map[diffKind]string{
+	fieldAdd:                  "fieldAdd",
+	fieldAddWithoutDefault:    "fieldAddWithoutDefault",
+	fieldDelete:               "fieldDelete",
+	fieldDeleteWithoutDefault: "fieldDeleteWithoutDefault",
+	typeChange:                "typeChange",
+	typePromotion:             "typePromotion",
+	logicalTypeChange:         "logicalTypeChange",
+	nameChange:                "nameChange",
+	defaultChange:             "defaultChange",
+	enumSymbolAdd:             "enumSymbolAdd",
+	enumSymbolDelete:          "enumSymbolDelete",
+	fixedSizeChange:           "fixedSizeChange",
+	unionTypeAdd:              "unionTypeAdd",
+	unionTypeDelete:           "unionTypeDelete",
+	missingDefault:            "missingDefault",
+	typeMismatch:              "typeMismatch",
+	nameMismatch:              "nameMismatch",
+	logicalTypeMismatch:       "logicalTypeMismatch",
+	enumSymbolMissing:         "enumSymbolMissing",
+	fixedSizeMismatch:         "fixedSizeMismatch",
+	unionTypeMissing:          "unionTypeMissing",
+}
+
+func (d diffKind) String() string {
+	return diffKindNames[d]
+}
+
+// changes which stop new schema from reading data written with previous schema
+var backwardBreaking = []diffKind{
+	fieldAddWithoutDefault,
+	typeChange,
+	logicalTypeChange,
+	nameChange,
+	enumSymbolDelete,
+	fixedSizeChange,
+	unionTypeDelete,
+}
+
+// changes which stop previous schema from reading data written with new schema
+var forwardBreaking = []diffKind{
+	fieldDeleteWithoutDefault,
+	typeChange,
+	typePromotion,
+	logicalTypeChange,
+	nameChange,
+	enumSymbolAdd,
+	fixedSizeChange,
+	unionTypeAdd,
+}
+
+// promotions lists writer types which avro readers can promote to wider reader types
+var promotions = map[av.Type][]av.Type{
+	av.Int:    {av.Long, av.Float, av.Double},
+	av.Long:   {av.Float, av.Double},
+	av.Float:  {av.Double},
+	av.String: {av.Bytes},
+	av.Bytes:  {av.String},
+}
+
+func (d diffKind) contains(others []diffKind) bool {
+	for _, v := range others {
+		if v == d {
+			return true
+		}
+	}
+	return false
+}
+
+func (d diffKind) breakingFor() []string {
+	var modes []string
+	backward, forward := d.contains(backwardBreaking), d.contains(forwardBreaking)
+	if backward {
+		modes = append(modes, "COMPATIBILITY_BACKWARD")
+	}
+	if forward {
+		modes = append(modes, "COMPATIBILITY_FORWARD")
+	}
+	if backward || forward {
+		modes = append(modes, "COMPATIBILITY_FULL")
+	}
+	return modes
+}
+
+type changeSet struct {
+	// visited keeps record pairs already compared, to stop on recursive types
+	visited map[string]bool
+	changes []schema.Change
+}
+
+func (c *changeSet) add(kind diffKind, path string, oldValue, newValue interface{}, format string, args ...interface{}) {
+	c.changes = append(c.changes, schema.Change{
+		Kind:        kind.String(),
+		Path:        path,
+		Message:     fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)),
+		OldValue:    valueString(oldValue),
+		NewValue:    valueString(newValue),
+		BreakingFor: kind.breakingFor(),
+	})
+}
+
+// Diff returns changes made in the schema since given earlier schema, across record fields, types, defaults and enum symbols.
+func (s *Schema) Diff(against schema.ParsedSchema) ([]schema.Change, error) {
+	prev, err := s.verify(against)
+	if err != nil {
+		return nil, err
+	}
+	changes := &changeSet{visited: map[string]bool{}}
+	compareTypes(rootPath(s.sc), prev.sc, s.sc, changes)
+	sort.SliceStable(changes.changes, func(i, j int) bool {
+		return changes.changes[i].Path < changes.changes[j].Path
+	})
+	return changes.changes, nil
+}
+
+func compareTypes(path string, prev, curr av.Schema, changes *changeSet) {
+	prev, curr = deref(prev), deref(curr)
+	if prev.Type() == av.Union || curr.Type() == av.Union {
+		compareUnions(path, prev, curr, changes)
+		return
+	}
+	if prev.Type() != curr.Type() {
+		if isPromotion(prev.Type(), curr.Type()) {
+			changes.add(typePromotion, path, typeName(prev), typeName(curr), "type promoted from %q to %q", typeName(prev), typeName(curr))
+			return
+		}
+		changes.add(typeChange, path, typeName(prev), typeName(curr), "type changed from %q to %q", typeName(prev), typeName(curr))
+		return
+	}
+	if prevNamed, ok := prev.(av.NamedSchema); ok {
+		currNamed := curr.(av.NamedSchema)
+		if prevNamed.FullName() != currNamed.FullName() {
+			changes.add(nameChange, path, prevNamed.FullName(), currNamed.FullName(), "name changed from %q to %q", prevNamed.FullName(), currNamed.FullName())
+		}
+	}
+	if prevLogical, currLogical := logicalType(prev), logicalType(curr); prevLogical != currLogical {
+		changes.add(logicalTypeChange, path, prevLogical, currLogical, "logical type changed from %q to %q", prevLogical, currLogical)
+	}
+	switch p := prev.(type) {
+	case *av.RecordSchema:
+		compareRecords(path, p, curr.(*av.RecordSchema), changes)
+	case *av.EnumSchema:
+		compareEnums(path, p, curr.(*av.EnumSchema), changes)
+	case *av.FixedSchema:
+		if c := curr.(*av.FixedSchema); p.Size() != c.Size() {
+			changes.add(fixedSizeChange, path, p.Size(), c.Size(), "fixed size changed from %d to %d", p.Size(), c.Size())
+		}
+	case *av.ArraySchema:
+		compareTypes(path+"[]", p.Items(), curr.(*av.ArraySchema).Items(), changes)
+	case *av.MapSchema:
+		compareTypes(path+"{}", p.Values(), curr.(*av.MapSchema).Values(), changes)
+	}
+}
+
+func compareRecords(path string, prev, curr *av.RecordSchema, changes *changeSet) {
+	key := prev.FullName() + "|" + curr.FullName()
+	if changes.visited[key] {
+		return
+	}
+	changes.visited[key] = true
+	prevFields := map[string]*av.Field{}
+	for _, field := range prev.Fields() {
+		prevFields[field.Name()] = field
+	}
+	currFields := map[string]*av.Field{}
+	for _, field := range curr.Fields() {
+		currFields[field.Name()] = field
+		fieldPath := path + "." + field.Name()
+		prevField, ok := prevFields[field.Name()]
+		if !ok {
+			if field.HasDefault() {
+				changes.add(fieldAdd, fieldPath, nil, typeName(field.Type()), "field %q added", field.Name())
+			} else {
+				changes.add(fieldAddWithoutDefault, fieldPath, nil, typeName(field.Type()), "field %q added without default", field.Name())
+			}
+			continue
+		}
+		compareDefaults(fieldPath, prevField, field, changes)
+		compareTypes(fieldPath, prevField.Type(), field.Type(), changes)
+	}
+	for _, field := range prev.Fields() {
+		if _, ok := currFields[field.Name()]; ok {
+			continue
+		}
+		fieldPath := path + "." + field.Name()
+		if field.HasDefault() {
+			changes.add(fieldDelete, fieldPath, typeName(field.Type()), nil, "field %q deleted", field.Name())
+		} else {
+			changes.add(fieldDeleteWithoutDefault, fieldPath, typeName(field.Type()), nil, "field %q without default deleted", field.Name())
+		}
+	}
+}
+
+func compareDefaults(path string, prev, curr *av.Field, changes *changeSet) {
+	switch {
+	case prev.HasDefault() && !curr.HasDefault():
+		changes.add(defaultChange, path, prev.Default(), nil, "default removed")
+	case !prev.HasDefault() && curr.HasDefault():
+		changes.add(defaultChange, path, nil, curr.Default(), "default added")
+	case prev.HasDefault() && !reflect.DeepEqual(prev.Default(), curr.Default()):
+		changes.add(defaultChange, path, prev.Default(), curr.Default(), "default changed from %s to %s", valueString(prev.Default()), valueString(curr.Default()))
+	}
+}
+
+func compareEnums(path string, prev, curr *av.EnumSchema, changes *changeSet) {
+	for _, symbol := range curr.Symbols() {
+		if !contains(prev.Symbols(), symbol) {
+			changes.add(enumSymbolAdd, path, nil, symbol, "enum symbol %q added", symbol)
+		}
+	}
+	for _, symbol := range prev.Symbols() {
+		if !contains(curr.Symbols(), symbol) {
+			changes.add(enumSymbolDelete, path, symbol, nil, "enum symbol %q deleted", symbol)
+		}
+	}
+}
+
+// compareUnions matches union branches by type name, a non union type is treated as union of one branch
+func compareUnions(path string, prev, curr av.Schema, changes *changeSet) {
+	prevBranches, currBranches := unionBranches(prev), unionBranches(curr)
+	for name, branch := range currBranches {
+		prevBranch, ok := prevBranches[name]
+		if !ok {
+			changes.add(unionTypeAdd, path, nil, name, "type %q added to union", name)
+			continue
+		}
+		compareTypes(path, prevBranch, branch, changes)
+	}
+	for name := range prevBranches {
+		if _, ok := currBranches[name]; !ok {
+			changes.add(unionTypeDelete, path, name, nil, "type %q deleted from union", name)
+		}
+	}
+}
+
+func unionBranches(sc av.Schema) map[string]av.Schema {
+	branches := map[string]av.Schema{}
+	types := av.Schemas{sc}
+	if union, ok := sc.(*av.UnionSchema); ok {
+		types = union.Types()
+	}
+	for _, branch := range types {
+		branch = deref(branch)
+		name := string(branch.Type())
+		if named, ok := branch.(av.NamedSchema); ok {
+			name = named.FullName()
+		}
+		branches[name] = branch
+	}
+	return branches
+}
+
+func deref(sc av.Schema) av.Schema {
+	if ref, ok := sc.(*av.RefSchema); ok {
+		return ref.Schema()
+	}
+	return sc
+}
+
+func isPromotion(from, to av.Type) bool {
+	for _, t := range promotions[from] {
+		if t == to {
+			return true
+		}
+	}
+	return false
+}
+
+func rootPath(sc av.Schema) string {
+	if named, ok := sc.(av.NamedSchema); ok {
+		return named.FullName()
+	}
+	return string(sc.Type())
+}
+
+// typeName returns short name for the type, full name for named types
+func typeName(sc av.Schema) string {
+	sc = deref(sc)
+	switch s := sc.(type) {
+	case av.NamedSchema:
+		return s.FullName()
+	case *av.ArraySchema:
+		return fmt.Sprintf("array<%s>", typeName(s.Items()))
+	case *av.MapSchema:
+		return fmt.Sprintf("map<%s>", typeName(s.Values()))
+	case *av.UnionSchema:
+		var names []string
+		for _, t := range s.Types() {
+			names = append(names, typeName(t))
+		}
+		return "[" + strings.Join(names, ",") + "]"
+	}
+	if logical := logicalType(sc); logical != "" {
+		return fmt.Sprintf("%s(%s)", sc.Type(), logical)
+	}
+	return string(sc.Type())
+}
+
+func logicalType(sc av.Schema) string {
+	if s, ok := sc.(av.LogicalTypeSchema); ok && s.Logical() != nil {
+		return string(s.Logical().Type())
+	}
+	return ""
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func valueString(val interface{}) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+	data, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Sprint(val)
+	}
+	return string(data)
+}
@@ -0,0 +1,80 @@
+package avro_test
+
+import (
+	"testing"
+
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/formats/avro"
+	"github.com/raystack/stencil/formats/formattest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	prev, err := avro.ParseSchema([]byte(`{
+		"type": "record",
+		"name": "Booking",
+		"namespace": "a",
+		"fields": [
+			{ "name": "id", "type": "string" },
+			{ "name": "count", "type": "int", "default": 1 },
+			{ "name": "price", "type": "double" },
+			{ "name": "note", "type": "string", "default": "" },
+			{ "name": "code", "type": "string" },
+			{ "name": "status", "type": { "type": "enum", "name": "Status", "symbols": ["OPEN", "CLOSED"] } },
+			{ "name": "tags", "type": { "type": "array", "items": "int" } }
+		]
+	}`))
+	assert.NoError(t, err)
+	current, err := avro.ParseSchema([]byte(`{
+		"type": "record",
+		"name": "Booking",
+		"namespace": "a",
+		"fields": [
+			{ "name": "id", "type": ["null", "string"], "default": null },
+			{ "name": "count", "type": "long", "default": 2 },
+			{ "name": "price", "type": "string" },
+			{ "name": "status", "type": { "type": "enum", "name": "Status", "symbols": ["OPEN", "CANCELLED"] } },
+			{ "name": "tags", "type": { "type": "array", "items": "long" } },
+			{ "name": "source", "type": "string", "default": "app" },
+			{ "name": "user", "type": "string" }
+		]
+	}`))
+	assert.NoError(t, err)
+	changes, err := current.(schema.Differ).Diff(prev)
+	assert.NoError(t, err)
+
+	for _, test := range []struct {
+		kind        string
+		path        string
+		breakingFor []string
+	}{
+		{"fieldAdd", "a.Booking.source", nil},
+		{"fieldAddWithoutDefault", "a.Booking.user", []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FULL"}},
+		{"fieldDelete", "a.Booking.note", nil},
+		{"fieldDeleteWithoutDefault", "a.Booking.code", []string{"COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}},
+		{"typePromotion", "a.Booking.count", []string{"COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}},
+		{"typePromotion", "a.Booking.tags[]", []string{"COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}},
+		{"typeChange", "a.Booking.price", []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}},
+		{"defaultChange", "a.Booking.count", nil},
+		{"defaultChange", "a.Booking.id", nil},
+		{"unionTypeAdd", "a.Booking.id", []string{"COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}},
+		{"enumSymbolAdd", "a.Booking.status", []string{"COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}},
+		{"enumSymbolDelete", "a.Booking.status", []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FULL"}},
+	} {
+		change := formattest.FindChange(changes, test.kind, test.path)
+		if assert.NotNil(t, change, "%s %s", test.kind, test.path) {
+			assert.Equal(t, test.breakingFor, change.BreakingFor, "%s %s", test.kind, test.path)
+		}
+	}
+	assert.Equal(t, `a.Booking.count: type promoted from "int" to "long"`, formattest.FindChange(changes, "typePromotion", "a.Booking.count").Message)
+	assert.Equal(t, "1", formattest.FindChange(changes, "defaultChange", "a.Booking.count").OldValue)
+	assert.Equal(t, "2", formattest.FindChange(changes, "defaultChange", "a.Booking.count").NewValue)
+	assert.Equal(t, "null", formattest.FindChange(changes, "unionTypeAdd", "a.Booking.id").NewValue)
+	assert.Nil(t, formattest.FindChange(changes, "typeChange", "a.Booking.id"))
+
+	t.Run("should return no changes for same schema", func(t *testing.T) {
+		changes, err := current.(schema.Differ).Diff(current)
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+}
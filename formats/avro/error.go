@@ -0,0 +1,17 @@
+package avro
+
+import (
+	"fmt"
+
+	"github.com/raystack/stencil/core/schema"
+)
+
+// compatibilityErr collects every reason reader schema can not read data written with writer schema.
+// Old value of a violation is from writer schema and new value from reader schema.
+type compatibilityErr struct {
+	schema.ViolationCollector[diffKind]
+}
+
+func (c *compatibilityErr) add(kind diffKind, path string, writerValue, readerValue interface{}, format string, args ...interface{}) {
+	c.Add(kind, path, fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)), valueString(writerValue), valueString(readerValue))
+}
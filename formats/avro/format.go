@@ -0,0 +1,32 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/raystack/stencil/core/schema"
+)
+
+// Format is avro schema format plugin
+type Format struct{}
+
+func (Format) Name() string {
+	return avroFormat
+}
+
+func (Format) ContentType() string {
+	return "application/json"
+}
+
+func (Format) ParseSchema(data []byte) (schema.ParsedSchema, error) {
+	return ParseSchema(data)
+}
+
+// PrintSchema prints indented schema json
+func (Format) PrintSchema(data []byte, filter string) (string, string, error) {
+	var out bytes.Buffer
+	if err := json.Indent(&out, data, "", "  "); err != nil {
+		return string(data), "JSON", nil
+	}
+	return out.String(), "JSON", nil
+}
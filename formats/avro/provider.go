@@ -14,5 +14,5 @@ func ParseSchema(data []byte) (schema.ParsedSchema, error) {
 	if err != nil {
 		return nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: err}
 	}
-	return &Schema{sc: sc, data: data}, nil
+	return &Schema{sc: sc, data: data, aliases: parseAliases(data)}, nil
 }
@@ -13,20 +13,24 @@ import (
 const avroFormat = "FORMAT_AVRO"
 
 type Schema struct {
-	data []byte
-	sc   av.Schema
+	data    []byte
+	sc      av.Schema
+	aliases *aliases
 }
 
 func (s *Schema) Format() string {
 	return avroFormat
 }
 
+// GetCanonicalValue returns schema file identified by fingerprint of parsing canonical form, named types and record fields are searchable
 func (s *Schema) GetCanonicalValue() *schema.SchemaFile {
 	fingerprint := s.sc.Fingerprint()
 	id := uuid.NewSHA1(uuid.NameSpaceOID, fingerprint[:])
 	return &schema.SchemaFile{
-		ID:   id.String(),
-		Data: s.data,
+		ID:     id.String(),
+		Types:  getAllTypes(s.sc),
+		Data:   s.data,
+		Fields: getAllFields(s.sc),
 	}
 }
 
@@ -38,14 +42,14 @@ func (s *Schema) verify(against schema.ParsedSchema) (*Schema, error) {
 	return nil, &runtime.HTTPStatusError{HTTPStatus: 400, Err: fmt.Errorf("current and prev schema formats(%s, %s) are different", s.Format(), against.Format())}
 }
 
-// IsBackwardCompatible checks backward compatibility against given schema
+// IsBackwardCompatible checks whether data written with given schema can be read with this schema.
+// Every mismatch is reported along with record and field path, reader and writer types.
 func (s *Schema) IsBackwardCompatible(against schema.ParsedSchema) error {
 	prev, err := s.verify(against)
 	if err != nil {
 		return err
 	}
-	c := av.NewSchemaCompatibility()
-	return c.Compatible(s.sc, prev.sc)
+	return checkCompatibility(s, prev)
 }
 
 // IsForwardCompatible checks backward compatibility against given schema
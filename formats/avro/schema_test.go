@@ -7,7 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-// adding basic tests only since these compatibility checks already tested in avro lib
+// basic checks, reported violations are covered in compatibility_test.go
 func TestAvroCompatibility(t *testing.T) {
 	for _, test := range []struct {
 		name         string
@@ -95,3 +95,25 @@ func TestParseSchema(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestGetCanonicalValue(t *testing.T) {
+	sc, err := avro.ParseSchema([]byte(`{
+		"type": "record",
+		"name": "Booking",
+		"namespace": "raystack",
+		"fields": [
+			{ "name": "id", "type": "string" },
+			{ "name": "status", "type": { "type": "enum", "name": "Status", "symbols": ["OPEN", "CLOSED"] } },
+			{ "name": "drivers", "type": { "type": "array", "items": { "type": "record", "name": "Driver", "namespace": "raystack.fleet", "fields": [
+				{ "name": "name", "type": "string" },
+				{ "name": "backup", "type": ["null", "Driver"] }
+			] } } },
+			{ "name": "previous", "type": ["null", "Booking"] }
+		]
+	}`))
+	assert.NoError(t, err)
+	file := sc.GetCanonicalValue()
+	assert.Equal(t, []string{"raystack.Booking", "raystack.Status", "raystack.fleet.Driver"}, file.Types)
+	assert.Equal(t, []string{"raystack.Booking.id", "raystack.Booking.status", "raystack.Booking.drivers", "raystack.Booking.previous",
+		"raystack.fleet.Driver.name", "raystack.fleet.Driver.backup"}, file.Fields)
+}
@@ -0,0 +1,57 @@
+package avro
+
+import (
+	av "github.com/hamba/avro"
+)
+
+// forEachNamedType calls f once for every record, enum and fixed type defined in the schema
+func forEachNamedType(sc av.Schema, f func(av.NamedSchema)) {
+	visited := map[string]bool{}
+	var walk func(sc av.Schema)
+	walk = func(sc av.Schema) {
+		switch s := sc.(type) {
+		case av.NamedSchema:
+			if visited[s.FullName()] {
+				return
+			}
+			visited[s.FullName()] = true
+			f(s)
+			if record, ok := s.(*av.RecordSchema); ok {
+				for _, field := range record.Fields() {
+					walk(field.Type())
+				}
+			}
+		case *av.ArraySchema:
+			walk(s.Items())
+		case *av.MapSchema:
+			walk(s.Values())
+		case *av.UnionSchema:
+			for _, branch := range s.Types() {
+				walk(branch)
+			}
+		}
+	}
+	walk(sc)
+}
+
+// getAllTypes returns full names of named types
+func getAllTypes(sc av.Schema) []string {
+	var types []string
+	forEachNamedType(sc, func(named av.NamedSchema) {
+		types = append(types, named.FullName())
+	})
+	return types
+}
+
+// getAllFields returns record fields as record full name and field name, like raystack.Booking.id
+func getAllFields(sc av.Schema) []string {
+	var fields []string
+	forEachNamedType(sc, func(named av.NamedSchema) {
+		if record, ok := named.(*av.RecordSchema); ok {
+			for _, field := range record.Fields() {
+				fields = append(fields, record.FullName()+"."+field.Name())
+			}
+		}
+	})
+	return fields
+}
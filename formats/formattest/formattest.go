@@ -0,0 +1,36 @@
+// Package formattest provides helpers shared by tests of schema formats.
+package formattest
+
+import (
+	"testing"
+
+	"github.com/raystack/stencil/core/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// Parser returns parse helper of a format which fails the test if data can not be parsed
+func Parser(parse func([]byte) (schema.ParsedSchema, error)) func(t *testing.T, data string) schema.ParsedSchema {
+	return func(t *testing.T, data string) schema.ParsedSchema {
+		t.Helper()
+		sc, err := parse([]byte(data))
+		assert.NoError(t, err)
+		return sc
+	}
+}
+
+// FindChange returns change of kind at path from diff of two schemas, nil if there is no such change
+func FindChange(changes []schema.Change, kind, path string) *schema.Change {
+	for i := range changes {
+		if changes[i].Kind == kind && changes[i].Path == path {
+			return &changes[i]
+		}
+	}
+	return nil
+}
+
+// AssertID checks that equivalent schema has same id as sc and changed schema has a different one
+func AssertID(t *testing.T, sc, equivalent, changed schema.ParsedSchema) {
+	t.Helper()
+	assert.Equal(t, sc.GetCanonicalValue().ID, equivalent.GetCanonicalValue().ID)
+	assert.NotEqual(t, sc.GetCanonicalValue().ID, changed.GetCanonicalValue().ID)
+}
@@ -0,0 +1,309 @@
+package graphql
+
+import (
+	"github.com/raystack/stencil/core/schema"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+type diffKind int
+
+const (
+	_ diffKind = iota
+	typeDelete
+	typeKindChange
+	fieldDelete
+	fieldTypeChange
+	fieldNullable
+	fieldNonNull
+	inputFieldRequired
+	inputFieldOptional
+	requiredInputFieldAdd
+	argumentDelete
+	argumentTypeChange
+	argumentRequired
+	argumentOptional
+	requiredArgumentAdd
+	enumValueDelete
+	unionMemberDelete
+	interfaceDelete
+	// changes below are only reported by diff, none of the compatibility modes reject them
+	typeAdd
+	fieldAdd
+	argumentAdd
+	defaultChange
+	enumValueAdd
+	unionMemberAdd
+	interfaceAdd
+	deprecationChange
+)
+
+var diffKindNames = map[diffKind]string{
+	typeDelete:            "typeDelete",
+	typeKindChange:        "typeKindChange",
+	fieldDelete:           "fieldDelete",
+	fieldTypeChange:       "fieldTypeChange",
+	fieldNullable:         "fieldNullable",
+	fieldNonNull:          "fieldNonNull",
+	inputFieldRequired:    "inputFieldRequired",
+	inputFieldOptional:    "inputFieldOptional",
+	requiredInputFieldAdd: "requiredInputFieldAdd",
+	argumentDelete:        "argumentDelete",
+	argumentTypeChange:    "argumentTypeChange",
+	argumentRequired:      "argumentRequired",
+	argumentOptional:      "argumentOptional",
+	requiredArgumentAdd:   "requiredArgumentAdd",
+	enumValueDelete:       "enumValueDelete",
+	unionMemberDelete:     "unionMemberDelete",
+	interfaceDelete:       "interfaceDelete",
+	typeAdd:               "typeAdd",
+	fieldAdd:              "fieldAdd",
+	argumentAdd:           "argumentAdd",
+	defaultChange:         "defaultChange",
+	enumValueAdd:          "enumValueAdd",
+	unionMemberAdd:        "unionMemberAdd",
+	interfaceAdd:          "interfaceAdd",
+	deprecationChange:     "deprecationChange",
+}
+
+// backward compatibility keeps queries of existing clients valid, forward compatibility keeps queries of
+// clients written against new schema valid on previous schema, as in rollback of a subgraph, for elements
+// present in both schemas. Types, fields, arguments and enum values added by new schema are not yet served
+// by previous schema, so clients written against new schema can only rely on them once new schema is live.
+var (
+	backwardCompatibility = []diffKind{
+		typeDelete,
+		typeKindChange,
+		fieldDelete,
+		fieldTypeChange,
+		fieldNullable,
+		inputFieldRequired,
+		requiredInputFieldAdd,
+		argumentDelete,
+		argumentTypeChange,
+		argumentRequired,
+		requiredArgumentAdd,
+		enumValueDelete,
+		unionMemberDelete,
+		interfaceDelete}
+	forwardCompatibility = []diffKind{
+		typeKindChange,
+		fieldTypeChange,
+		fieldNonNull,
+		inputFieldOptional,
+		argumentTypeChange,
+		argumentOptional}
+	fullCompatibility = []diffKind{
+		typeDelete,
+		typeKindChange,
+		fieldDelete,
+		fieldTypeChange,
+		fieldNullable,
+		fieldNonNull,
+		inputFieldRequired,
+		inputFieldOptional,
+		requiredInputFieldAdd,
+		argumentDelete,
+		argumentTypeChange,
+		argumentRequired,
+		argumentOptional,
+		requiredArgumentAdd,
+		enumValueDelete,
+		unionMemberDelete,
+		interfaceDelete}
+)
+
+func (d diffKind) String() string {
+	return diffKindNames[d]
+}
+
+// inputKinds are change kinds of an input value, which is either an argument or an input object field
+type inputKinds struct {
+	noun       string
+	typeChange diffKind
+	required   diffKind
+	optional   diffKind
+}
+
+var (
+	argumentKinds   = inputKinds{"argument", argumentTypeChange, argumentRequired, argumentOptional}
+	inputFieldKinds = inputKinds{"field", fieldTypeChange, inputFieldRequired, inputFieldOptional}
+)
+
+func compareSchemas(current, prev *Schema, notAllowedChanges []diffKind) error {
+	diffs := &compatibilityErr{}
+	walkSchemas(current, prev, diffRecorder{schema.RejectedChanges[diffKind](diffs, notAllowedChanges)})
+	if diffs.IsEmpty() {
+		return nil
+	}
+	return diffs
+}
+
+// walkSchemas records every change from prev to current schema, types are matched by name
+func walkSchemas(current, prev *Schema, diffs diffRecorder) {
+	for _, prevDef := range userTypes(prev.schema) {
+		currentDef := getType(current.schema, prevDef.Name)
+		if currentDef == nil {
+			diffs.add(typeDelete, prevDef.Name, prevDef.Name, nil, `%s "%s" is removed`, prevDef.Kind, prevDef.Name)
+			continue
+		}
+		if prevDef.Kind != currentDef.Kind {
+			diffs.add(typeKindChange, prevDef.Name, prevDef.Kind, currentDef.Kind, `"%s" changed from %s to %s`, prevDef.Name, prevDef.Kind, currentDef.Kind)
+			continue
+		}
+		compareTypes(currentDef, prevDef, diffs)
+	}
+	for _, currentDef := range userTypes(current.schema) {
+		if getType(prev.schema, currentDef.Name) == nil {
+			diffs.add(typeAdd, currentDef.Name, nil, currentDef.Name, `%s "%s" is added`, currentDef.Kind, currentDef.Name)
+		}
+	}
+}
+
+func compareTypes(current, prev *ast.Definition, diffs diffRecorder) {
+	switch prev.Kind {
+	case ast.Object, ast.Interface, ast.InputObject:
+		compareFields(current, prev, diffs)
+	case ast.Enum:
+		compareEnums(current, prev, diffs)
+	case ast.Union:
+		compareMembers(unionMemberDelete, unionMemberAdd, "member", current.Name, current.Types, prev.Types, diffs)
+	}
+	if prev.Kind == ast.Object || prev.Kind == ast.Interface {
+		compareMembers(interfaceDelete, interfaceAdd, "interface", current.Name, current.Interfaces, prev.Interfaces, diffs)
+	}
+}
+
+func compareFields(current, prev *ast.Definition, diffs diffRecorder) {
+	input := prev.Kind == ast.InputObject
+	for _, prevField := range userFields(prev) {
+		path := prev.Name + "." + prevField.Name
+		currentField := current.Fields.ForName(prevField.Name)
+		if currentField == nil {
+			diffs.add(fieldDelete, path, prevField.Name, nil, `field "%s" is deleted`, prevField.Name)
+			continue
+		}
+		if input {
+			compareInputValues(inputFieldKinds, path, prevField.Name, currentField.Type, prevField.Type, currentField.DefaultValue, prevField.DefaultValue, diffs)
+		} else {
+			compareOutputTypes(path, prevField.Name, currentField.Type, prevField.Type, diffs)
+			compareArguments(path, currentField.Arguments, prevField.Arguments, diffs)
+		}
+		prevDeprecation, currentDeprecation := deprecation(prevField.Directives), deprecation(currentField.Directives)
+		if prevDeprecation != currentDeprecation {
+			diffs.add(deprecationChange, path, prevDeprecation, currentDeprecation, `field "%s" deprecation changed from "%s" to "%s"`, prevField.Name, prevDeprecation, currentDeprecation)
+		}
+	}
+	for _, currentField := range userFields(current) {
+		if prev.Fields.ForName(currentField.Name) != nil {
+			continue
+		}
+		path := current.Name + "." + currentField.Name
+		if input && isRequired(currentField.Type, currentField.DefaultValue) {
+			diffs.add(requiredInputFieldAdd, path, nil, currentField.Type, `required field "%s" is added`, currentField.Name)
+		} else {
+			diffs.add(fieldAdd, path, nil, currentField.Type, `field "%s" is added`, currentField.Name)
+		}
+	}
+}
+
+// compareOutputTypes checks type of field returned to clients, making it non null keeps existing clients valid
+func compareOutputTypes(path, name string, current, prev *ast.Type, diffs diffRecorder) {
+	if prev.String() == current.String() {
+		return
+	}
+	switch {
+	case !sameShape(current, prev):
+		diffs.add(fieldTypeChange, path, prev, current, `field "%s" type changed from "%s" to "%s"`, name, prev, current)
+	case isStricter(current, prev):
+		diffs.add(fieldNonNull, path, prev, current, `field "%s" is made non null, type changed from "%s" to "%s"`, name, prev, current)
+	case isStricter(prev, current):
+		diffs.add(fieldNullable, path, prev, current, `field "%s" is made nullable, type changed from "%s" to "%s"`, name, prev, current)
+	default:
+		diffs.add(fieldTypeChange, path, prev, current, `field "%s" type changed from "%s" to "%s"`, name, prev, current)
+	}
+}
+
+// compareInputValues checks type of value sent by clients, making it nullable or giving it a default keeps existing clients valid
+func compareInputValues(kinds inputKinds, path, name string, current, prev *ast.Type, currentDefault, prevDefault *ast.Value, diffs diffRecorder) {
+	switch {
+	case prev.String() == current.String():
+		prevRequired, currentRequired := isRequired(prev, prevDefault), isRequired(current, currentDefault)
+		if !prevRequired && currentRequired {
+			diffs.add(kinds.required, path, prev, current, `%s "%s" is made required, default value is removed`, kinds.noun, name)
+		}
+		if prevRequired && !currentRequired {
+			diffs.add(kinds.optional, path, prev, current, `%s "%s" is made optional, default value is added`, kinds.noun, name)
+		}
+	case !sameShape(current, prev):
+		diffs.add(kinds.typeChange, path, prev, current, `%s "%s" type changed from "%s" to "%s"`, kinds.noun, name, prev, current)
+	case isStricter(current, prev) && isRequired(current, currentDefault):
+		diffs.add(kinds.required, path, prev, current, `%s "%s" is made required, type changed from "%s" to "%s"`, kinds.noun, name, prev, current)
+	case isStricter(current, prev):
+		diffs.add(kinds.required, path, prev, current, `%s "%s" is made non null, type changed from "%s" to "%s"`, kinds.noun, name, prev, current)
+	case isStricter(prev, current):
+		diffs.add(kinds.optional, path, prev, current, `%s "%s" is made optional, type changed from "%s" to "%s"`, kinds.noun, name, prev, current)
+	default:
+		diffs.add(kinds.typeChange, path, prev, current, `%s "%s" type changed from "%s" to "%s"`, kinds.noun, name, prev, current)
+	}
+	prevValue, currentValue := valueString(prevDefault), valueString(currentDefault)
+	if prevValue != currentValue {
+		diffs.add(defaultChange, path, prevValue, currentValue, `%s "%s" default changed from "%s" to "%s"`, kinds.noun, name, prevValue, currentValue)
+	}
+}
+
+func compareArguments(fieldPath string, current, prev ast.ArgumentDefinitionList, diffs diffRecorder) {
+	for _, prevArg := range prev {
+		path := fieldPath + "(" + prevArg.Name + ")"
+		currentArg := current.ForName(prevArg.Name)
+		if currentArg == nil {
+			diffs.add(argumentDelete, path, prevArg.Name, nil, `argument "%s" is deleted`, prevArg.Name)
+			continue
+		}
+		compareInputValues(argumentKinds, path, prevArg.Name, currentArg.Type, prevArg.Type, currentArg.DefaultValue, prevArg.DefaultValue, diffs)
+	}
+	for _, currentArg := range current {
+		if prev.ForName(currentArg.Name) != nil {
+			continue
+		}
+		path := fieldPath + "(" + currentArg.Name + ")"
+		if isRequired(currentArg.Type, currentArg.DefaultValue) {
+			diffs.add(requiredArgumentAdd, path, nil, currentArg.Type, `required argument "%s" is added`, currentArg.Name)
+		} else {
+			diffs.add(argumentAdd, path, nil, currentArg.Type, `argument "%s" is added`, currentArg.Name)
+		}
+	}
+}
+
+func compareEnums(current, prev *ast.Definition, diffs diffRecorder) {
+	for _, prevValue := range prev.EnumValues {
+		path := prev.Name + "." + prevValue.Name
+		currentValue := current.EnumValues.ForName(prevValue.Name)
+		if currentValue == nil {
+			diffs.add(enumValueDelete, path, prevValue.Name, nil, `enum value "%s" is deleted from "%s"`, prevValue.Name, prev.Name)
+			continue
+		}
+		prevDeprecation, currentDeprecation := deprecation(prevValue.Directives), deprecation(currentValue.Directives)
+		if prevDeprecation != currentDeprecation {
+			diffs.add(deprecationChange, path, prevDeprecation, currentDeprecation, `enum value "%s" deprecation changed from "%s" to "%s"`, prevValue.Name, prevDeprecation, currentDeprecation)
+		}
+	}
+	for _, currentValue := range current.EnumValues {
+		if prev.EnumValues.ForName(currentValue.Name) == nil {
+			diffs.add(enumValueAdd, current.Name+"."+currentValue.Name, nil, currentValue.Name, `enum value "%s" is added to "%s"`, currentValue.Name, current.Name)
+		}
+	}
+}
+
+// compareMembers compares union member types or implemented interfaces of a type
+func compareMembers(deleteKind, addKind diffKind, noun, path string, current, prev []string, diffs diffRecorder) {
+	for _, name := range prev {
+		if !contains(current, name) {
+			diffs.add(deleteKind, path, name, nil, `%s "%s" is removed from "%s"`, noun, name, path)
+		}
+	}
+	for _, name := range current {
+		if !contains(prev, name) {
+			diffs.add(addKind, path, nil, name, `%s "%s" is added to "%s"`, noun, name, path)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompatibility(t *testing.T) {
+	prev := parse(t, `
+enum Status { OPEN CLOSED }
+type Booking { id: ID!, status: Status, note: String! }
+input BookingFilter { status: Status }
+type Query { booking(id: ID!): Booking, bookings(filter: BookingFilter, limit: Int = 10): [Booking] }
+`)
+	for _, test := range []struct {
+		name     string
+		current  string
+		backward string
+		forward  string
+	}{
+		{"optional field and argument addition is allowed", `
+enum Status { OPEN CLOSED }
+type Booking { id: ID!, status: Status, note: String!, total: Float }
+input BookingFilter { status: Status, from: String }
+type Query { booking(id: ID!, cached: Boolean): Booking, bookings(filter: BookingFilter, limit: Int = 10): [Booking] }
+`, "", ""},
+		{"type, enum value and required argument addition is forward compatible", `
+enum Status { OPEN CLOSED CANCELLED }
+enum Region { NORTH SOUTH }
+type Booking { id: ID!, status: Status, note: String!, region: Region }
+input BookingFilter { status: Status, region: Region! }
+type Query { booking(id: ID!, region: Region!): Booking, bookings(filter: BookingFilter, limit: Int = 10): [Booking] }
+`, `BookingFilter.region: required field "region" is added;Query.booking(region): required argument "region" is added`, ""},
+		{"field deletion is not backward compatible", `
+enum Status { OPEN CLOSED }
+type Booking { id: ID!, status: Status }
+input BookingFilter { status: Status }
+type Query { booking(id: ID!): Booking, bookings(filter: BookingFilter, limit: Int = 10): [Booking] }
+`, `Booking.note: field "note" is deleted`, ""},
+		{"type deletion is not backward compatible", `
+enum Status { OPEN CLOSED }
+type Booking { id: ID!, status: Status, note: String! }
+type Query { booking(id: ID!): Booking, bookings(limit: Int = 10): [Booking] }
+`, `BookingFilter: INPUT_OBJECT "BookingFilter" is removed;Query.bookings(filter): argument "filter" is deleted`, ""},
+		{"making argument required is not backward compatible", `
+enum Status { OPEN CLOSED }
+type Booking { id: ID!, status: Status, note: String! }
+input BookingFilter { status: Status }
+type Query { booking(id: ID!): Booking, bookings(filter: BookingFilter!, limit: Int!): [Booking] }
+`, `Query.bookings(filter): argument "filter" is made required, type changed from "BookingFilter" to "BookingFilter!";Query.bookings(limit): argument "limit" is made required, type changed from "Int" to "Int!"`, ""},
+		{"removing default of nullable argument is allowed", `
+enum Status { OPEN CLOSED }
+type Booking { id: ID!, status: Status, note: String! }
+input BookingFilter { status: Status }
+type Query { booking(id: ID!): Booking, bookings(filter: BookingFilter, limit: Int): [Booking] }
+`, "", ""},
+		{"required argument and input field addition is not backward compatible", `
+enum Status { OPEN CLOSED }
+type Booking { id: ID!, status: Status, note: String! }
+input BookingFilter { status: Status, from: String! }
+type Query { booking(id: ID!, region: String!): Booking, bookings(filter: BookingFilter, limit: Int = 10): [Booking] }
+`, `BookingFilter.from: required field "from" is added;Query.booking(region): required argument "region" is added`, ""},
+		{"making argument optional is not forward compatible", `
+enum Status { OPEN CLOSED }
+type Booking { id: ID!, status: Status, note: String! }
+input BookingFilter { status: Status }
+type Query { booking(id: ID): Booking, bookings(filter: BookingFilter, limit: Int = 10): [Booking] }
+`, "", `Query.booking(id): argument "id" is made optional, type changed from "ID!" to "ID"`},
+		{"making field nullable is not backward compatible", `
+enum Status { OPEN CLOSED }
+type Booking { id: ID!, status: Status, note: String }
+input BookingFilter { status: Status }
+type Query { booking(id: ID!): Booking, bookings(filter: BookingFilter, limit: Int = 10): [Booking] }
+`, `Booking.note: field "note" is made nullable, type changed from "String!" to "String"`, ""},
+		{"making field non null is not forward compatible", `
+enum Status { OPEN CLOSED }
+type Booking { id: ID!, status: Status!, note: String! }
+input BookingFilter { status: Status }
+type Query { booking(id: ID!): Booking, bookings(filter: BookingFilter, limit: Int = 10): [Booking!] }
+`, "", `Booking.status: field "status" is made non null, type changed from "Status" to "Status!";Query.bookings: field "bookings" is made non null, type changed from "[Booking]" to "[Booking!]"`},
+		{"field type change is not compatible", `
+enum Status { OPEN CLOSED }
+type Booking { id: ID!, status: String, note: String! }
+input BookingFilter { status: Status }
+type Query { booking(id: ID!): Booking, bookings(filter: BookingFilter, limit: Int = 10): Booking }
+`, `Booking.status: field "status" type changed from "Status" to "String";Query.bookings: field "bookings" type changed from "[Booking]" to "Booking"`, `Booking.status: field "status" type changed from "Status" to "String";Query.bookings: field "bookings" type changed from "[Booking]" to "Booking"`},
+		{"enum value deletion is not backward compatible", `
+enum Status { OPEN }
+type Booking { id: ID!, status: Status, note: String! }
+input BookingFilter { status: Status }
+type Query { booking(id: ID!): Booking, bookings(filter: BookingFilter, limit: Int = 10): [Booking] }
+`, `Status.CLOSED: enum value "CLOSED" is deleted from "Status"`, ""},
+		{"type kind change is not compatible", `
+scalar Status
+type Booking { id: ID!, status: Status, note: String! }
+input BookingFilter { status: Status }
+type Query { booking(id: ID!): Booking, bookings(filter: BookingFilter, limit: Int = 10): [Booking] }
+`, `Status: "Status" changed from ENUM to SCALAR`, `Status: "Status" changed from ENUM to SCALAR`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			current := parse(t, test.current)
+			for _, check := range []struct {
+				expected string
+				err      error
+			}{
+				{test.backward, current.IsBackwardCompatible(prev)},
+				{test.forward, current.IsForwardCompatible(prev)},
+			} {
+				if check.expected == "" {
+					assert.NoError(t, check.err)
+				} else if assert.Error(t, check.err) {
+					assert.Equal(t, check.expected, check.err.Error())
+				}
+			}
+			full := current.IsFullCompatible(prev)
+			if test.backward == "" && test.forward == "" {
+				assert.NoError(t, full)
+			} else {
+				assert.Error(t, full)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package graphql
+
+import (
+	"github.com/raystack/stencil/core/schema"
+)
+
+// compatibilityModes classifies changes listed by diff
+var compatibilityModes = schema.CompatibilityModes[diffKind]{
+	Backward: backwardCompatibility,
+	Forward:  forwardCompatibility,
+	Full:     fullCompatibility,
+}
+
+// Diff returns changes made in the schema since given earlier schema, across types, fields, arguments, enum values, union members and interfaces.
+func (s *Schema) Diff(against schema.ParsedSchema) ([]schema.Change, error) {
+	prev, err := s.verify(against)
+	if err != nil {
+		return nil, err
+	}
+	changes := schema.NewChangeSet(compatibilityModes)
+	walkSchemas(s, prev, diffRecorder{changes})
+	return changes.Changes(), nil
+}
@@ -0,0 +1,77 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/formats/formattest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	prev := parse(t, bookingSDL)
+	current := parse(t, `enum Status {
+  OPEN
+  CANCELLED
+}
+
+interface Node {
+  id: ID!
+}
+
+type Booking implements Node @key(fields: "id") {
+  id: ID!
+  status: Status @deprecated(reason: "use state")
+  total(currency: String! = "EUR"): Float!
+}
+
+input BookingFilter {
+  status: Status
+  from: String!
+}
+
+type Query {
+  booking(id: ID, cached: Boolean): Booking
+  bookings(filter: BookingFilter): [Booking!]!
+}
+`)
+	changes, err := current.(schema.Differ).Diff(prev)
+	assert.NoError(t, err)
+
+	backward := []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FULL"}
+	forward := []string{"COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}
+	for _, test := range []struct {
+		kind        string
+		path        string
+		breakingFor []string
+	}{
+		{"typeAdd", "Node", nil},
+		{"typeDelete", "SearchResult", backward},
+		{"interfaceAdd", "Booking", nil},
+		{"enumValueAdd", "Status.CANCELLED", nil},
+		{"enumValueDelete", "Status.CLOSED", backward},
+		{"deprecationChange", "Booking.status", nil},
+		{"fieldNonNull", "Booking.total", forward},
+		{"argumentRequired", "Booking.total(currency)", backward},
+		{"defaultChange", "Booking.total(currency)", nil},
+		{"argumentOptional", "Query.booking(id)", forward},
+		{"argumentAdd", "Query.booking(cached)", nil},
+		{"requiredInputFieldAdd", "BookingFilter.from", backward},
+	} {
+		change := formattest.FindChange(changes, test.kind, test.path)
+		if assert.NotNil(t, change, "%s %s", test.kind, test.path) {
+			assert.Equal(t, test.breakingFor, change.BreakingFor, "%s %s", test.kind, test.path)
+		}
+	}
+	currency := formattest.FindChange(changes, "argumentRequired", "Booking.total(currency)")
+	assert.Equal(t, `Booking.total(currency): argument "currency" is made non null, type changed from "String" to "String!"`, currency.Message)
+	assert.Equal(t, "String", currency.OldValue)
+	assert.Equal(t, "String!", currency.NewValue)
+	assert.Equal(t, `"EUR"`, formattest.FindChange(changes, "defaultChange", "Booking.total(currency)").NewValue)
+
+	t.Run("should return no changes for same schema", func(t *testing.T) {
+		changes, err := current.(schema.Differ).Diff(current)
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+}
@@ -0,0 +1,20 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/raystack/stencil/core/schema"
+)
+
+type compatibilityErr struct {
+	schema.ViolationCollector[diffKind]
+}
+
+// diffRecorder formats changes found while walking schemas for a compatibility error or a change set
+type diffRecorder struct {
+	schema.DiffRecorder[diffKind]
+}
+
+func (r diffRecorder) add(kind diffKind, path string, oldValue, newValue interface{}, format string, args ...interface{}) {
+	r.Add(kind, path, fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)), valueString(oldValue), valueString(newValue))
+}
@@ -0,0 +1,29 @@
+package graphql
+
+import (
+	"github.com/raystack/stencil/core/schema"
+)
+
+// Format is graphql schema format plugin, schema data is SDL source of a single schema or federated subgraph
+type Format struct{}
+
+func (Format) Name() string {
+	return graphqlFormat
+}
+
+func (Format) ContentType() string {
+	return "text/plain"
+}
+
+func (Format) ParseSchema(data []byte) (schema.ParsedSchema, error) {
+	return ParseSchema(data)
+}
+
+// PrintSchema prints formatted SDL along with comments, types are sorted by name
+func (Format) PrintSchema(data []byte, filter string) (string, string, error) {
+	sc, err := ParseSchema(data)
+	if err != nil {
+		return "", "", err
+	}
+	return printSDL(sc.(*Schema).schema, true), "GraphQL", nil
+}
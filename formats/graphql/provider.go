@@ -0,0 +1,76 @@
+package graphql
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+const fileName = "schema.graphql"
+
+// federationDefinitions declares federation directives so subgraph SDLs can use them without declaring.
+// Argument values of directives are not type checked on schema, so field sets and imports are kept loose.
+var federationDefinitions = []struct {
+	name       string
+	directive  bool
+	definition string
+}{
+	{"_FieldSet", false, "scalar _FieldSet"},
+	{"_Any", false, "scalar _Any"},
+	{"key", true, "directive @key(fields: _FieldSet!, resolvable: Boolean = true) repeatable on OBJECT | INTERFACE"},
+	{"requires", true, "directive @requires(fields: _FieldSet!) on FIELD_DEFINITION"},
+	{"provides", true, "directive @provides(fields: _FieldSet!) on FIELD_DEFINITION"},
+	{"external", true, "directive @external(reason: String) on OBJECT | FIELD_DEFINITION"},
+	{"extends", true, "directive @extends on OBJECT | INTERFACE"},
+	{"shareable", true, "directive @shareable repeatable on OBJECT | FIELD_DEFINITION"},
+	{"inaccessible", true, "directive @inaccessible on FIELD_DEFINITION | OBJECT | INTERFACE | UNION | ARGUMENT_DEFINITION | SCALAR | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION"},
+	{"override", true, "directive @override(from: String!, label: String) on FIELD_DEFINITION"},
+	{"tag", true, "directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT | INTERFACE | UNION | ARGUMENT_DEFINITION | SCALAR | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION | SCHEMA"},
+	{"interfaceObject", true, "directive @interfaceObject on OBJECT"},
+	{"composeDirective", true, "directive @composeDirective(name: String!) repeatable on SCHEMA"},
+	{"link", true, "directive @link(url: String!, as: String, for: String, import: [_Any]) repeatable on SCHEMA"},
+}
+
+// ParseSchema parses and validates SDL into ParsedSchema
+func ParseSchema(data []byte) (schema.ParsedSchema, error) {
+	source := &ast.Source{Name: fileName, Input: string(data)}
+	doc, err := parser.ParseSchema(source)
+	if err != nil {
+		return nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: err}
+	}
+	sc, err := gqlparser.LoadSchema(federationSource(doc), source)
+	if err != nil {
+		return nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: err}
+	}
+	return &Schema{data: data, schema: sc}, nil
+}
+
+// federationSource returns built in source with federation definitions not declared by the document
+func federationSource(doc *ast.SchemaDocument) *ast.Source {
+	var definitions []string
+	for _, def := range federationDefinitions {
+		if def.directive && doc.Directives.ForName(def.name) == nil ||
+			!def.directive && doc.Definitions.ForName(def.name) == nil {
+			definitions = append(definitions, def.definition)
+		}
+	}
+	return &ast.Source{Name: "federation.graphql", Input: strings.Join(definitions, "\n"), BuiltIn: true}
+}
+
+// printSDL renders schema without built in definitions, comments are kept if comments is set
+func printSDL(sc *ast.Schema, comments bool) string {
+	var buf bytes.Buffer
+	var options []formatter.FormatterOption
+	if comments {
+		options = append(options, formatter.WithComments())
+	}
+	formatter.NewFormatter(&buf, options...).FormatSchema(sc)
+	return strings.TrimSpace(buf.String()) + "\n"
+}
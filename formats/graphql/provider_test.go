@@ -0,0 +1,80 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/raystack/stencil/formats/formattest"
+	"github.com/raystack/stencil/formats/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+const bookingSDL = `enum Status {
+  OPEN
+  CLOSED
+}
+
+type Booking @key(fields: "id") {
+  id: ID!
+  status: Status
+  total(currency: String = "USD"): Float
+}
+
+input BookingFilter {
+  status: Status
+}
+
+union SearchResult = Booking
+
+type Query {
+  booking(id: ID!): Booking
+  bookings(filter: BookingFilter): [Booking!]!
+}
+`
+
+var parse = formattest.Parser(graphql.ParseSchema)
+
+func TestParseSchema(t *testing.T) {
+	t.Run("should return search data of types and fields", func(t *testing.T) {
+		sc := parse(t, bookingSDL)
+		file := sc.GetCanonicalValue()
+		assert.Equal(t, "FORMAT_GRAPHQL", sc.Format())
+		assert.ElementsMatch(t, []string{"Booking", "BookingFilter", "Query", "SearchResult", "Status"}, file.Types)
+		assert.ElementsMatch(t, []string{"Booking.id", "Booking.status", "Booking.total", "BookingFilter.status", "Query.booking", "Query.bookings"}, file.Fields)
+		assert.Equal(t, []byte(bookingSDL), file.Data)
+	})
+	t.Run("should have same id when only comments, formatting and order of types change", func(t *testing.T) {
+		sc := parse(t, bookingSDL)
+		formatted := parse(t, "# bookings\ntype Query { booking(id: ID!): Booking, bookings(filter: BookingFilter): [Booking!]! }\n"+
+			"union SearchResult = Booking\ninput BookingFilter { status: Status }\n"+
+			"type Booking @key(fields: \"id\") { id: ID! status: Status total(currency: String = \"USD\"): Float }\nenum Status { OPEN CLOSED }")
+		formattest.AssertID(t, sc, formatted, parse(t, bookingSDL+"scalar Date\n"))
+	})
+	t.Run("should allow subgraph extending types owned by other subgraphs", func(t *testing.T) {
+		sc := parse(t, "extend schema @link(url: \"https://specs.apollo.dev/federation/v2.3\", import: [\"@key\", \"@external\"])\n"+
+			"extend type User @key(fields: \"id\") { id: ID! @external bookings: [Booking] }\ntype Booking { id: ID! }")
+		assert.ElementsMatch(t, []string{"Booking", "User"}, sc.GetCanonicalValue().Types)
+	})
+	for _, test := range []struct {
+		name string
+		sdl  string
+		err  string
+	}{
+		{"should return error for invalid SDL", "type A { id: }", ""},
+		{"should return error for unknown type", "type A { m: Missing }", `Undefined type Missing.`},
+		{"should return error for unknown directive", "type A @missing { id: ID }", `Undefined directive missing.`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := graphql.ParseSchema([]byte(test.sdl))
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), test.err)
+			}
+		})
+	}
+}
+
+func TestPrintSchema(t *testing.T) {
+	source, language, err := graphql.Format{}.PrintSchema([]byte("type Query { booking(id: ID!): Booking }\n\"booking\"\ntype Booking { id: ID! }"), "")
+	assert.NoError(t, err)
+	assert.Equal(t, "GraphQL", language)
+	assert.Equal(t, "\"\"\"\nbooking\n\"\"\"\ntype Booking {\n\tid: ID!\n}\ntype Query {\n\tbooking(id: ID!): Booking\n}\n", source)
+}
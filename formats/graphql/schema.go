@@ -0,0 +1,70 @@
+package graphql
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const graphqlFormat = "FORMAT_GRAPHQL"
+
+type Schema struct {
+	data   []byte
+	schema *ast.Schema
+}
+
+func (s *Schema) Format() string {
+	return graphqlFormat
+}
+
+// GetCanonicalValue returns schema file identified by formatted SDL, so changes to comments, formatting and order of types are not new versions
+func (s *Schema) GetCanonicalValue() *schema.SchemaFile {
+	id := uuid.NewSHA1(uuid.NameSpaceOID, []byte(printSDL(s.schema, false)))
+	return &schema.SchemaFile{
+		ID:     id.String(),
+		Types:  getAllTypes(s.schema),
+		Data:   s.data,
+		Fields: getAllFields(s.schema),
+	}
+}
+
+func (s *Schema) verify(against schema.ParsedSchema) (*Schema, error) {
+	prev, ok := against.(*Schema)
+	if !ok || against.Format() != graphqlFormat {
+		return nil, errors.New("different schema formats")
+	}
+	return prev, nil
+}
+
+// IsBackwardCompatible checks whether clients written against given schema keep working with this schema
+// Allowed changes: type addition, field addition, optional argument addition, output field made non null, argument made optional
+// Disallowed changes: type deletion, field deletion, field type change, output field made nullable, argument made required, required argument addition
+func (s *Schema) IsBackwardCompatible(against schema.ParsedSchema) error {
+	prev, err := s.verify(against)
+	if err != nil {
+		return err
+	}
+	return compareSchemas(s, prev, backwardCompatibility)
+}
+
+// IsForwardCompatible checks whether clients written against this schema keep working with given schema
+// Allowed changes: type and field addition or deletion, output field made nullable, argument made required
+// Disallowed changes: field type change, output field made non null, argument made optional
+func (s *Schema) IsForwardCompatible(against schema.ParsedSchema) error {
+	prev, err := s.verify(against)
+	if err != nil {
+		return err
+	}
+	return compareSchemas(s, prev, forwardCompatibility)
+}
+
+// IsFullCompatible checks for both backward and forward compatibility
+func (s *Schema) IsFullCompatible(against schema.ParsedSchema) error {
+	prev, err := s.verify(against)
+	if err != nil {
+		return err
+	}
+	return compareSchemas(s, prev, fullCompatibility)
+}
@@ -0,0 +1,131 @@
+package graphql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// userTypes returns type definitions declared in schema sorted by name, built in scalars, introspection and federation types are skipped
+func userTypes(sc *ast.Schema) []*ast.Definition {
+	var defs []*ast.Definition
+	for _, def := range sc.Types {
+		if !def.BuiltIn {
+			defs = append(defs, def)
+		}
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].Name < defs[j].Name
+	})
+	return defs
+}
+
+func getType(sc *ast.Schema, name string) *ast.Definition {
+	def := sc.Types[name]
+	if def == nil || def.BuiltIn {
+		return nil
+	}
+	return def
+}
+
+// userFields returns fields of type, skipping introspection fields added to query type
+func userFields(def *ast.Definition) ast.FieldList {
+	var fields ast.FieldList
+	for _, f := range def.Fields {
+		if !strings.HasPrefix(f.Name, "__") {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+func hasFields(def *ast.Definition) bool {
+	return def.Kind == ast.Object || def.Kind == ast.Interface || def.Kind == ast.InputObject
+}
+
+// getAllTypes returns names of objects, interfaces, unions, enums, input objects and custom scalars
+func getAllTypes(sc *ast.Schema) []string {
+	var types []string
+	for _, def := range userTypes(sc) {
+		types = append(types, def.Name)
+	}
+	return types
+}
+
+// getAllFields returns fields of objects, interfaces and input objects as type.field
+func getAllFields(sc *ast.Schema) []string {
+	var fields []string
+	for _, def := range userTypes(sc) {
+		if !hasFields(def) {
+			continue
+		}
+		for _, f := range userFields(def) {
+			fields = append(fields, fmt.Sprintf("%s.%s", def.Name, f.Name))
+		}
+	}
+	return fields
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// isRequired checks whether input value has to be provided by clients
+func isRequired(t *ast.Type, defaultValue *ast.Value) bool {
+	return t.NonNull && defaultValue == nil
+}
+
+// sameShape checks types have same named type and list nesting, ignoring nullability
+func sameShape(a, b *ast.Type) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.NamedType == b.NamedType && sameShape(a.Elem, b.Elem)
+}
+
+// isStricter checks every level of strict type is non null wherever loose type is, both types must have same shape
+func isStricter(strict, loose *ast.Type) bool {
+	if strict == nil {
+		return true
+	}
+	if loose.NonNull && !strict.NonNull {
+		return false
+	}
+	return isStricter(strict.Elem, loose.Elem)
+}
+
+func deprecation(directives ast.DirectiveList) string {
+	d := directives.ForName("deprecated")
+	if d == nil {
+		return ""
+	}
+	if reason := d.Arguments.ForName("reason"); reason != nil {
+		return reason.Value.Raw
+	}
+	return "No longer supported"
+}
+
+func valueString(val interface{}) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case *ast.Value:
+		if v == nil {
+			return ""
+		}
+		return v.String()
+	case *ast.Type:
+		if v == nil {
+			return ""
+		}
+		return v.String()
+	}
+	return fmt.Sprint(val)
+}
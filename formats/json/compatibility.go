@@ -32,8 +32,44 @@ const (
 	oneOfElementDeleted
 	allOfModified
 	additionalPropertiesNotTrue
+	typePromotion
+	enumElementAddition
+	defaultChanged
 )
 
+var diffKindNames = map[diffKind]string{
+	schemaDeleted:               "schemaDeleted",
+	incompatibleTypes:           "incompatibleTypes",
+	requiredFieldChanged:        "requiredFieldChanged",
+	propertyAddition:            "propertyAddition",
+	itemSchemaModification:      "itemSchemaModification",
+	itemSchemaAddition:          "itemSchemaAddition",
+	itemsSchemaDeletion:         "itemsSchemaDeletion",
+	subSchemaTypeModification:   "subSchemaTypeModification",
+	enumCreation:                "enumCreation",
+	enumDeletion:                "enumDeletion",
+	enumElementDeletion:         "enumElementDeletion",
+	refChanged:                  "refChanged",
+	anyOfModified:               "anyOfModified",
+	anyOfAdded:                  "anyOfAdded",
+	anyOfDeleted:                "anyOfDeleted",
+	anyOfElementAdded:           "anyOfElementAdded",
+	anyOfElementDeleted:         "anyOfElementDeleted",
+	oneOfAdded:                  "oneOfAdded",
+	oneOfDeleted:                "oneOfDeleted",
+	oneOfElementAdded:           "oneOfElementAdded",
+	oneOfElementDeleted:         "oneOfElementDeleted",
+	allOfModified:               "allOfModified",
+	additionalPropertiesNotTrue: "additionalPropertiesNotTrue",
+	typePromotion:               "typePromotion",
+	enumElementAddition:         "enumElementAddition",
+	defaultChanged:              "defaultChanged",
+}
+
+func (d diffKind) String() string {
+	return diffKindNames[d]
+}
+
 var backwardCompatibility = []diffKind{
 	schemaDeleted,
 	requiredFieldChanged,
@@ -93,15 +129,23 @@ func compareSchemas(prevSchemaMap, currentSchemaMap map[string]*jsonschema.Schem
 			schemaCheck(currSchema, diffs)
 		}
 	}
-	if diffs.isEmpty() {
+	if diffs.IsEmpty() {
 		return nil
 	}
 	return diffs
 }
 
+// CheckBackwardCompatibility runs standard checks on every subschema of current schema against prev schema.
+// Formats embedding json schemas use it for each embedded schema, both schemas should be compiled from
+// resources with same url so that locations of subschemas match.
+func CheckBackwardCompatibility(prev, current *jsonschema.Schema) error {
+	return compareSchemas(exploreSchema(prev), exploreSchema(current), backwardCompatibility,
+		[]SchemaCompareCheck{CheckPropertyDeleted, TypeCheckExecutor(StandardTypeChecks)}, []SchemaCheck{CheckAdditionalProperties})
+}
+
 func CheckPropertyDeleted(prevSchema, currSchema *jsonschema.Schema, diffs *compatibilityErr) {
 	if prevSchema != nil && currSchema == nil {
-		diffs.add(schemaDeleted, prevSchema.Location, `property is removed`)
+		diffs.add(schemaDeleted, prevSchema.Location, nil, nil, `property is removed`)
 	}
 }
 
@@ -113,7 +157,7 @@ func CheckAdditionalProperties(schema *jsonschema.Schema, diffs *compatibilityEr
 	if schema.AdditionalProperties != nil {
 		property, ok := schema.AdditionalProperties.(bool)
 		if !ok || !property {
-			diffs.add(additionalPropertiesNotTrue, schema.Location, "additionalProperties need to be not defined or true for evaluation as an open content model")
+			diffs.add(additionalPropertiesNotTrue, schema.Location, nil, nil, "additionalProperties need to be not defined or true for evaluation as an open content model")
 		}
 	}
 }
@@ -127,7 +171,7 @@ func TypeCheckExecutor(spec TypeCheckSpec) SchemaCompareCheck {
 		currTypes := currSchema.Types
 		err := elementsMatch(prevTypes, currTypes) // special case of integer being allowed to changed to number is not respected due to additional code complexity
 		if err != nil {
-			diffs.add(subSchemaTypeModification, currSchema.Location, "%s", err.Error())
+			diffs.add(subSchemaTypeModification, currSchema.Location, prevTypes, currTypes, "%s", err.Error())
 			return
 		}
 		if len(currTypes) == 0 {
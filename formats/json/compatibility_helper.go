@@ -10,27 +10,27 @@ func checkEnum(prevSchema, currSchema *jsonschema.Schema, diffs *compatibilityEr
 	prevEnum := prevSchema.Enum
 	currEnum := currSchema.Enum
 	if prevEnum == nil && currEnum != nil {
-		diffs.add(enumCreation, currSchema.Location, "enum values added to existing non enum values")
+		diffs.add(enumCreation, currSchema.Location, nil, currEnum, "enum values added to existing non enum values")
 	}
 	if prevEnum != nil && currEnum == nil {
-		diffs.add(enumDeletion, currSchema.Location, "enum was deleted")
+		diffs.add(enumDeletion, currSchema.Location, prevEnum, nil, "enum was deleted")
 	}
 	if prevEnum != nil && currEnum != nil {
 		if !isSubset(currEnum, prevEnum) {
-			diffs.add(enumElementDeletion, currSchema.Location, "enum property was deleted")
+			diffs.add(enumElementDeletion, currSchema.Location, prevEnum, currEnum, "enum property was deleted")
 		}
 	}
 }
 
 func checkRef(prevSchema, currSchema *jsonschema.Schema, diffs *compatibilityErr) {
 	if prevSchema.Ref != nil && currSchema.Ref != nil && prevSchema.Ref.Location != currSchema.Ref.Location { // check if prev and curr schema location are equivalent
-		diffs.add(refChanged, currSchema.Location, "ref for schema has been changed")
+		diffs.add(refChanged, currSchema.Location, prevSchema.Ref.Location, currSchema.Ref.Location, "ref for schema has been changed")
 	}
 	if prevSchema.Ref != nil && currSchema.Ref == nil {
-		diffs.add(refChanged, currSchema.Location, "ref for schema has been removed")
+		diffs.add(refChanged, currSchema.Location, prevSchema.Ref.Location, nil, "ref for schema has been removed")
 	}
 	if prevSchema.Ref == nil && currSchema.Ref != nil {
-		diffs.add(refChanged, currSchema.Location, "ref for schema has been added")
+		diffs.add(refChanged, currSchema.Location, nil, currSchema.Ref.Location, "ref for schema has been added")
 	}
 }
 
@@ -39,19 +39,19 @@ func checkAnyOf(prevSchema, currSchema *jsonschema.Schema, diffs *compatibilityE
 	currAnyOf := currSchema.AnyOf
 	if prevAnyOf != nil && currAnyOf != nil {
 		if len(prevAnyOf) < len(currAnyOf) {
-			diffs.add(anyOfElementAdded, currSchema.Location, "anyOf condition cannot have added elements")
+			diffs.add(anyOfElementAdded, currSchema.Location, nil, nil, "anyOf condition cannot have added elements")
 			return
 		}
 		if len(prevAnyOf) > len(currAnyOf) {
-			diffs.add(anyOfElementDeleted, currSchema.Location, "anyOf condition cannot have deleted elements")
+			diffs.add(anyOfElementDeleted, currSchema.Location, nil, nil, "anyOf condition cannot have deleted elements")
 			return
 		}
 	}
 	if prevAnyOf == nil && currAnyOf != nil {
-		diffs.add(anyOfAdded, currSchema.Location, "anyOf condition cannot created during modification of schema")
+		diffs.add(anyOfAdded, currSchema.Location, nil, nil, "anyOf condition cannot created during modification of schema")
 	}
 	if prevAnyOf != nil && currAnyOf == nil {
-		diffs.add(anyOfDeleted, currSchema.Location, "anyOf condition cannot be removed during modification of schema")
+		diffs.add(anyOfDeleted, currSchema.Location, nil, nil, "anyOf condition cannot be removed during modification of schema")
 	}
 }
 
@@ -60,18 +60,18 @@ func checkOneOf(prevSchema, currSchema *jsonschema.Schema, diffs *compatibilityE
 	currOneOf := currSchema.OneOf
 	if prevOneOf != nil && currOneOf != nil {
 		if len(prevOneOf) < len(currOneOf) {
-			diffs.add(oneOfElementAdded, currSchema.Location, "oneOf condition cannot have added elements")
+			diffs.add(oneOfElementAdded, currSchema.Location, nil, nil, "oneOf condition cannot have added elements")
 			return
 		}
 		if len(prevOneOf) > len(currOneOf) {
-			diffs.add(oneOfElementDeleted, currSchema.Location, "oneOf condition cannot have elements removed")
+			diffs.add(oneOfElementDeleted, currSchema.Location, nil, nil, "oneOf condition cannot have elements removed")
 		}
 	}
 	if prevOneOf == nil && currOneOf != nil {
-		diffs.add(oneOfAdded, currSchema.Location, "oneOf condition cannot created during modification of schema")
+		diffs.add(oneOfAdded, currSchema.Location, nil, nil, "oneOf condition cannot created during modification of schema")
 	}
 	if prevOneOf != nil && currOneOf == nil {
-		diffs.add(oneOfDeleted, currSchema.Location, "oneOf condition cannot be removed during modification of schema")
+		diffs.add(oneOfDeleted, currSchema.Location, nil, nil, "oneOf condition cannot be removed during modification of schema")
 	}
 }
 
@@ -80,15 +80,15 @@ func checkAllOf(prevSchema, currSchema *jsonschema.Schema, diffs *compatibilityE
 	currAllOf := currSchema.AllOf
 	if prevAllOf != nil && currAllOf != nil {
 		if len(prevAllOf) != len(currAllOf) {
-			diffs.add(allOfModified, currSchema.Location, "allOf condition cannot be modified")
+			diffs.add(allOfModified, currSchema.Location, nil, nil, "allOf condition cannot be modified")
 			return
 		}
 	}
 	if prevAllOf == nil && currAllOf != nil {
-		diffs.add(allOfModified, currSchema.Location, "allOf condition cannot created during modification of schema")
+		diffs.add(allOfModified, currSchema.Location, nil, nil, "allOf condition cannot created during modification of schema")
 	}
 	if prevAllOf != nil && currAllOf == nil {
-		diffs.add(allOfModified, currSchema.Location, "allOf condition cannot be removed during modification of schema")
+		diffs.add(allOfModified, currSchema.Location, nil, nil, "allOf condition cannot be removed during modification of schema")
 	}
 }
 
@@ -99,20 +99,20 @@ func checkItemSchema(prevSchema, currSchema *jsonschema.Schema, diffs *compatibi
 		currItems := currSchema.PrefixItems
 		if prevItems != nil && currItems != nil {
 			if len(prevItems) != len(currItems) {
-				diffs.add(itemSchemaModification, currSchema.Location, "prev prefix items contains %d elements, current contains %d", len(prevItems), len(currItems))
+				diffs.add(itemSchemaModification, currSchema.Location, len(prevItems), len(currItems), "prev prefix items contains %d elements, current contains %d", len(prevItems), len(currItems))
 			}
 		}
 		if prevItems == nil && currItems != nil {
-			diffs.add(itemSchemaModification, currSchema.Location, "prev prefix items is absent, current contains %d", len(currItems))
+			diffs.add(itemSchemaModification, currSchema.Location, nil, len(currItems), "prev prefix items is absent, current contains %d", len(currItems))
 		}
 		if prevItems != nil && currItems == nil {
-			diffs.add(itemSchemaModification, currSchema.Location, "prev prefix items contains %d elements, current contains absent", len(prevItems))
+			diffs.add(itemSchemaModification, currSchema.Location, len(prevItems), nil, "prev prefix items contains %d elements, current contains absent", len(prevItems))
 		}
 	} else {
 		prevItems := getItems(prevSchema)
 		currItems := getItems(currSchema)
 		if len(prevItems) != len(currItems) {
-			diffs.add(itemSchemaModification, currSchema.Location, "prev items contains %d elements, current contains %d", len(prevItems), len(currItems))
+			diffs.add(itemSchemaModification, currSchema.Location, len(prevItems), len(currItems), "prev items contains %d elements, current contains %d", len(prevItems), len(currItems))
 		}
 	}
 }
@@ -130,7 +130,7 @@ func checkRestOfItemsSchema(prevSchema, currSchema *jsonschema.Schema, diffs *co
 			if !ok { // prev schema additional Items is boolean value
 				if prevSchema.AdditionalItems != currSchema.AdditionalItems {
 					// curr schema additional items is not equivalent
-					diffs.add(itemSchemaModification, prevSchema.Location, "the value of additional items has changed")
+					diffs.add(itemSchemaModification, prevSchema.Location, nil, nil, "the value of additional items has changed")
 				}
 				return // since both cases equal and non equal have been evaluated.
 			}
@@ -139,18 +139,18 @@ func checkRestOfItemsSchema(prevSchema, currSchema *jsonschema.Schema, diffs *co
 			currItem, ok = currSchema.AdditionalItems.(*jsonschema.Schema)
 			if !ok { // curr schema is boolean
 				if prevSchema.AdditionalItems == nil {
-					diffs.add(itemSchemaAddition, prevSchema.Location, "additional items has been set, changes are not allowed to additional items")
+					diffs.add(itemSchemaAddition, prevSchema.Location, nil, nil, "additional items has been set, changes are not allowed to additional items")
 				} else if prevSchema.AdditionalItems != currSchema.AdditionalItems {
-					diffs.add(itemSchemaModification, prevSchema.Location, "additional items has been modified, changes are not allowed")
+					diffs.add(itemSchemaModification, prevSchema.Location, nil, nil, "additional items has been modified, changes are not allowed")
 				}
 				return
 			}
 		}
 	}
 	if prevItem == nil && currItem != nil {
-		diffs.add(itemSchemaAddition, currItem.Location, "item schema cannot be added in schema changes")
+		diffs.add(itemSchemaAddition, currItem.Location, nil, nil, "item schema cannot be added in schema changes")
 	} else if prevItem != nil && currItem == nil {
-		diffs.add(itemsSchemaDeletion, prevItem.Location, "items schema cannot be deleted in modification changes")
+		diffs.add(itemsSchemaDeletion, prevItem.Location, nil, nil, "items schema cannot be deleted in modification changes")
 	}
 }
 
@@ -159,7 +159,7 @@ func checkPropertyAddition(prevSchema, currSchema *jsonschema.Schema, diffs *com
 	currProperties := getKeys(currSchema.Properties)
 	addedKeys := getDiffernce(currProperties, prevProperties)
 	if len(addedKeys) > 0 {
-		diffs.add(propertyAddition, currSchema.Location, "added keys: %s", strings.Join(addedKeys, ","))
+		diffs.add(propertyAddition, currSchema.Location, nil, nil, "added keys: %s", strings.Join(addedKeys, ","))
 	}
 }
 
@@ -168,7 +168,7 @@ func checkRequiredProperties(prevSchema, currSchema *jsonschema.Schema, diffs *c
 	currReqiredProperties := currSchema.Required
 	err := elementsMatch(prevRequiredProperties, currReqiredProperties)
 	if err != nil {
-		diffs.add(requiredFieldChanged, currSchema.Location, "%s", err.Error())
+		diffs.add(requiredFieldChanged, currSchema.Location, nil, nil, "%s", err.Error())
 	}
 }
 
@@ -37,7 +37,7 @@ func Test_CheckEnum_ForSuccess_WhenAddition_Of_Fields(t *testing.T) {
 	curr := initialiseSchema(t, "./testdata/enum/curr_addition.json").Properties["roles"]
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkEnum(prev, curr, diffs)
-	assert.Empty(t, diffs.diffs)
+	assert.Empty(t, diffs.Kinds())
 }
 
 func Test_CheckEnum_ForFailure_WhenRemoval_Of_Fields(t *testing.T) {
@@ -45,8 +45,21 @@ func Test_CheckEnum_ForFailure_WhenRemoval_Of_Fields(t *testing.T) {
 	curr := initialiseSchema(t, "./testdata/enum/curr_removal.json").Properties["roles"]
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkEnum(prev, curr, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, enumElementDeletion, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, enumElementDeletion, diffs.Kinds()[0])
+}
+
+func Test_CheckEnum_Reports_Violation_With_Old_And_New_Values(t *testing.T) {
+	prev := initialiseSchema(t, "./testdata/enum/prev.json").Properties["roles"]
+	curr := initialiseSchema(t, "./testdata/enum/curr_removal.json").Properties["roles"]
+	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
+	checkEnum(prev, curr, diffs)
+	violations := diffs.Violations()
+	assert.Equal(t, 1, len(violations))
+	assert.Equal(t, "enumElementDeletion", violations[0].Kind)
+	assert.Equal(t, curr.Location, violations[0].Path)
+	assert.Equal(t, `["producer","licensor","processor","host"]`, violations[0].OldValue)
+	assert.Equal(t, `["producer","licensor"]`, violations[0].NewValue)
 }
 
 func Test_CheckEnum_ForFailure_WhenEnum_Is_Removed(t *testing.T) {
@@ -54,8 +67,8 @@ func Test_CheckEnum_ForFailure_WhenEnum_Is_Removed(t *testing.T) {
 	curr := initialiseSchema(t, "./testdata/enum/non_enum.json").Properties["roles"]
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkEnum(prev, curr, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, enumDeletion, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, enumDeletion, diffs.Kinds()[0])
 }
 
 func Test_CheckEnum_NoPanic_WhenBothSchemaAreNonEnum(t *testing.T) {
@@ -63,7 +76,7 @@ func Test_CheckEnum_NoPanic_WhenBothSchemaAreNonEnum(t *testing.T) {
 	curr := initialiseSchema(t, "./testdata/enum/non_enum.json").Properties["roles"]
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkEnum(prev, curr, diffs)
-	assert.Empty(t, diffs.diffs)
+	assert.Empty(t, diffs.Kinds())
 }
 
 func Test_CheckRef_ForSuccess_WhenRefIsSame(t *testing.T) {
@@ -71,7 +84,7 @@ func Test_CheckRef_ForSuccess_WhenRefIsSame(t *testing.T) {
 	curr := initialiseSchema(t, "./testdata/refChange/prev.json").Properties["roleRef"]
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkRef(prev, curr, diffs)
-	assert.Empty(t, diffs.diffs)
+	assert.Empty(t, diffs.Kinds())
 }
 
 func Test_CheckRef_ForSuccess_WhenRefIsAbsentInSchemas(t *testing.T) {
@@ -79,7 +92,7 @@ func Test_CheckRef_ForSuccess_WhenRefIsAbsentInSchemas(t *testing.T) {
 	curr := initialiseSchema(t, "./testdata/refChange/prev.json").Properties["roles"]
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkRef(prev, curr, diffs)
-	assert.Empty(t, diffs.diffs)
+	assert.Empty(t, diffs.Kinds())
 }
 
 func Test_CheckRef_ForFailure_WhenRefIsRemoved(t *testing.T) {
@@ -87,8 +100,8 @@ func Test_CheckRef_ForFailure_WhenRefIsRemoved(t *testing.T) {
 	curr := initialiseSchema(t, "./testdata/refChange/removed.json").Properties["roleRef"]
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkRef(prev, curr, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, refChanged, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, refChanged, diffs.Kinds()[0])
 }
 
 func Test_CheckRef_ForFailure_WhenRefIsModified(t *testing.T) {
@@ -96,8 +109,8 @@ func Test_CheckRef_ForFailure_WhenRefIsModified(t *testing.T) {
 	curr := initialiseSchema(t, "./testdata/refChange/modified.json").Properties["roleRef"]
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkRef(prev, curr, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, refChanged, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, refChanged, diffs.Kinds()[0])
 }
 
 func Test_Check_AllOf_Conditions(t *testing.T) {
@@ -106,24 +119,24 @@ func Test_Check_AllOf_Conditions(t *testing.T) {
 	diffs0 := &compatibilityErr{notAllowed: backwardCompatibility}
 	// check modified
 	checkAllOf(prev, new, diffs0)
-	assert.Equal(t, 1, len(diffs0.diffs))
-	assert.Equal(t, allOfModified, diffs0.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs0.Kinds()))
+	assert.Equal(t, allOfModified, diffs0.Kinds()[0])
 	// check deleted
 	deleted := initialiseSchema(t, "./testdata/allOf/deleted.json").Properties["roles"]
 	diffs1 := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkAllOf(prev, deleted, diffs1)
-	assert.Equal(t, 1, len(diffs1.diffs))
-	assert.Equal(t, allOfModified, diffs1.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs1.Kinds()))
+	assert.Equal(t, allOfModified, diffs1.Kinds()[0])
 	// check noChange
 	noChange := initialiseSchema(t, "./testdata/allOf/noChange.json").Properties["roles"]
 	diffs2 := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkAllOf(prev, noChange, diffs2)
-	assert.Empty(t, len(diffs2.diffs))
+	assert.Empty(t, len(diffs2.Kinds()))
 	// check addition of all of condition
 	diffs3 := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkAllOf(deleted, prev, diffs3)
-	assert.Equal(t, 1, len(diffs3.diffs))
-	assert.Equal(t, allOfModified, diffs3.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs3.Kinds()))
+	assert.Equal(t, allOfModified, diffs3.Kinds()[0])
 }
 
 func Test_Check_AnyOf_Conditions(t *testing.T) {
@@ -132,38 +145,38 @@ func Test_Check_AnyOf_Conditions(t *testing.T) {
 	diffs0backward := &compatibilityErr{notAllowed: backwardCompatibility}
 	// check element added
 	checkAnyOf(prev, new, diffs0backward)
-	assert.Equal(t, 0, len(diffs0backward.diffs))
+	assert.Equal(t, 0, len(diffs0backward.Kinds()))
 	diffs0all := &compatibilityErr{notAllowed: allFilter}
 	checkAnyOf(prev, new, diffs0all)
-	assert.Equal(t, 1, len(diffs0all.diffs))
-	assert.Equal(t, anyOfElementAdded, diffs0all.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs0all.Kinds()))
+	assert.Equal(t, anyOfElementAdded, diffs0all.Kinds()[0])
 
 	// check deleted
 	deleted := initialiseSchema(t, "./testdata/anyOf/deleted.json").Properties["roles"]
 	diffs1 := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkAnyOf(prev, deleted, diffs1)
-	assert.Equal(t, 1, len(diffs1.diffs))
-	assert.Equal(t, anyOfDeleted, diffs1.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs1.Kinds()))
+	assert.Equal(t, anyOfDeleted, diffs1.Kinds()[0])
 	// check noChange
 	noChange := initialiseSchema(t, "./testdata/anyOf/noChange.json").Properties["roles"]
 	diffs2 := &compatibilityErr{notAllowed: allFilter}
 	checkAnyOf(prev, noChange, diffs2)
-	assert.Empty(t, len(diffs2.diffs))
+	assert.Empty(t, len(diffs2.Kinds()))
 
 	// check addition of any of condition
 	diffs3backward := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkAnyOf(deleted, prev, diffs3backward)
-	assert.Equal(t, 0, len(diffs3backward.diffs))
+	assert.Equal(t, 0, len(diffs3backward.Kinds()))
 	diffs3all := &compatibilityErr{notAllowed: allFilter}
 	checkAnyOf(deleted, prev, diffs3all)
-	assert.Equal(t, 1, len(diffs3all.diffs))
-	assert.Equal(t, anyOfAdded, diffs3all.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs3all.Kinds()))
+	assert.Equal(t, anyOfAdded, diffs3all.Kinds()[0])
 
 	// check element deletion
 	diffs4 := &compatibilityErr{notAllowed: allFilter}
 	checkAnyOf(new, prev, diffs4)
-	assert.Equal(t, 1, len(diffs4.diffs))
-	assert.Equal(t, anyOfElementDeleted, diffs4.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs4.Kinds()))
+	assert.Equal(t, anyOfElementDeleted, diffs4.Kinds()[0])
 }
 
 func Test_Check_OneOf_Conditions(t *testing.T) {
@@ -173,37 +186,37 @@ func Test_Check_OneOf_Conditions(t *testing.T) {
 	// check element added
 	diffs0backward := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkOneOf(prev, new, diffs0backward)
-	assert.Equal(t, 0, len(diffs0backward.diffs))
+	assert.Equal(t, 0, len(diffs0backward.Kinds()))
 	diffs0all := &compatibilityErr{notAllowed: allFilter}
 	checkOneOf(prev, new, diffs0all)
-	assert.Equal(t, 1, len(diffs0all.diffs))
-	assert.Equal(t, oneOfElementAdded, diffs0all.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs0all.Kinds()))
+	assert.Equal(t, oneOfElementAdded, diffs0all.Kinds()[0])
 
 	// check deleted
 	deleted := initialiseSchema(t, "./testdata/oneOf/deleted.json").Properties["roles"]
 	diffs1 := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkOneOf(prev, deleted, diffs1)
-	assert.Equal(t, 1, len(diffs1.diffs))
-	assert.Equal(t, oneOfDeleted, diffs1.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs1.Kinds()))
+	assert.Equal(t, oneOfDeleted, diffs1.Kinds()[0])
 	// check noChange
 	noChange := initialiseSchema(t, "./testdata/oneOf/noChange.json").Properties["roles"]
 	diffs2 := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkOneOf(prev, noChange, diffs2)
-	assert.Empty(t, len(diffs2.diffs))
+	assert.Empty(t, len(diffs2.Kinds()))
 	// check addition of one of condition
 	diffs3backward := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkOneOf(deleted, prev, diffs3backward)
-	assert.Equal(t, 0, len(diffs3backward.diffs))
+	assert.Equal(t, 0, len(diffs3backward.Kinds()))
 	diffs3all := &compatibilityErr{notAllowed: allFilter}
 	checkOneOf(deleted, prev, diffs3all)
-	assert.Equal(t, 1, len(diffs3all.diffs))
-	assert.Equal(t, oneOfAdded, diffs3all.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs3all.Kinds()))
+	assert.Equal(t, oneOfAdded, diffs3all.Kinds()[0])
 
 	// check element deleted
 	diffs4 := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkOneOf(new, prev, diffs4)
-	assert.Equal(t, 1, len(diffs4.diffs))
-	assert.Equal(t, oneOfElementDeleted, diffs4.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs4.Kinds()))
+	assert.Equal(t, oneOfElementDeleted, diffs4.Kinds()[0])
 }
 
 func Test_CheckPropertyAddition_ReturnsSuccess_WhenPropertyAdded(t *testing.T) {
@@ -212,12 +225,12 @@ func Test_CheckPropertyAddition_ReturnsSuccess_WhenPropertyAdded(t *testing.T) {
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkPropertyAddition(prev, new, diffs)
 	// no error diffs when backward compatibility is not allowed
-	assert.Empty(t, len(diffs.diffs))
+	assert.Empty(t, len(diffs.Kinds()))
 	newDiff := &compatibilityErr{notAllowed: []diffKind{propertyAddition}}
 	checkPropertyAddition(prev, new, newDiff)
 	// diff contains element when told to record property addition
-	assert.Equal(t, 1, len(newDiff.diffs))
-	assert.Equal(t, propertyAddition, newDiff.diffs[0].kind)
+	assert.Equal(t, 1, len(newDiff.Kinds()))
+	assert.Equal(t, propertyAddition, newDiff.Kinds()[0])
 }
 
 func Test_CheckRequiredProperties_ReturnFailure_WhenRequiredPropertiesAdded(t *testing.T) {
@@ -225,8 +238,8 @@ func Test_CheckRequiredProperties_ReturnFailure_WhenRequiredPropertiesAdded(t *t
 	new := initialiseSchema(t, "./testdata/requiredProperties/added.json")
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkRequiredProperties(prev, new, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, requiredFieldChanged, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, requiredFieldChanged, diffs.Kinds()[0])
 }
 
 func Test_CheckRequiredProperties_ReturnSuccess_WhenRequiredPropertiesUnchangedAndNewPropertyAdded(t *testing.T) {
@@ -234,7 +247,7 @@ func Test_CheckRequiredProperties_ReturnSuccess_WhenRequiredPropertiesUnchangedA
 	new := initialiseSchema(t, "./testdata/requiredProperties/modified.json")
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkRequiredProperties(prev, new, diffs)
-	assert.Empty(t, diffs.diffs)
+	assert.Empty(t, diffs.Kinds())
 }
 
 func Test_CheckRequiredProperties_ReturnFailure_WhenRequiredPropertiesAreRemoved(t *testing.T) {
@@ -242,8 +255,8 @@ func Test_CheckRequiredProperties_ReturnFailure_WhenRequiredPropertiesAreRemoved
 	new := initialiseSchema(t, "./testdata/requiredProperties/removed.json")
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkRequiredProperties(prev, new, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, requiredFieldChanged, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, requiredFieldChanged, diffs.Kinds()[0])
 }
 
 func Test_CheckItems_ReturnsFailure_WhenNon2020DraftAdditionalItemsIsChanged(t *testing.T) {
@@ -251,12 +264,12 @@ func Test_CheckItems_ReturnsFailure_WhenNon2020DraftAdditionalItemsIsChanged(t *
 	new := initialiseSchema(t, "./testdata/array/draft7additionalItems.json").Properties["example"]
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkRestOfItemsSchema(prev, new, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, itemsSchemaDeletion, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, itemsSchemaDeletion, diffs.Kinds()[0])
 	diffs = &compatibilityErr{notAllowed: backwardCompatibility}
 	checkRestOfItemsSchema(new, prev, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, itemSchemaAddition, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, itemSchemaAddition, diffs.Kinds()[0])
 }
 
 func Test_CheckItems_ReturnsFailure_WhenNon2020DraftItemsIsChanged(t *testing.T) {
@@ -264,8 +277,8 @@ func Test_CheckItems_ReturnsFailure_WhenNon2020DraftItemsIsChanged(t *testing.T)
 	new := initialiseSchema(t, "./testdata/array/draft7items.json").Properties["example"]
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkItemSchema(prev, new, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, itemSchemaModification, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, itemSchemaModification, diffs.Kinds()[0])
 }
 
 func Test_CheckItems_ReturnsFailure_When2020DraftItemsIsChanged(t *testing.T) {
@@ -273,12 +286,12 @@ func Test_CheckItems_ReturnsFailure_When2020DraftItemsIsChanged(t *testing.T) {
 	new := initialiseSchema(t, "./testdata/array/2020items.json").Properties["example"]
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkRestOfItemsSchema(prev, new, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, itemsSchemaDeletion, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, itemsSchemaDeletion, diffs.Kinds()[0])
 	diffs = &compatibilityErr{notAllowed: backwardCompatibility}
 	checkRestOfItemsSchema(new, prev, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, itemSchemaAddition, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, itemSchemaAddition, diffs.Kinds()[0])
 }
 
 func Test_CheckItems_ReturnsFailure_When2020DraftAPrefixItemsIsChanged(t *testing.T) {
@@ -286,8 +299,8 @@ func Test_CheckItems_ReturnsFailure_When2020DraftAPrefixItemsIsChanged(t *testin
 	new := initialiseSchema(t, "./testdata/array/2020prefixItems.json").Properties["example"]
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkItemSchema(prev, new, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, itemSchemaModification, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, itemSchemaModification, diffs.Kinds()[0])
 }
 
 func Test_CheckItems_ReturnsSuccess_WhenNon2020DraftItemsAreUpdated(t *testing.T) {
@@ -295,7 +308,7 @@ func Test_CheckItems_ReturnsSuccess_WhenNon2020DraftItemsAreUpdated(t *testing.T
 	new := initialiseSchema(t, "./testdata/array/draft7updated.json")
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkItemSchema(prev, new, diffs)
-	assert.Equal(t, 0, len(diffs.diffs))
+	assert.Equal(t, 0, len(diffs.Kinds()))
 }
 
 func Test_CheckItems_ReturnsSuccess_When2020DraftPrefixItemsAreUpdated(t *testing.T) {
@@ -303,5 +316,5 @@ func Test_CheckItems_ReturnsSuccess_When2020DraftPrefixItemsAreUpdated(t *testin
 	new := initialiseSchema(t, "./testdata/array/2020updated.json")
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	checkItemSchema(prev, new, diffs)
-	assert.Equal(t, 0, len(diffs.diffs))
+	assert.Equal(t, 0, len(diffs.Kinds()))
 }
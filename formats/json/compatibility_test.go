@@ -58,8 +58,8 @@ func Test_CheckAdditionalProperties_Fails_When_Its_Partial_OpenContentModel(t *t
 	for _, schema := range schemaMap {
 		CheckAdditionalProperties(schema, diffs)
 	}
-	assert.Equal(t, 1, len(diffs.diffs))
-	assert.Equal(t, additionalPropertiesNotTrue, diffs.diffs[0].kind)
+	assert.Equal(t, 1, len(diffs.Kinds()))
+	assert.Equal(t, additionalPropertiesNotTrue, diffs.Kinds()[0])
 }
 
 func Test_CheckAdditionalProperties_Fails_When_Its_ClosedContentModel(t *testing.T) {
@@ -69,7 +69,7 @@ func Test_CheckAdditionalProperties_Fails_When_Its_ClosedContentModel(t *testing
 	for _, schema := range schemaMap {
 		CheckAdditionalProperties(schema, diffs)
 	}
-	assert.Equal(t, 2, len(diffs.diffs))
+	assert.Equal(t, 2, len(diffs.Kinds()))
 }
 
 func Test_CheckAdditionalProperties_Succeeds_When_Its_OpenContentModel(t *testing.T) {
@@ -79,7 +79,7 @@ func Test_CheckAdditionalProperties_Succeeds_When_Its_OpenContentModel(t *testin
 	for _, schema := range schemaMap {
 		CheckAdditionalProperties(schema, diffs)
 	}
-	assert.Empty(t, len(diffs.diffs))
+	assert.Empty(t, len(diffs.Kinds()))
 }
 
 func Test_CheckPropertyDeleted_ReturnsEmpty_When_FieldModified(t *testing.T) {
@@ -87,14 +87,14 @@ func Test_CheckPropertyDeleted_ReturnsEmpty_When_FieldModified(t *testing.T) {
 	modified := initialiseSchema(t, "./testdata/propertyDeleted/modifiedSchema.json")
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	CheckPropertyDeleted(prev, modified, diffs)
-	assert.Empty(t, diffs.diffs)
+	assert.Empty(t, diffs.Kinds())
 }
 
 func Test_CheckPropertyDeleted_ReturnsDiff_When_FieldDeleted(t *testing.T) {
 	prev := initialiseSchema(t, "./testdata/propertyDeleted/prevSchema.json")
 	diffs := &compatibilityErr{notAllowed: backwardCompatibility}
 	CheckPropertyDeleted(prev, nil, diffs)
-	assert.Equal(t, 1, len(diffs.diffs))
+	assert.Equal(t, 1, len(diffs.Kinds()))
 }
 
 func Test_TypeCheckExecutorCorrectness(t *testing.T) {
@@ -0,0 +1,150 @@
+package json
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/raystack/stencil/core/schema"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// inverseKinds maps a change to the change seen when schemas are compared in reverse order,
+// forward compatibility check compares schemas in reverse order.
+var inverseKinds = map[diffKind]diffKind{
+	schemaDeleted:       propertyAddition,
+	propertyAddition:    schemaDeleted,
+	enumCreation:        enumDeletion,
+	enumDeletion:        enumCreation,
+	enumElementDeletion: enumElementAddition,
+	enumElementAddition: enumElementDeletion,
+	itemSchemaAddition:  itemsSchemaDeletion,
+	itemsSchemaDeletion: itemSchemaAddition,
+	anyOfAdded:          anyOfDeleted,
+	anyOfDeleted:        anyOfAdded,
+	anyOfElementAdded:   anyOfElementDeleted,
+	anyOfElementDeleted: anyOfElementAdded,
+	oneOfAdded:          oneOfDeleted,
+	oneOfDeleted:        oneOfAdded,
+	oneOfElementAdded:   oneOfElementDeleted,
+	oneOfElementDeleted: oneOfElementAdded,
+	// integer promoted to number is seen as type change from number to integer in reverse order
+	typePromotion: subSchemaTypeModification,
+}
+
+func (d diffKind) inverse() diffKind {
+	if kind, ok := inverseKinds[d]; ok {
+		return kind
+	}
+	return d
+}
+
+func (d diffKind) breakingFor() []string {
+	var modes []string
+	backward, forward := d.contains(backwardCompatibility), d.inverse().contains(backwardCompatibility)
+	if backward {
+		modes = append(modes, "COMPATIBILITY_BACKWARD")
+	}
+	if forward {
+		modes = append(modes, "COMPATIBILITY_FORWARD")
+	}
+	if backward || forward {
+		modes = append(modes, "COMPATIBILITY_FULL")
+	}
+	return modes
+}
+
+// Diff returns changes made in the schema since given earlier schema, across properties, types, defaults, enums and subschemas.
+func (s *Schema) Diff(against schema.ParsedSchema) ([]schema.Change, error) {
+	prev, err := s.verify(against)
+	if err != nil {
+		return nil, err
+	}
+	currMap := exploreSchema(s.compiled)
+	prevMap := exploreSchema(prev.compiled)
+	allKinds := make([]diffKind, 0, len(diffKindNames))
+	for kind := range diffKindNames {
+		allKinds = append(allKinds, kind)
+	}
+	diffs := &compatibilityErr{notAllowed: allKinds}
+	for location, prevSchema := range prevMap {
+		currSchema := currMap[location]
+		executeSchemaCompareCheck(prevSchema, currSchema, diffs, []SchemaCompareCheck{CheckPropertyDeleted, diffSubSchema})
+	}
+	for location, currSchema := range currMap {
+		if _, ok := prevMap[location]; !ok {
+			diffs.add(propertyAddition, location, nil, currSchema.Types, "property is added")
+		}
+	}
+	kinds := diffs.Kinds()
+	changes := make([]schema.Change, 0, len(kinds))
+	for i, v := range diffs.Violations() {
+		path := fragment(v.Path)
+		changes = append(changes, schema.Change{
+			Kind:        v.Kind,
+			Path:        path,
+			Message:     strings.Replace(v.Message, v.Path, path, 1),
+			OldValue:    v.OldValue,
+			NewValue:    v.NewValue,
+			BreakingFor: kinds[i].breakingFor(),
+		})
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Path == changes[j].Path {
+			return changes[i].Kind < changes[j].Kind
+		}
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+// fragment returns location relative to schema root, like #/properties/name
+func fragment(location string) string {
+	if i := strings.Index(location, "#"); i >= 0 {
+		return location[i:]
+	}
+	return "#"
+}
+
+// diffSubSchema runs every check on subschema present in both schemas irrespective of its type
+func diffSubSchema(prevSchema, currSchema *jsonschema.Schema, diffs *compatibilityErr) {
+	if prevSchema == nil || currSchema == nil {
+		return
+	}
+	checkTypes(prevSchema, currSchema, diffs)
+	checkDefault(prevSchema, currSchema, diffs)
+	executeSchemaCompareCheck(prevSchema, currSchema, diffs, []SchemaCompareCheck{
+		checkEnum, checkEnumAddition, checkRef, checkAllOf, checkAnyOf, checkOneOf,
+	})
+	if contains(prevSchema.Types, "object") && contains(currSchema.Types, "object") {
+		checkRequiredProperties(prevSchema, currSchema, diffs)
+	}
+	if contains(prevSchema.Types, "array") && contains(currSchema.Types, "array") {
+		executeSchemaCompareCheck(prevSchema, currSchema, diffs, arrayTypeChecks)
+	}
+}
+
+func checkTypes(prevSchema, currSchema *jsonschema.Schema, diffs *compatibilityErr) {
+	prevTypes := prevSchema.Types
+	currTypes := currSchema.Types
+	if elementsMatch(prevTypes, currTypes) == nil {
+		return
+	}
+	if len(prevTypes) == 1 && len(currTypes) == 1 && prevTypes[0] == "integer" && currTypes[0] == "number" {
+		diffs.add(typePromotion, currSchema.Location, prevTypes, currTypes, "type promoted from integer to number")
+		return
+	}
+	diffs.add(subSchemaTypeModification, currSchema.Location, prevTypes, currTypes, "type changed from %s to %s", valueString(prevTypes), valueString(currTypes))
+}
+
+func checkDefault(prevSchema, currSchema *jsonschema.Schema, diffs *compatibilityErr) {
+	if !reflect.DeepEqual(prevSchema.Default, currSchema.Default) {
+		diffs.add(defaultChanged, currSchema.Location, prevSchema.Default, currSchema.Default, "default changed from %s to %s", valueString(prevSchema.Default), valueString(currSchema.Default))
+	}
+}
+
+func checkEnumAddition(prevSchema, currSchema *jsonschema.Schema, diffs *compatibilityErr) {
+	if prevSchema.Enum != nil && currSchema.Enum != nil && !isSubset(prevSchema.Enum, currSchema.Enum) {
+		diffs.add(enumElementAddition, currSchema.Location, prevSchema.Enum, currSchema.Enum, "enum property was added")
+	}
+}
@@ -0,0 +1,72 @@
+package json_test
+
+import (
+	"testing"
+
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/formats/formattest"
+	"github.com/raystack/stencil/formats/json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	prev, err := json.GetParsedSchema([]byte(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["id"],
+		"properties": {
+			"id": { "type": "string" },
+			"count": { "type": "integer", "default": 1 },
+			"price": { "type": "number" },
+			"note": { "type": "string" },
+			"status": { "type": "string", "enum": ["OPEN", "CLOSED"] }
+		}
+	}`))
+	assert.NoError(t, err)
+	current, err := json.GetParsedSchema([]byte(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["id", "source"],
+		"properties": {
+			"id": { "type": "string" },
+			"count": { "type": "number", "default": 2 },
+			"price": { "type": "string" },
+			"status": { "type": "string", "enum": ["OPEN", "CANCELLED"] },
+			"source": { "type": "string" }
+		}
+	}`))
+	assert.NoError(t, err)
+	changes, err := current.(schema.Differ).Diff(prev)
+	assert.NoError(t, err)
+
+	allModes := []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}
+	for _, test := range []struct {
+		kind        string
+		path        string
+		breakingFor []string
+	}{
+		{"propertyAddition", "#/properties/source", []string{"COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}},
+		{"schemaDeleted", "#/properties/note", []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FULL"}},
+		{"typePromotion", "#/properties/count", []string{"COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}},
+		{"subSchemaTypeModification", "#/properties/price", allModes},
+		{"defaultChanged", "#/properties/count", nil},
+		{"enumElementAddition", "#/properties/status", []string{"COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}},
+		{"enumElementDeletion", "#/properties/status", []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FULL"}},
+		{"requiredFieldChanged", "#", allModes},
+	} {
+		change := formattest.FindChange(changes, test.kind, test.path)
+		if assert.NotNil(t, change, "%s %s", test.kind, test.path) {
+			assert.Equal(t, test.breakingFor, change.BreakingFor, "%s %s", test.kind, test.path)
+		}
+	}
+	assert.Equal(t, "1", formattest.FindChange(changes, "defaultChanged", "#/properties/count").OldValue)
+	assert.Equal(t, "2", formattest.FindChange(changes, "defaultChanged", "#/properties/count").NewValue)
+	assert.Equal(t, "#/properties/count: type promoted from integer to number", formattest.FindChange(changes, "typePromotion", "#/properties/count").Message)
+	assert.Equal(t, `["integer"]`, formattest.FindChange(changes, "typePromotion", "#/properties/count").OldValue)
+
+	t.Run("should return no changes for same schema", func(t *testing.T) {
+		changes, err := current.(schema.Differ).Diff(current)
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+}
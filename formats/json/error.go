@@ -1,20 +1,17 @@
 package json
 
 import (
+	"encoding/json"
 	"fmt"
-	"strings"
+
+	"github.com/raystack/stencil/core/schema"
 )
 
 type diffKind int
 
-type diff struct {
-	kind diffKind
-	msg  string
-}
-
 type compatibilityErr struct {
+	schema.ViolationCollector[diffKind]
 	notAllowed []diffKind
-	diffs      []diff
 }
 
 func (d diffKind) contains(others []diffKind) bool {
@@ -26,21 +23,23 @@ func (d diffKind) contains(others []diffKind) bool {
 	return false
 }
 
-func (c *compatibilityErr) add(kind diffKind, location string, format string, args ...interface{}) {
+func (c *compatibilityErr) add(kind diffKind, location string, oldValue, newValue interface{}, format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
 	if kind.contains(c.notAllowed) && msg != "" {
-		c.diffs = append(c.diffs, diff{kind: kind, msg: fmt.Sprintf("%s: %s", location, msg)})
+		c.Add(kind, location, fmt.Sprintf("%s: %s", location, msg), valueString(oldValue), valueString(newValue))
 	}
 }
 
-func (c *compatibilityErr) isEmpty() bool {
-	return len(c.diffs) == 0
-}
-
-func (c *compatibilityErr) Error() string {
-	var msgs []string
-	for _, val := range c.diffs {
-		msgs = append(msgs, val.msg)
+func valueString(val interface{}) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+	data, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Sprint(val)
 	}
-	return strings.Join(msgs, ";")
+	return string(data)
 }
@@ -0,0 +1,32 @@
+package json
+
+import (
+	"bytes"
+	js "encoding/json"
+
+	"github.com/raystack/stencil/core/schema"
+)
+
+// Format is json schema format plugin
+type Format struct{}
+
+func (Format) Name() string {
+	return jsonFormat
+}
+
+func (Format) ContentType() string {
+	return "application/json"
+}
+
+func (Format) ParseSchema(data []byte) (schema.ParsedSchema, error) {
+	return GetParsedSchema(data)
+}
+
+// PrintSchema prints indented schema json
+func (Format) PrintSchema(data []byte, filter string) (string, string, error) {
+	var out bytes.Buffer
+	if err := js.Indent(&out, data, "", "  "); err != nil {
+		return string(data), "JSON", nil
+	}
+	return out.String(), "JSON", nil
+}
@@ -20,5 +20,9 @@ func GetParsedSchema(data []byte) (schema.ParsedSchema, error) {
 	if err := sc.Validate(val); err != nil {
 		return nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: err}
 	}
-	return &Schema{data: data}, nil
+	compiled, definitions, err := compile(data, val)
+	if err != nil {
+		return nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: err}
+	}
+	return &Schema{data: data, compiled: compiled, definitions: definitions}, nil
 }
@@ -45,3 +45,30 @@ func TestSchemaValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCanonicalValue(t *testing.T) {
+	sc, err := json.GetParsedSchema([]byte(`{
+		"$id": "https://example.com/booking.schema.json",
+		"type": "object",
+		"properties": {
+			"id": { "type": "string" },
+			"address": { "$ref": "#/$defs/Address" },
+			"tags": { "type": "array", "items": { "type": "object", "properties": { "name": { "type": "string" } } } },
+			"meta": { "type": "object", "properties": { "source": { "type": "string" } } }
+		},
+		"$defs": {
+			"Address": {
+				"type": "object",
+				"properties": {
+					"city": { "type": "string" },
+					"previous": { "$ref": "#/$defs/Address" }
+				}
+			},
+			"Unused": { "type": "object", "properties": { "note": { "type": "string" } } }
+		}
+	}`))
+	assert.NoError(t, err)
+	file := sc.GetCanonicalValue()
+	assert.Equal(t, []string{"Address", "Unused"}, file.Types)
+	assert.Equal(t, []string{"Address.city", "Address.previous", "Unused.note", "address", "id", "meta", "meta.source", "tags", "tags.name"}, file.Fields)
+}
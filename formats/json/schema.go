@@ -1,9 +1,14 @@
 package json
 
 import (
+	"bytes"
+	"errors"
+	"net/url"
+	"sort"
+	"strings"
+
 	"github.com/google/uuid"
 	"github.com/raystack/stencil/core/schema"
-	"github.com/raystack/stencil/pkg/logger"
 	"github.com/santhosh-tekuri/jsonschema/v5"
 	_ "github.com/santhosh-tekuri/jsonschema/v5/httploader" // imported to compile http references in json schema
 	"go.uber.org/multierr"
@@ -13,37 +18,43 @@ const jsonFormat = "FORMAT_JSON"
 const schemaURI = "sample_schema"
 
 type Schema struct {
-	data []byte
+	data     []byte
+	compiled *jsonschema.Schema
+	// definitions are compiled $defs and definitions of document, including ones not referenced from root schema
+	definitions []*jsonschema.Schema
 }
 
 func (s *Schema) Format() string {
 	return jsonFormat
 }
 
+// GetCanonicalValue returns schema file identified by schema data, definitions and properties of compiled schema are searchable
 func (s *Schema) GetCanonicalValue() *schema.SchemaFile {
 	id := uuid.NewSHA1(uuid.NameSpaceOID, s.data)
+	types, fields := getTypesAndFields(s.compiled, s.definitions)
 	return &schema.SchemaFile{
-		ID:   id.String(),
-		Data: s.data,
+		ID:     id.String(),
+		Types:  types,
+		Fields: fields,
+		Data:   s.data,
+	}
+}
+
+func (s *Schema) verify(against schema.ParsedSchema) (*Schema, error) {
+	prev, ok := against.(*Schema)
+	if !ok || against.Format() != jsonFormat {
+		return nil, errors.New("different schema formats")
 	}
+	return prev, nil
 }
 
 // IsBackwardCompatible checks backward compatibility against given schema
 func (s *Schema) IsBackwardCompatible(against schema.ParsedSchema) error {
-	sc, err := jsonschema.CompileString(schemaURI, string(s.data))
-	if err != nil {
-		logger.Logger.Warn("unable to compile schema to check for backward compatibility")
-		return err
-	}
-	againstSchema, err := jsonschema.CompileString(schemaURI, string(against.GetCanonicalValue().Data))
+	prev, err := s.verify(against)
 	if err != nil {
-		logger.Logger.Warn("unable to compile against schema to check for backward compatibility")
 		return err
 	}
-	jsonSchemaMap := exploreSchema(sc)
-	againstJsonSchemaMap := exploreSchema(againstSchema)
-	return compareSchemas(againstJsonSchemaMap, jsonSchemaMap, backwardCompatibility,
-		[]SchemaCompareCheck{CheckPropertyDeleted, TypeCheckExecutor(StandardTypeChecks)}, []SchemaCheck{CheckAdditionalProperties})
+	return CheckBackwardCompatibility(prev.compiled, s.compiled)
 }
 
 // IsForwardCompatible checks backward compatibility against given schema
@@ -57,3 +68,36 @@ func (s *Schema) IsFullCompatible(against schema.ParsedSchema) error {
 	backwardErr := s.IsBackwardCompatible(against)
 	return multierr.Combine(forwardErr, backwardErr)
 }
+
+// compile compiles schema along with annotations like default, which are skipped by default compiler.
+// Definitions are compiled on their own, since subschemas not referenced from root schema are left out of it.
+func compile(data []byte, doc interface{}) (*jsonschema.Schema, []*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	compiler.ExtractAnnotations = true
+	if err := compiler.AddResource(schemaURI, bytes.NewReader(data)); err != nil {
+		return nil, nil, err
+	}
+	root, err := compiler.Compile(schemaURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	document, _ := doc.(map[string]interface{})
+	var definitions []*jsonschema.Schema
+	for _, keyword := range []string{"$defs", "definitions"} {
+		defs, _ := document[keyword].(map[string]interface{})
+		names := make([]string, 0, len(defs))
+		for name := range defs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			token := strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+			def, err := compiler.Compile(schemaURI + "#/" + keyword + "/" + url.PathEscape(token))
+			if err != nil {
+				return nil, nil, err
+			}
+			definitions = append(definitions, def)
+		}
+	}
+	return root, definitions, nil
+}
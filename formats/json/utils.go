@@ -3,6 +3,7 @@ package json
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/santhosh-tekuri/jsonschema/v5"
@@ -174,3 +175,49 @@ func getRestOfItemsSchema(jsSchema *jsonschema.Schema) *jsonschema.Schema {
 		return schema
 	}
 }
+
+// getTypesAndFields returns names of definitions and dotted paths of properties across subschemas explored from root schema and definitions,
+// like Address for #/$defs/Address, address.city for #/properties/address/properties/city and Address.city for #/$defs/Address/properties/city
+func getTypesAndFields(root *jsonschema.Schema, definitions []*jsonschema.Schema) ([]string, []string) {
+	explored := exploreSchema(root)
+	for _, def := range definitions {
+		explore(def, explored, root.Location)
+	}
+	var types, fields []string
+	for location := range explored {
+		names, keyword := locationNames(location)
+		switch keyword {
+		case "$defs", "definitions":
+			types = append(types, strings.Join(names, "."))
+		case "properties":
+			fields = append(fields, strings.Join(names, "."))
+		}
+	}
+	sort.Strings(types)
+	sort.Strings(fields)
+	return types, fields
+}
+
+// locationNames returns names of properties and definitions in location of subschema along with keyword of the last name
+func locationNames(location string) ([]string, string) {
+	i := strings.Index(location, "#")
+	if i < 0 {
+		return nil, ""
+	}
+	var names []string
+	var keyword string
+	tokens := strings.Split(strings.TrimPrefix(location[i+1:], "/"), "/")
+	for j := 0; j < len(tokens); j++ {
+		switch tokens[j] {
+		case "properties", "$defs", "definitions":
+			if j+1 < len(tokens) {
+				keyword = tokens[j]
+				names = append(names, strings.ReplaceAll(strings.ReplaceAll(tokens[j+1], "~1", "/"), "~0", "~"))
+				j++
+			}
+		default:
+			keyword = ""
+		}
+	}
+	return names, keyword
+}
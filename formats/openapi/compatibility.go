@@ -0,0 +1,234 @@
+package openapi
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/raystack/stencil/core/schema"
+	jsonformat "github.com/raystack/stencil/formats/json"
+)
+
+type diffKind int
+
+const (
+	_ diffKind = iota
+	pathDelete
+	operationDelete
+	requiredParameterAdd
+	requestBodyRequired
+	requestDelete
+	requiredRequestFieldAdd
+	responseDelete
+	responseFieldDelete
+	componentDelete
+)
+
+var diffKindNames = map[diffKind]string{
+	pathDelete:              "pathDelete",
+	operationDelete:         "operationDelete",
+	requiredParameterAdd:    "requiredParameterAdd",
+	requestBodyRequired:     "requestBodyRequired",
+	requestDelete:           "requestDelete",
+	requiredRequestFieldAdd: "requiredRequestFieldAdd",
+	responseDelete:          "responseDelete",
+	responseFieldDelete:     "responseFieldDelete",
+	componentDelete:         "componentDelete",
+}
+
+func (d diffKind) String() string {
+	return diffKindNames[d]
+}
+
+// compareDocuments checks every change from prev to current document which breaks clients of prev document.
+// Component schemas are checked with json schema backward compatibility checks.
+func compareDocuments(current, prev *Schema) error {
+	diffs := &compatibilityErr{}
+	currentRoutes := map[string]bool{}
+	for _, route := range current.doc.routes() {
+		currentRoutes[route] = true
+	}
+	for _, route := range prev.doc.routes() {
+		if !currentRoutes[route] {
+			diffs.add(pathDelete, route, route, nil, `%s "%s" is removed`, prev.doc.routeNoun(), route)
+		}
+	}
+	prevOperations, currentOperations := prev.doc.operations(), current.doc.operations()
+	for _, key := range sortedOperationKeys(prevOperations) {
+		prevOp := prevOperations[key]
+		if !currentRoutes[prevOp.route] {
+			continue
+		}
+		currentOp, ok := currentOperations[key]
+		if !ok {
+			diffs.add(operationDelete, key, key, nil, `operation "%s" is removed`, key)
+			continue
+		}
+		compareOperations(current.doc, prev.doc, currentOp, prevOp, diffs)
+	}
+	if err := compareComponents(current, prev, diffs); err != nil {
+		return err
+	}
+	if diffs.IsEmpty() {
+		return nil
+	}
+	return diffs
+}
+
+func sortedOperationKeys(operations map[string]*operation) []string {
+	keys := make([]string, 0, len(operations))
+	for key := range operations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func compareOperations(current, prev *document, currentOp, prevOp *operation, diffs *compatibilityErr) {
+	var parameters []string
+	for name := range currentOp.parameters {
+		parameters = append(parameters, name)
+	}
+	sort.Strings(parameters)
+	for _, name := range parameters {
+		required, wasRequired := currentOp.parameters[name], prevOp.parameters[name]
+		if !required || wasRequired {
+			continue
+		}
+		path := currentOp.key + " parameter " + name
+		if _, ok := prevOp.parameters[name]; ok {
+			diffs.add(requiredParameterAdd, path, false, true, `parameter "%s" is made required`, name)
+		} else {
+			diffs.add(requiredParameterAdd, path, nil, true, `required parameter "%s" is added`, name)
+		}
+	}
+	if currentOp.bodyRequired && !prevOp.bodyRequired {
+		diffs.add(requestBodyRequired, currentOp.key+" request", false, true, "request body is made required")
+	}
+	for _, section := range sortedKeys(prevOp.requests) {
+		path := prevOp.key + " " + section
+		currentSchema, ok := currentOp.requests[section]
+		if !ok {
+			diffs.add(requestDelete, path, section, nil, `%s is removed`, section)
+			continue
+		}
+		fields := &fieldWalker{current: current, prev: prev, path: path, diffs: diffs, visited: map[string]bool{}}
+		fields.compareRequired("", currentSchema, prevOp.requests[section])
+	}
+	for _, section := range sortedKeys(prevOp.responses) {
+		path := prevOp.key + " " + section
+		currentSchema, ok := currentOp.responses[section]
+		if !ok {
+			diffs.add(responseDelete, path, section, nil, `%s is removed`, section)
+			continue
+		}
+		fields := &fieldWalker{current: current, prev: prev, path: path, diffs: diffs, visited: map[string]bool{}}
+		fields.compareProperties("", currentSchema, prevOp.responses[section])
+	}
+}
+
+// compareComponents records component schema changes, errors which are not compatibility violations are returned as is
+func compareComponents(current, prev *Schema, diffs *compatibilityErr) error {
+	for _, name := range sortedKeys(prev.doc.componentSchemas()) {
+		currentSchema, ok := current.components[name]
+		if !ok {
+			diffs.add(componentDelete, "#/components/schemas/"+name, name, nil, `schema "%s" is removed`, name)
+			continue
+		}
+		err := jsonformat.CheckBackwardCompatibility(prev.components[name], currentSchema)
+		if err == nil {
+			continue
+		}
+		reporter, ok := err.(schema.ViolationReporter)
+		if !ok {
+			return fmt.Errorf("component schema %s: %w", name, err)
+		}
+		diffs.addViolations(reporter.Violations())
+	}
+	return nil
+}
+
+// fieldWalker walks request or response schema of an operation in both documents, following references
+type fieldWalker struct {
+	current, prev *document
+	path          string
+	diffs         *compatibilityErr
+	// visited stops walking recursive schemas, keyed by pair of followed references
+	visited map[string]bool
+}
+
+func (w *fieldWalker) resolve(currentNode, prevNode interface{}) (map[string]interface{}, map[string]interface{}, bool) {
+	current, currentRef := w.current.resolve(currentNode)
+	prev, prevRef := w.prev.resolve(prevNode)
+	if current == nil || prev == nil {
+		return nil, nil, false
+	}
+	if currentRef != "" && prevRef != "" {
+		key := prevRef + "|" + currentRef
+		if w.visited[key] {
+			return nil, nil, false
+		}
+		w.visited[key] = true
+	}
+	return current, prev, true
+}
+
+func joinField(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+// compareRequired records request fields which are required by current schema but not by prev schema
+func (w *fieldWalker) compareRequired(field string, currentNode, prevNode interface{}) {
+	current, prev, ok := w.resolve(currentNode, prevNode)
+	if !ok {
+		return
+	}
+	prevRequired := map[string]bool{}
+	for _, name := range asSlice(prev["required"]) {
+		prevRequired[asString(name)] = true
+	}
+	prevProperties, currentProperties := asMap(prev["properties"]), asMap(current["properties"])
+	for _, name := range asSlice(current["required"]) {
+		name := asString(name)
+		if prevRequired[name] {
+			continue
+		}
+		fieldName := joinField(field, name)
+		if _, ok := prevProperties[name]; ok {
+			w.diffs.add(requiredRequestFieldAdd, w.path+" "+fieldName, false, true, `field "%s" is made required`, fieldName)
+		} else {
+			w.diffs.add(requiredRequestFieldAdd, w.path+" "+fieldName, nil, true, `required field "%s" is added`, fieldName)
+		}
+	}
+	for _, name := range sortedKeys(prevProperties) {
+		if currentProperty, ok := currentProperties[name]; ok {
+			w.compareRequired(joinField(field, name), currentProperty, prevProperties[name])
+		}
+	}
+	if current["items"] != nil && prev["items"] != nil {
+		w.compareRequired(field+"[]", current["items"], prev["items"])
+	}
+}
+
+// compareProperties records response fields of prev schema which are removed in current schema
+func (w *fieldWalker) compareProperties(field string, currentNode, prevNode interface{}) {
+	current, prev, ok := w.resolve(currentNode, prevNode)
+	if !ok {
+		return
+	}
+	prevProperties, currentProperties := asMap(prev["properties"]), asMap(current["properties"])
+	for _, name := range sortedKeys(prevProperties) {
+		fieldName := joinField(field, name)
+		currentProperty, ok := currentProperties[name]
+		if !ok {
+			w.diffs.add(responseFieldDelete, w.path+" "+fieldName, fieldName, nil, `field "%s" is removed`, fieldName)
+			continue
+		}
+		w.compareProperties(fieldName, currentProperty, prevProperties[name])
+	}
+	if current["items"] != nil && prev["items"] != nil {
+		w.compareProperties(field+"[]", current["items"], prev["items"])
+	}
+}
@@ -0,0 +1,72 @@
+package openapi_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompatibility(t *testing.T) {
+	prev := parse(t, bookingAPI)
+	for _, test := range []struct {
+		name     string
+		old      string
+		new      string
+		backward string
+	}{
+		{"optional field and operation addition is allowed",
+			"        note:\n          type: string\n",
+			"        note:\n          type: string\n        tags:\n          type: array\n    Customer:\n      type: object\n",
+			""},
+		{"path removal is not backward compatible", "  /bookings/{id}:", "  /rides/{id}:",
+			`/bookings/{id}: path "/bookings/{id}" is removed`},
+		{"operation removal is not backward compatible", "    put:\n      operationId: updateBooking", "    post:\n      operationId: updateBooking",
+			`PUT /bookings/{id}: operation "PUT /bookings/{id}" is removed`},
+		{"response removal is not backward compatible", "            application/json:\n              schema:\n                $ref: '#/components/schemas/Booking'",
+			"            application/xml:\n              schema:\n                $ref: '#/components/schemas/Booking'",
+			`GET /bookings/{id} response 200: response 200 is removed`},
+		{"response field removal is not backward compatible", "        driver:\n          $ref: '#/components/schemas/Driver'\n", "",
+			`GET /bookings/{id} response 200 driver: field "driver" is removed;#/components/schemas/Booking/properties/driver: property is removed`},
+		{"nested response field removal is not backward compatible", "      properties:\n        name:\n          type: string\n", "      properties:\n        phone:\n          type: string\n",
+			`GET /bookings/{id} response 200 driver.name: field "driver.name" is removed;#/components/schemas/Driver/properties/name: property is removed`},
+		{"required request field addition is not backward compatible", "      required: [status]", "      required: [status, note, region]",
+			`PUT /bookings/{id} request note: field "note" is made required;PUT /bookings/{id} request region: required field "region" is added;#/components/schemas/BookingRequest: count of elements do not match`},
+		{"required parameter addition is not backward compatible", "        - name: fields\n          in: query\n",
+			"        - name: fields\n          in: query\n          required: true\n          schema:\n            type: string\n        - name: region\n          in: header\n",
+			`GET /bookings/{id} parameter query.fields: parameter "query.fields" is made required`},
+		{"making request body required is not backward compatible", "      requestBody:\n", "      requestBody:\n        required: true\n",
+			`PUT /bookings/{id} request: request body is made required`},
+		{"component schema removal is not backward compatible", "Driver", "Rider",
+			`#/components/schemas/Booking/properties/driver: ref for schema has been changed;#/components/schemas/Driver: schema "Driver" is removed`},
+		{"component schema type change is not backward compatible", "        status:\n          type: string\n        driver:", "        status:\n          type: integer\n        driver:",
+			`#/components/schemas/Booking/properties/status: string element not found in second array`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			doc := strings.ReplaceAll(bookingAPI, test.old, test.new)
+			assert.NotEqual(t, bookingAPI, doc)
+			current := parse(t, doc)
+			backward := current.IsBackwardCompatible(prev)
+			if test.backward == "" {
+				assert.NoError(t, backward)
+			} else if assert.Error(t, backward) {
+				assert.Equal(t, test.backward, backward.Error())
+			}
+		})
+	}
+}
+
+func TestAsyncAPICompatibility(t *testing.T) {
+	prev := parse(t, bookingEvents)
+	current := parse(t, strings.ReplaceAll(bookingEvents, "booking/created", "booking/updated"))
+	err := current.IsBackwardCompatible(prev)
+	if assert.Error(t, err) {
+		assert.Equal(t, `booking/created: channel "booking/created" is removed`, err.Error())
+	}
+	current = parse(t, strings.Replace(bookingEvents, "        id:\n", "        ref:\n", 1))
+	err = current.IsBackwardCompatible(prev)
+	if assert.Error(t, err) {
+		assert.Equal(t, `SUBSCRIBE booking/created message BookingCreated id: field "id" is removed;#/components/schemas/Booking/properties/id: property is removed`, err.Error())
+	}
+	assert.NoError(t, prev.IsFullCompatible(parse(t, bookingEvents)))
+}
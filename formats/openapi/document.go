@@ -0,0 +1,315 @@
+package openapi
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// document is an OpenAPI or AsyncAPI document with yaml mappings converted to json objects
+type document struct {
+	root     map[string]interface{}
+	asyncAPI bool
+	version  string
+}
+
+// operation is a path or channel operation along with schemas of data it receives and sends
+type operation struct {
+	// key identifies operation, like "GET /bookings" or "SEND booking/created"
+	key   string
+	route string
+	id    string
+	// parameters maps "in.name" of parameter to whether it is required
+	parameters   map[string]bool
+	bodyRequired bool
+	// requests and responses map section like "request", "response 200" or "message BookingCreated" to its schema
+	requests  map[string]interface{}
+	responses map[string]interface{}
+}
+
+func asMap(node interface{}) map[string]interface{} {
+	m, _ := node.(map[string]interface{})
+	return m
+}
+
+func asSlice(node interface{}) []interface{} {
+	s, _ := node.([]interface{})
+	return s
+}
+
+func asString(node interface{}) string {
+	s, _ := node.(string)
+	return s
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// routeNoun returns what routes are called in the document
+func (d *document) routeNoun() string {
+	if d.asyncAPI {
+		return "channel"
+	}
+	return "path"
+}
+
+// lookup returns node at local reference like "#/components/schemas/Booking"
+func (d *document) lookup(ref string) (interface{}, bool) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, false
+	}
+	var node interface{} = d.root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch v := node.(type) {
+		case map[string]interface{}:
+			child, ok := v[token]
+			if !ok {
+				return nil, false
+			}
+			node = child
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			node = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return node, true
+}
+
+// resolve follows references of node, returns resolved object along with last followed reference
+func (d *document) resolve(node interface{}) (map[string]interface{}, string) {
+	var lastRef string
+	for i := 0; i < 32; i++ {
+		ref := asString(asMap(node)["$ref"])
+		if ref == "" {
+			break
+		}
+		resolved, ok := d.lookup(ref)
+		if !ok {
+			return nil, ref
+		}
+		node, lastRef = resolved, ref
+	}
+	return asMap(node), lastRef
+}
+
+// refs returns every reference used in the document
+func (d *document) refs() []string {
+	var refs []string
+	var walk func(node interface{})
+	walk = func(node interface{}) {
+		switch v := node.(type) {
+		case map[string]interface{}:
+			if ref, ok := v["$ref"].(string); ok {
+				refs = append(refs, ref)
+			}
+			for _, key := range sortedKeys(v) {
+				walk(v[key])
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(d.root)
+	return refs
+}
+
+func (d *document) componentSchemas() map[string]interface{} {
+	return asMap(asMap(d.root["components"])["schemas"])
+}
+
+// routes returns paths of OpenAPI document or channel addresses of AsyncAPI document
+func (d *document) routes() []string {
+	if !d.asyncAPI {
+		return sortedKeys(asMap(d.root["paths"]))
+	}
+	channels := asMap(d.root["channels"])
+	if strings.HasPrefix(d.version, "2.") {
+		return sortedKeys(channels)
+	}
+	var routes []string
+	for _, id := range sortedKeys(channels) {
+		routes = append(routes, d.channelAddress(id))
+	}
+	return routes
+}
+
+func (d *document) channelAddress(id string) string {
+	channel, _ := d.resolve(asMap(d.root["channels"])[id])
+	if address := asString(channel["address"]); address != "" {
+		return address
+	}
+	return id
+}
+
+// operations returns operations of the document by their key
+func (d *document) operations() map[string]*operation {
+	switch {
+	case !d.asyncAPI:
+		return d.pathOperations()
+	case strings.HasPrefix(d.version, "2."):
+		return d.channelOperations()
+	}
+	return d.asyncOperations()
+}
+
+func (d *document) pathOperations() map[string]*operation {
+	operations := map[string]*operation{}
+	for path, node := range asMap(d.root["paths"]) {
+		item, _ := d.resolve(node)
+		for _, method := range httpMethods {
+			opNode := asMap(item[method])
+			if opNode == nil {
+				continue
+			}
+			op := &operation{
+				key:        strings.ToUpper(method) + " " + path,
+				route:      path,
+				id:         asString(opNode["operationId"]),
+				parameters: map[string]bool{},
+				requests:   map[string]interface{}{},
+				responses:  map[string]interface{}{},
+			}
+			for _, p := range append(asSlice(item["parameters"]), asSlice(opNode["parameters"])...) {
+				param, _ := d.resolve(p)
+				in := asString(param["in"])
+				op.parameters[in+"."+asString(param["name"])] = in == "path" || param["required"] == true
+			}
+			if body, _ := d.resolve(opNode["requestBody"]); body != nil {
+				op.bodyRequired = body["required"] == true
+				d.addContent(op.requests, "request", body["content"])
+			}
+			for code, r := range asMap(opNode["responses"]) {
+				response, _ := d.resolve(r)
+				d.addContent(op.responses, "response "+code, response["content"])
+			}
+			operations[op.key] = op
+		}
+	}
+	return operations
+}
+
+// addContent adds schema of each media type to sections, media type is left out of section name for json
+func (d *document) addContent(sections map[string]interface{}, section string, content interface{}) {
+	media := asMap(content)
+	if len(media) == 0 {
+		sections[section] = nil
+		return
+	}
+	for mediaType, node := range media {
+		name := section
+		if mediaType != "application/json" {
+			name = section + " " + mediaType
+		}
+		sections[name] = asMap(node)["schema"]
+	}
+}
+
+// channelOperations returns publish and subscribe operations of AsyncAPI 2 document.
+// Application receives messages published to the channel and sends messages subscribed from the channel.
+func (d *document) channelOperations() map[string]*operation {
+	operations := map[string]*operation{}
+	for channel, node := range asMap(d.root["channels"]) {
+		item, _ := d.resolve(node)
+		for _, action := range []string{"publish", "subscribe"} {
+			opNode, _ := d.resolve(item[action])
+			if opNode == nil {
+				continue
+			}
+			op := &operation{
+				key:       strings.ToUpper(action) + " " + channel,
+				route:     channel,
+				id:        asString(opNode["operationId"]),
+				requests:  map[string]interface{}{},
+				responses: map[string]interface{}{},
+			}
+			sections := op.requests
+			if action == "subscribe" {
+				sections = op.responses
+			}
+			message, _ := d.resolve(opNode["message"])
+			if oneOf := asSlice(message["oneOf"]); oneOf != nil {
+				for _, m := range oneOf {
+					d.addMessage(sections, m)
+				}
+			} else {
+				d.addMessage(sections, opNode["message"])
+			}
+			operations[op.key] = op
+		}
+	}
+	return operations
+}
+
+// asyncOperations returns send and receive operations of AsyncAPI 3 document
+func (d *document) asyncOperations() map[string]*operation {
+	operations := map[string]*operation{}
+	for id, node := range asMap(d.root["operations"]) {
+		opNode, _ := d.resolve(node)
+		action := asString(opNode["action"])
+		channel, channelRef := d.resolve(opNode["channel"])
+		address := asString(channel["address"])
+		if address == "" {
+			address = id
+			if channelRef != "" {
+				address = channelRef[strings.LastIndex(channelRef, "/")+1:]
+			}
+		}
+		op := &operation{
+			key:       strings.ToUpper(action) + " " + address,
+			route:     address,
+			id:        id,
+			requests:  map[string]interface{}{},
+			responses: map[string]interface{}{},
+		}
+		sections := op.requests
+		if action == "send" {
+			sections = op.responses
+		}
+		messages := asSlice(opNode["messages"])
+		if messages == nil {
+			for _, name := range sortedKeys(asMap(channel["messages"])) {
+				messages = append(messages, asMap(channel["messages"])[name])
+			}
+		}
+		for _, m := range messages {
+			d.addMessage(sections, m)
+		}
+		operations[op.key] = op
+	}
+	return operations
+}
+
+// addMessage adds payload schema of message, section is named after message name or the referenced message
+func (d *document) addMessage(sections map[string]interface{}, node interface{}) {
+	message, ref := d.resolve(node)
+	if message == nil {
+		return
+	}
+	name := asString(message["name"])
+	if name == "" && ref != "" {
+		name = ref[strings.LastIndex(ref, "/")+1:]
+	}
+	if name == "" {
+		name = asString(message["messageId"])
+	}
+	if name == "" {
+		name = strconv.Itoa(len(sections))
+	}
+	sections["message "+name] = message["payload"]
+}
@@ -0,0 +1,47 @@
+package openapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/raystack/stencil/core/schema"
+)
+
+// compatibilityErr collects changes to operations along with changes rejected by json schema checks of component schemas
+type compatibilityErr struct {
+	schema.ViolationCollector[fmt.Stringer]
+}
+
+// kindName is kind of change reported by json schema checks
+type kindName string
+
+func (k kindName) String() string {
+	return string(k)
+}
+
+func (c *compatibilityErr) add(kind diffKind, path string, oldValue, newValue interface{}, format string, args ...interface{}) {
+	c.Add(kind, path, fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)), valueString(oldValue), valueString(newValue))
+}
+
+// addViolations adds changes rejected by json schema checks of component schemas, locations are made relative to document
+func (c *compatibilityErr) addViolations(violations []schema.Violation) {
+	for _, v := range violations {
+		path := fragment(v.Path)
+		c.Add(kindName(v.Kind), path, strings.Replace(v.Message, v.Path, path, 1), v.OldValue, v.NewValue)
+	}
+}
+
+// fragment returns location relative to document, like #/components/schemas/Booking
+func fragment(location string) string {
+	if i := strings.Index(location, "#"); i >= 0 {
+		return location[i:]
+	}
+	return location
+}
+
+func valueString(val interface{}) string {
+	if val == nil {
+		return ""
+	}
+	return fmt.Sprint(val)
+}
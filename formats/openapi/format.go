@@ -0,0 +1,32 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/raystack/stencil/core/schema"
+)
+
+// Format is openapi schema format plugin, schema data is an OpenAPI 3 or AsyncAPI document in json or yaml
+type Format struct{}
+
+func (Format) Name() string {
+	return openapiFormat
+}
+
+func (Format) ContentType() string {
+	return "text/plain"
+}
+
+func (Format) ParseSchema(data []byte) (schema.ParsedSchema, error) {
+	return ParseSchema(data)
+}
+
+// PrintSchema prints indented json document as is, yaml document is printed unchanged
+func (Format) PrintSchema(data []byte, filter string) (string, string, error) {
+	var out bytes.Buffer
+	if err := json.Indent(&out, data, "", "  "); err != nil {
+		return string(data), "YAML", nil
+	}
+	return out.String(), "JSON", nil
+}
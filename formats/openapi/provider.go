@@ -0,0 +1,112 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"gopkg.in/yaml.v3"
+)
+
+// documentURI is resource url of document while compiling component schemas, locations of schemas are relative to it
+const documentURI = "openapi.json"
+
+// ParseSchema parses OpenAPI or AsyncAPI document, references and component schemas of the document are validated
+func ParseSchema(data []byte) (schema.ParsedSchema, error) {
+	var root interface{}
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: err}
+	}
+	doc, err := newDocument(normalize(root))
+	if err != nil {
+		return nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: err}
+	}
+	canonical, err := json.Marshal(doc.root)
+	if err != nil {
+		return nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: err}
+	}
+	components, err := compileComponents(doc, canonical)
+	if err != nil {
+		return nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: err}
+	}
+	return &Schema{data: data, canonical: canonical, doc: doc, components: components}, nil
+}
+
+func newDocument(root interface{}) (*document, error) {
+	obj := asMap(root)
+	if obj == nil {
+		return nil, errors.New("document should be an object")
+	}
+	doc := &document{root: obj}
+	if version := asString(obj["openapi"]); strings.HasPrefix(version, "3.") {
+		doc.version = version
+	} else if version := asString(obj["asyncapi"]); strings.HasPrefix(version, "2.") || strings.HasPrefix(version, "3.") {
+		doc.version, doc.asyncAPI = version, true
+	} else {
+		return nil, errors.New("document should be an OpenAPI 3 or AsyncAPI 2, 3 document")
+	}
+	for _, ref := range doc.refs() {
+		if !strings.HasPrefix(ref, "#") {
+			return nil, fmt.Errorf("external reference %q is not supported", ref)
+		}
+		if _, ok := doc.lookup(ref); !ok {
+			return nil, fmt.Errorf("reference %q is not found", ref)
+		}
+	}
+	return doc, nil
+}
+
+// normalize converts yaml mappings with non string keys, like response codes, to json objects
+func normalize(node interface{}) interface{} {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			v[key] = normalize(val)
+		}
+		return v
+	case map[interface{}]interface{}:
+		obj := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			obj[fmt.Sprint(key)] = normalize(val)
+		}
+		return obj
+	case []interface{}:
+		for i, val := range v {
+			v[i] = normalize(val)
+		}
+		return v
+	}
+	return node
+}
+
+// compileComponents compiles component schemas as json schemas of draft used by the document
+func compileComponents(doc *document, canonical []byte) (map[string]*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	switch {
+	case doc.asyncAPI:
+		compiler.Draft = jsonschema.Draft7
+	case strings.HasPrefix(doc.version, "3.0"):
+		compiler.Draft = jsonschema.Draft4
+	default:
+		compiler.Draft = jsonschema.Draft2020
+	}
+	if err := compiler.AddResource(documentURI, bytes.NewReader(canonical)); err != nil {
+		return nil, err
+	}
+	components := map[string]*jsonschema.Schema{}
+	for _, name := range sortedKeys(doc.componentSchemas()) {
+		escaped := strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+		sc, err := compiler.Compile(documentURI + "#/components/schemas/" + escaped)
+		if err != nil {
+			return nil, err
+		}
+		components[name] = sc
+	}
+	return components, nil
+}
@@ -0,0 +1,151 @@
+package openapi_test
+
+import (
+	"testing"
+
+	"github.com/raystack/stencil/formats/formattest"
+	"github.com/raystack/stencil/formats/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const bookingAPI = `openapi: 3.0.3
+info:
+  title: Booking
+  version: 1.0.0
+paths:
+  /bookings/{id}:
+    parameters:
+      - name: id
+        in: path
+        required: true
+        schema:
+          type: string
+    get:
+      operationId: getBooking
+      parameters:
+        - name: fields
+          in: query
+          schema:
+            type: string
+      responses:
+        200:
+          description: booking
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Booking'
+    put:
+      operationId: updateBooking
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/BookingRequest'
+      responses:
+        204:
+          description: updated
+components:
+  schemas:
+    Booking:
+      type: object
+      properties:
+        id:
+          type: string
+        status:
+          type: string
+        driver:
+          $ref: '#/components/schemas/Driver'
+    BookingRequest:
+      type: object
+      required: [status]
+      properties:
+        status:
+          type: string
+        note:
+          type: string
+    Driver:
+      type: object
+      properties:
+        name:
+          type: string
+`
+
+const bookingEvents = `asyncapi: 2.6.0
+info:
+  title: Booking events
+  version: 1.0.0
+channels:
+  booking/created:
+    subscribe:
+      operationId: onBookingCreated
+      message:
+        $ref: '#/components/messages/BookingCreated'
+components:
+  messages:
+    BookingCreated:
+      payload:
+        $ref: '#/components/schemas/Booking'
+  schemas:
+    Booking:
+      type: object
+      properties:
+        id:
+          type: string
+`
+
+var parse = formattest.Parser(openapi.ParseSchema)
+
+func TestParseSchema(t *testing.T) {
+	t.Run("should return search data of component schemas and operations", func(t *testing.T) {
+		sc := parse(t, bookingAPI)
+		file := sc.GetCanonicalValue()
+		assert.Equal(t, "FORMAT_OPENAPI", sc.Format())
+		assert.Equal(t, []string{"Booking", "BookingRequest", "Driver"}, file.Types)
+		assert.Equal(t, []string{"Booking.driver", "Booking.id", "Booking.status", "BookingRequest.note", "BookingRequest.status", "Driver.name",
+			"GET /bookings/{id}", "PUT /bookings/{id}", "getBooking", "updateBooking"}, file.Fields)
+		assert.Equal(t, []byte(bookingAPI), file.Data)
+	})
+	t.Run("should parse asyncapi document", func(t *testing.T) {
+		sc := parse(t, bookingEvents)
+		file := sc.GetCanonicalValue()
+		assert.Equal(t, []string{"Booking"}, file.Types)
+		assert.Equal(t, []string{"Booking.id", "SUBSCRIBE booking/created", "onBookingCreated"}, file.Fields)
+	})
+	t.Run("should have same id for json and yaml of same document", func(t *testing.T) {
+		sc := parse(t, bookingEvents)
+		json := parse(t, `{"asyncapi": "2.6.0", "info": {"title": "Booking events", "version": "1.0.0"},
+			"components": {"schemas": {"Booking": {"type": "object", "properties": {"id": {"type": "string"}}}},
+				"messages": {"BookingCreated": {"payload": {"$ref": "#/components/schemas/Booking"}}}},
+			"channels": {"booking/created": {"subscribe": {"operationId": "onBookingCreated", "message": {"$ref": "#/components/messages/BookingCreated"}}}}}`)
+		formattest.AssertID(t, sc, json, parse(t, bookingEvents+"    Driver:\n      type: object\n"))
+	})
+	for _, test := range []struct {
+		name string
+		doc  string
+		err  string
+	}{
+		{"should return error for document which is not an api document", `{"type": "object"}`, "document should be an OpenAPI 3 or AsyncAPI 2, 3 document"},
+		{"should return error for swagger 2 document", `{"swagger": "2.0"}`, "document should be an OpenAPI 3 or AsyncAPI 2, 3 document"},
+		{"should return error for external reference", `{"openapi": "3.1.0", "components": {"schemas": {"A": {"$ref": "common.json#/B"}}}}`, `external reference "common.json#/B" is not supported`},
+		{"should return error for unresolved reference", `{"openapi": "3.1.0", "components": {"schemas": {"A": {"$ref": "#/components/schemas/B"}}}}`, `reference "#/components/schemas/B" is not found`},
+		{"should return error for invalid component schema", `{"openapi": "3.1.0", "components": {"schemas": {"A": {"type": 1}}}}`, "openapi.json#/components/schemas/A"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := openapi.ParseSchema([]byte(test.doc))
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), test.err)
+			}
+		})
+	}
+}
+
+func TestPrintSchema(t *testing.T) {
+	source, language, err := openapi.Format{}.PrintSchema([]byte(`{"openapi":"3.1.0"}`), "")
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n  \"openapi\": \"3.1.0\"\n}", source)
+	assert.Equal(t, "JSON", language)
+	source, language, err = openapi.Format{}.PrintSchema([]byte(bookingAPI), "")
+	assert.NoError(t, err)
+	assert.Equal(t, bookingAPI, source)
+	assert.Equal(t, "YAML", language)
+}
@@ -0,0 +1,90 @@
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"go.uber.org/multierr"
+)
+
+const openapiFormat = "FORMAT_OPENAPI"
+
+type Schema struct {
+	data []byte
+	// canonical is document as json with sorted keys
+	canonical  []byte
+	doc        *document
+	components map[string]*jsonschema.Schema
+}
+
+func (s *Schema) Format() string {
+	return openapiFormat
+}
+
+// GetCanonicalValue returns schema file identified by document converted to json, so formatting changes and
+// switching between json and yaml are not new versions. Component schema properties and operations are searchable fields.
+func (s *Schema) GetCanonicalValue() *schema.SchemaFile {
+	id := uuid.NewSHA1(uuid.NameSpaceOID, s.canonical)
+	return &schema.SchemaFile{
+		ID:     id.String(),
+		Types:  sortedKeys(s.doc.componentSchemas()),
+		Data:   s.data,
+		Fields: s.getAllFields(),
+	}
+}
+
+// getAllFields returns properties of component schemas as schema.property, along with operations and operation ids
+func (s *Schema) getAllFields() []string {
+	var fields []string
+	components := s.doc.componentSchemas()
+	for _, name := range sortedKeys(components) {
+		resolved, _ := s.doc.resolve(components[name])
+		for _, property := range sortedKeys(asMap(resolved["properties"])) {
+			fields = append(fields, fmt.Sprintf("%s.%s", name, property))
+		}
+	}
+	var operations []string
+	for key, op := range s.doc.operations() {
+		operations = append(operations, key)
+		if op.id != "" {
+			operations = append(operations, op.id)
+		}
+	}
+	sort.Strings(operations)
+	return append(fields, operations...)
+}
+
+func (s *Schema) verify(against schema.ParsedSchema) (*Schema, error) {
+	prev, ok := against.(*Schema)
+	if !ok || against.Format() != openapiFormat {
+		return nil, errors.New("different schema formats")
+	}
+	return prev, nil
+}
+
+// IsBackwardCompatible checks whether clients of given document keep working with this document
+// Disallowed changes: path, operation and response removal, response field removal, newly required parameters and request fields,
+// component schema changes rejected by json schema backward compatibility
+func (s *Schema) IsBackwardCompatible(against schema.ParsedSchema) error {
+	prev, err := s.verify(against)
+	if err != nil {
+		return err
+	}
+	return compareDocuments(s, prev)
+}
+
+// IsForwardCompatible checks backward compatibility of given document against this document
+func (s *Schema) IsForwardCompatible(against schema.ParsedSchema) error {
+	return against.IsBackwardCompatible(s)
+}
+
+// IsFullCompatible checks for both backward and forward compatibility
+func (s *Schema) IsFullCompatible(against schema.ParsedSchema) error {
+	forwardErr := s.IsForwardCompatible(against)
+	backwardErr := s.IsBackwardCompatible(against)
+	return multierr.Combine(forwardErr, backwardErr)
+}
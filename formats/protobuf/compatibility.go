@@ -1,6 +1,9 @@
 package protobuf
 
 import (
+	"fmt"
+
+	"github.com/raystack/stencil/core/schema"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 )
@@ -25,8 +28,56 @@ const (
 	enumValueDeleteWithoutReservedName
 	enumValueNumberChange
 	syntaxChange
+	// changes below are only reported by diff, none of the compatibility modes reject them
+	fileAdd
+	fileDelete
+	messageAdd
+	fieldAdd
+	enumAdd
+	enumValueAdd
+	reservedRangeAdd
+	reservedNameAdd
+	serviceAdd
+	serviceDelete
+	methodAdd
+	methodDelete
+	methodSignatureChange
+	optionChange
 )
 
+var diffKindNames = map[diffKind]string{
+	messageDelete:                        "messageDelete",
+	nonInclusivereservedRange:            "nonInclusiveReservedRange",
+	nonInclusiceReservedNames:            "nonInclusiveReservedNames",
+	fieldDelete:                          "fieldDelete",
+	fieldDeleteWithoutReservedNumber:     "fieldDeleteWithoutReservedNumber",
+	fieldDeleteWithoutReservedName:       "fieldDeleteWithoutReservedName",
+	fieldNameChange:                      "fieldNameChange",
+	fieldLabelchange:                     "fieldLabelChange",
+	fieldKindChange:                      "fieldKindChange",
+	fieldTypeChange:                      "fieldTypeChange",
+	enumDelete:                           "enumDelete",
+	enumValueDelete:                      "enumValueDelete",
+	enumValueDeleteWithoutReservedNumber: "enumValueDeleteWithoutReservedNumber",
+	enumValueDeleteWithoutReservedName:   "enumValueDeleteWithoutReservedName",
+	enumValueNumberChange:                "enumValueNumberChange",
+	syntaxChange:                         "syntaxChange",
+	fileAdd:                              "fileAdd",
+	fileDelete:                           "fileDelete",
+	messageAdd:                           "messageAdd",
+	fieldAdd:                             "fieldAdd",
+	enumAdd:                              "enumAdd",
+	enumValueAdd:                         "enumValueAdd",
+	reservedRangeAdd:                     "reservedRangeAdd",
+	reservedNameAdd:                      "reservedNameAdd",
+	serviceAdd:                           "serviceAdd",
+	serviceDelete:                        "serviceDelete",
+	methodAdd:                            "methodAdd",
+	methodDelete:                         "methodDelete",
+	methodSignatureChange:                "methodSignatureChange",
+	optionChange:                         "optionChange",
+}
+
 var (
 	backwardCompatibility = []diffKind{
 		messageDelete,
@@ -71,22 +122,26 @@ var (
 		syntaxChange}
 )
 
-func (d diffKind) contains(others []diffKind) bool {
-	for _, v := range others {
-		if v == d {
-			return true
-		}
-	}
-	return false
+func (d diffKind) String() string {
+	return diffKindNames[d]
 }
 
 func compareSchemas(current, prev *protoregistry.Files, notAllowedChanges []diffKind) error {
-	diffs := &compatibilityErr{notAllowed: notAllowedChanges}
+	diffs := &compatibilityErr{}
+	walkSchemas(current, prev, diffRecorder{schema.RejectedChanges[diffKind](diffs, notAllowedChanges)})
+	if diffs.IsEmpty() {
+		return nil
+	}
+	return diffs
+}
+
+// walkSchemas records every change from prev to current schema
+func walkSchemas(current, prev *protoregistry.Files, diffs diffRecorder) {
 	prev.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
 		forEachMessage(fd.Messages(), func(prevMsg protoreflect.MessageDescriptor) bool {
 			currentMsg := getMessage(current, prevMsg.FullName())
 			if currentMsg == nil {
-				diffs.add(messageDelete, prevMsg, `"%s" is removed`, prevMsg.FullName())
+				diffs.add(messageDelete, prevMsg, prevMsg.FullName(), nil, `"%s" is removed`, prevMsg.FullName())
 				return true
 			}
 			compareSyntax(currentMsg, prevMsg, diffs)
@@ -96,27 +151,98 @@ func compareSchemas(current, prev *protoregistry.Files, notAllowedChanges []diff
 		forEachEnum(fd, func(ed protoreflect.EnumDescriptor) bool {
 			currentEnum := getEnum(current, ed.FullName())
 			if currentEnum == nil {
-				diffs.add(enumDelete, ed, `enum "%s" deleted`, ed.FullName())
+				diffs.add(enumDelete, ed, ed.FullName(), nil, `enum "%s" deleted`, ed.FullName())
 				return true
 			}
 			compareEnums(currentEnum, ed, diffs)
 			return true
 		})
+		compareFiles(current, fd, diffs)
 		return true
 	})
-	if diffs.isEmpty() {
-		return nil
+	current.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if _, err := prev.FindFileByPath(fd.Path()); err != nil {
+			diffs.add(fileAdd, fd, nil, fd.Path(), `file "%s" is added`, fd.Path())
+		}
+		forEachMessage(fd.Messages(), func(msg protoreflect.MessageDescriptor) bool {
+			if getMessage(prev, msg.FullName()) == nil {
+				diffs.add(messageAdd, msg, nil, msg.FullName(), `"%s" is added`, msg.FullName())
+			}
+			return true
+		})
+		forEachEnum(fd, func(ed protoreflect.EnumDescriptor) bool {
+			if getEnum(prev, ed.FullName()) == nil {
+				diffs.add(enumAdd, ed, nil, ed.FullName(), `enum "%s" added`, ed.FullName())
+			}
+			return true
+		})
+		eachService(fd.Services(), func(sd protoreflect.ServiceDescriptor) bool {
+			if getService(prev, sd.FullName()) == nil {
+				diffs.add(serviceAdd, sd, nil, sd.FullName(), `service "%s" is added`, sd.FullName())
+			}
+			return true
+		})
+		return true
+	})
+}
+
+// compareFiles records file level changes, like file deletion, file options and services of the file
+func compareFiles(current *protoregistry.Files, prev protoreflect.FileDescriptor, diffs diffRecorder) {
+	currentFile, err := current.FindFileByPath(prev.Path())
+	if err != nil {
+		diffs.add(fileDelete, prev, prev.Path(), nil, `file "%s" is deleted`, prev.Path())
+	} else {
+		compareOptions(currentFile, prev, diffs)
 	}
-	return diffs
+	eachService(prev.Services(), func(prevService protoreflect.ServiceDescriptor) bool {
+		currentService := getService(current, prevService.FullName())
+		if currentService == nil {
+			diffs.add(serviceDelete, prevService, prevService.FullName(), nil, `service "%s" is deleted`, prevService.FullName())
+			return true
+		}
+		compareServices(currentService, prevService, diffs)
+		return true
+	})
 }
 
-func compareMessages(current, prev protoreflect.MessageDescriptor, diffs *compatibilityErr) {
+func compareServices(current, prev protoreflect.ServiceDescriptor, diffs diffRecorder) {
+	compareOptions(current, prev, diffs)
+	for i := 0; i < prev.Methods().Len(); i++ {
+		prevMethod := prev.Methods().Get(i)
+		currentMethod := current.Methods().ByName(prevMethod.Name())
+		if currentMethod == nil {
+			diffs.add(methodDelete, prevMethod, prevMethod.Name(), nil, `rpc "%s" is deleted from "%s"`, prevMethod.Name(), prev.FullName())
+			continue
+		}
+		prevSignature, currentSignature := methodSignature(prevMethod), methodSignature(currentMethod)
+		if prevSignature != currentSignature {
+			diffs.add(methodSignatureChange, prevMethod, prevSignature, currentSignature, `rpc "%s" changed from "%s" to "%s"`, prevMethod.Name(), prevSignature, currentSignature)
+		}
+		compareOptions(currentMethod, prevMethod, diffs)
+	}
+	for i := 0; i < current.Methods().Len(); i++ {
+		currentMethod := current.Methods().Get(i)
+		if prev.Methods().ByName(currentMethod.Name()) == nil {
+			diffs.add(methodAdd, currentMethod, nil, methodSignature(currentMethod), `rpc "%s" is added to "%s"`, currentMethod.Name(), current.FullName())
+		}
+	}
+}
+
+// compareOptions records change in options of the element, like java_package of file or deprecated option of field
+func compareOptions(current, prev protoreflect.Descriptor, diffs diffRecorder) {
+	prevOptions, currentOptions := optionsString(prev), optionsString(current)
+	if prevOptions != currentOptions {
+		diffs.add(optionChange, prev, prevOptions, currentOptions, `options of "%s" changed from "%s" to "%s"`, elementPath(prev), prevOptions, currentOptions)
+	}
+}
+
+func compareMessages(current, prev protoreflect.MessageDescriptor, diffs diffRecorder) {
 	prevRanges := prev.ReservedRanges()
 	for i := 0; i < prevRanges.Len(); i++ {
 		prevRange := prevRanges.Get(i)
 		start, end := prevRange[0], prevRange[1]
 		if !(current.ReservedRanges().Has(start) && current.ReservedRanges().Has(end-1)) {
-			diffs.add(nonInclusivereservedRange, current, "previous reserved range (%d, %d) is not inclusive of current range", start, end)
+			diffs.add(nonInclusivereservedRange, current, reservedRange(int64(start), int64(end-1)), nil, "previous reserved range (%d, %d) is not inclusive of current range", start, end)
 		}
 	}
 	// check reserved names
@@ -124,7 +250,7 @@ func compareMessages(current, prev protoreflect.MessageDescriptor, diffs *compat
 	for i := 0; i < prevNames.Len(); i++ {
 		prevName := prevNames.Get(i)
 		if !(current.ReservedNames().Has(prevName)) {
-			diffs.add(nonInclusiceReservedNames, current, `previous reserved name "%s" is removed`, prevName)
+			diffs.add(nonInclusiceReservedNames, current, prevName, nil, `previous reserved name "%s" is removed`, prevName)
 		}
 	}
 	// check field compatibility
@@ -133,40 +259,67 @@ func compareMessages(current, prev protoreflect.MessageDescriptor, diffs *compat
 		prevField := prevFields.Get(i)
 		currentField := current.Fields().ByNumber(prevField.Number())
 		if currentField == nil {
-			diffs.add(fieldDelete, prev, `field "%s" is deleted`, prevField.Name())
+			diffs.add(fieldDelete, prevField, prevField.Name(), nil, `field "%s" is deleted`, prevField.Name())
 			if !current.ReservedRanges().Has(prevField.Number()) {
-				diffs.add(fieldDeleteWithoutReservedNumber, prev, `field "%s" with number "%d" is not marked as reserved after the delete`, prevField.Name(), prevField.Number())
+				diffs.add(fieldDeleteWithoutReservedNumber, prevField, prevField.Number(), nil, `field "%s" with number "%d" is not marked as reserved after the delete`, prevField.Name(), prevField.Number())
 			}
 			if !current.ReservedNames().Has(prevField.Name()) {
-				diffs.add(fieldDeleteWithoutReservedName, prev, `field "%s" not marked as reserved after the delete`, prevField.Name())
+				diffs.add(fieldDeleteWithoutReservedName, prevField, prevField.Name(), nil, `field "%s" not marked as reserved after the delete`, prevField.Name())
 			}
 			continue
 		}
 		compareFields(currentField, prevField, diffs)
 	}
+	compareOptions(current, prev, diffs)
+	currentFields := current.Fields()
+	for i := 0; i < currentFields.Len(); i++ {
+		currentField := currentFields.Get(i)
+		if prev.Fields().ByNumber(currentField.Number()) == nil {
+			diffs.add(fieldAdd, currentField, nil, currentField.Name(), `field "%s" with number "%d" is added`, currentField.Name(), currentField.Number())
+		}
+	}
+	currentRanges := current.ReservedRanges()
+	for i := 0; i < currentRanges.Len(); i++ {
+		start, end := currentRanges.Get(i)[0], currentRanges.Get(i)[1]
+		if !(prevRanges.Has(start) && prevRanges.Has(end-1)) {
+			diffs.add(reservedRangeAdd, current, nil, reservedRange(int64(start), int64(end-1)), "reserved range (%d, %d) is added", start, end)
+		}
+	}
+	compareReservedNames(current, prev, current.ReservedNames(), prevNames, diffs)
+}
+
+// compareReservedNames records reserved names added in current element
+func compareReservedNames(current, prev protoreflect.Descriptor, currentNames, prevNames protoreflect.Names, diffs diffRecorder) {
+	for i := 0; i < currentNames.Len(); i++ {
+		name := currentNames.Get(i)
+		if !prevNames.Has(name) {
+			diffs.add(reservedNameAdd, current, nil, name, `reserved name "%s" is added to "%s"`, name, prev.FullName())
+		}
+	}
 }
 
-func compareFields(currentField, prevField protoreflect.FieldDescriptor, diffs *compatibilityErr) {
+func compareFields(currentField, prevField protoreflect.FieldDescriptor, diffs diffRecorder) {
 	if prevField.JSONName() != currentField.JSONName() {
-		diffs.add(fieldNameChange, prevField, `JSON field name changed from "%s" to "%s"`, prevField.JSONName(), currentField.JSONName())
+		diffs.add(fieldNameChange, prevField, prevField.JSONName(), currentField.JSONName(), `JSON field name changed from "%s" to "%s"`, prevField.JSONName(), currentField.JSONName())
 	}
 	name := prevField.Name()
 	if prevField.Cardinality().IsValid() && prevField.Cardinality().String() != currentField.Cardinality().String() {
-		diffs.add(fieldLabelchange, prevField, `field "%s" label changed from "%s" to "%s"`, name, prevField.Cardinality().String(), currentField.Cardinality().String())
+		diffs.add(fieldLabelchange, prevField, prevField.Cardinality(), currentField.Cardinality(), `field "%s" label changed from "%s" to "%s"`, name, prevField.Cardinality().String(), currentField.Cardinality().String())
 	}
 	if prevField.Kind() != currentField.Kind() {
-		diffs.add(fieldKindChange, prevField, `field "%s" kind changed from "%s" to "%s"`, name, prevField.Kind().String(), currentField.Kind().String())
+		diffs.add(fieldKindChange, prevField, prevField.Kind(), currentField.Kind(), `field "%s" kind changed from "%s" to "%s"`, name, prevField.Kind().String(), currentField.Kind().String())
 	} else {
 		if prevField.Kind() == protoreflect.MessageKind && prevField.Message().FullName() != currentField.Message().FullName() {
-			diffs.add(fieldTypeChange, prevField, `field "%s" type changed from "%s" to "%s"`, name, prevField.Message().FullName(), currentField.Message().FullName())
+			diffs.add(fieldTypeChange, prevField, prevField.Message().FullName(), currentField.Message().FullName(), `field "%s" type changed from "%s" to "%s"`, name, prevField.Message().FullName(), currentField.Message().FullName())
 		}
 		if prevField.Kind() == protoreflect.EnumKind && prevField.Enum().FullName() != currentField.Enum().FullName() {
-			diffs.add(fieldTypeChange, prevField, `field "%s" type changed from "%s" to "%s"`, name, prevField.Enum().FullName(), currentField.Enum().FullName())
+			diffs.add(fieldTypeChange, prevField, prevField.Enum().FullName(), currentField.Enum().FullName(), `field "%s" type changed from "%s" to "%s"`, name, prevField.Enum().FullName(), currentField.Enum().FullName())
 		}
 	}
+	compareOptions(currentField, prevField, diffs)
 }
 
-func compareEnums(current, prev protoreflect.EnumDescriptor, diffs *compatibilityErr) {
+func compareEnums(current, prev protoreflect.EnumDescriptor, diffs diffRecorder) {
 	// check reserved numbers
 	prevRanges := prev.ReservedRanges()
 	for i := 0; i < prevRanges.Len(); i++ {
@@ -174,9 +327,9 @@ func compareEnums(current, prev protoreflect.EnumDescriptor, diffs *compatibilit
 		start, end := prevRange[0], prevRange[1]
 		if !(current.ReservedRanges().Has(start) && current.ReservedRanges().Has(end)) {
 			if start == end {
-				diffs.add(nonInclusivereservedRange, current, "previous reserved number (%d) is not inclusive of current range", start)
+				diffs.add(nonInclusivereservedRange, current, reservedRange(int64(start), int64(end)), nil, "previous reserved number (%d) is not inclusive of current range", start)
 			} else {
-				diffs.add(nonInclusivereservedRange, current, "previous reserved range (%d, %d) is not inclusive of current range", start, end)
+				diffs.add(nonInclusivereservedRange, current, reservedRange(int64(start), int64(end)), nil, "previous reserved range (%d, %d) is not inclusive of current range", start, end)
 			}
 		}
 	}
@@ -185,7 +338,7 @@ func compareEnums(current, prev protoreflect.EnumDescriptor, diffs *compatibilit
 	for i := 0; i < prevNames.Len(); i++ {
 		prevName := prevNames.Get(i)
 		if !(current.ReservedNames().Has(prevName)) {
-			diffs.add(nonInclusiceReservedNames, current, `previous reserved name "%s" is removed`, prevName)
+			diffs.add(nonInclusiceReservedNames, current, prevName, nil, `previous reserved name "%s" is removed`, prevName)
 		}
 	}
 	// check enum values
@@ -194,23 +347,47 @@ func compareEnums(current, prev protoreflect.EnumDescriptor, diffs *compatibilit
 		prevValue := prevValues.Get(i)
 		currentValue := current.Values().ByName(prevValue.Name())
 		if currentValue == nil {
-			diffs.add(enumValueDelete, prev, `enum value "%s" with number "%d" is deleted from "%s"`, prevValue.Name(), prevValue.Number(), prev.FullName())
+			diffs.add(enumValueDelete, prevValue, prevValue.Name(), nil, `enum value "%s" with number "%d" is deleted from "%s"`, prevValue.Name(), prevValue.Number(), prev.FullName())
 			if !current.ReservedRanges().Has(prevValue.Number()) {
-				diffs.add(enumValueDeleteWithoutReservedNumber, prev, `enum value "%s" with number "%d" is not marked as reserved after the delete`, prevValue.Name(), prevValue.Number())
+				diffs.add(enumValueDeleteWithoutReservedNumber, prevValue, prevValue.Number(), nil, `enum value "%s" with number "%d" is not marked as reserved after the delete`, prevValue.Name(), prevValue.Number())
 			}
 			if !current.ReservedNames().Has(prevValue.Name()) {
-				diffs.add(enumValueDeleteWithoutReservedName, prev, `enum value "%s" name not marked as reserved after the delete`, prevValue.Name())
+				diffs.add(enumValueDeleteWithoutReservedName, prevValue, prevValue.Name(), nil, `enum value "%s" name not marked as reserved after the delete`, prevValue.Name())
 			}
 			continue
 		}
 		if prevValue.Number() != currentValue.Number() {
-			diffs.add(enumValueNumberChange, prev, `enum value number for "%s" changed from "%d" to "%d"`, prevValue.FullName(), prevValue.Number(), currentValue.Number())
+			diffs.add(enumValueNumberChange, prevValue, prevValue.Number(), currentValue.Number(), `enum value number for "%s" changed from "%d" to "%d"`, prevValue.FullName(), prevValue.Number(), currentValue.Number())
 		}
+		compareOptions(currentValue, prevValue, diffs)
 	}
+	currentValues := current.Values()
+	for i := 0; i < currentValues.Len(); i++ {
+		currentValue := currentValues.Get(i)
+		if prevValues.ByName(currentValue.Name()) == nil {
+			diffs.add(enumValueAdd, currentValue, nil, currentValue.Number(), `enum value "%s" with number "%d" is added to "%s"`, currentValue.Name(), currentValue.Number(), current.FullName())
+		}
+	}
+	currentRanges := current.ReservedRanges()
+	for i := 0; i < currentRanges.Len(); i++ {
+		start, end := currentRanges.Get(i)[0], currentRanges.Get(i)[1]
+		if !(prevRanges.Has(start) && prevRanges.Has(end)) {
+			diffs.add(reservedRangeAdd, current, nil, reservedRange(int64(start), int64(end)), "reserved range (%d, %d) is added", start, end)
+		}
+	}
+	compareReservedNames(current, prev, current.ReservedNames(), prevNames, diffs)
+	compareOptions(current, prev, diffs)
 }
 
-func compareSyntax(current, prev protoreflect.Descriptor, diffs *compatibilityErr) {
+func compareSyntax(current, prev protoreflect.Descriptor, diffs diffRecorder) {
 	if current.ParentFile().Syntax() != prev.Parent().Syntax() {
-		diffs.add(syntaxChange, current, `syntax changed from "%s" to "%s"`, prev.ParentFile().Syntax(), current.ParentFile().Syntax())
+		diffs.add(syntaxChange, current.ParentFile(), prev.ParentFile().Syntax(), current.ParentFile().Syntax(), `syntax changed from "%s" to "%s"`, prev.ParentFile().Syntax(), current.ParentFile().Syntax())
+	}
+}
+
+func reservedRange(start, end int64) string {
+	if start == end {
+		return fmt.Sprint(start)
 	}
+	return fmt.Sprintf("%d-%d", start, end)
 }
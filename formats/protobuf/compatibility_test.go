@@ -56,6 +56,28 @@ func TestCompatibility(t *testing.T) {
 			`2.proto: syntax changed from "proto2" to "proto3"`,
 		}, errMsgs)
 	})
+	t.Run("backwardCompatibility reports structured violations", func(t *testing.T) {
+		current, prev := getCompatibilityData(t, "backward")
+		err := current.IsBackwardCompatible(prev)
+		var reporter schema.ViolationReporter
+		assert.ErrorAs(t, err, &reporter)
+		violations := reporter.Violations()
+		assert.Len(t, violations, 18)
+		assert.Contains(t, violations, schema.Violation{
+			Kind:     "fieldKindChange",
+			Path:     "a.BreakingMessage.kind_change",
+			Message:  `1.proto: field "kind_change" kind changed from "string" to "int64"`,
+			OldValue: "string",
+			NewValue: "int64",
+		})
+		assert.Contains(t, violations, schema.Violation{
+			Kind:     "syntaxChange",
+			Path:     "2.proto",
+			Message:  `2.proto: syntax changed from "proto2" to "proto3"`,
+			OldValue: "proto2",
+			NewValue: "proto3",
+		})
+	})
 	t.Run("backwardCompatibility return error if format does not match", func(t *testing.T) {
 		current, _ := getCompatibilityData(t, "backward")
 		otherSchema := &mocks.ParsedSchema{}
@@ -0,0 +1,119 @@
+package protobuf
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// compileErr has errors reported by compiler, each prefixed with file:line:column position
+type compileErr struct {
+	errs []string
+}
+
+func (c *compileErr) Error() string {
+	return "compile failed: " + strings.Join(c.errs, "; ")
+}
+
+func (c *compileErr) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, c.Error())
+}
+
+// sourceFiles returns .proto files under importRoot keyed by their path relative to importRoot
+func sourceFiles(files map[string][]byte, importRoot string) map[string]string {
+	root := strings.Trim(path.Clean("/"+importRoot), "/")
+	sources := map[string]string{}
+	for name, content := range files {
+		if path.Ext(name) != ".proto" {
+			continue
+		}
+		if root != "" {
+			if !strings.HasPrefix(name, root+"/") {
+				continue
+			}
+			name = strings.TrimPrefix(name, root+"/")
+		}
+		sources[name] = string(content)
+	}
+	return sources
+}
+
+// Compile compiles .proto files under importRoot into descriptor set, like protoc with --include_imports option.
+// Imports are resolved from given files first, then from dependencies and finally from well-known google/protobuf files.
+// Files resolved from dependencies are left out of returned descriptor set, since they are served by referenced schemas.
+func Compile(files map[string][]byte, importRoot string, dependencies ...[]byte) ([]byte, error) {
+	sources := sourceFiles(files, importRoot)
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no .proto files found under import root %q", importRoot)
+	}
+	depFiles := map[string]*descriptorpb.FileDescriptorProto{}
+	for _, dep := range dependencies {
+		depSet := &descriptorpb.FileDescriptorSet{}
+		if err := proto.Unmarshal(dep, depSet); err != nil {
+			return nil, fmt.Errorf("referenced descriptor set file is not valid. %w", err)
+		}
+		for _, f := range depSet.File {
+			depFiles[f.GetName()] = f
+		}
+	}
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []string
+	parser := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(sources),
+		LookupImportProto: func(name string) (*descriptorpb.FileDescriptorProto, error) {
+			if f, ok := depFiles[name]; ok {
+				return f, nil
+			}
+			return nil, fmt.Errorf("%s: file not found", name)
+		},
+		ErrorReporter: func(err protoparse.ErrorWithPos) error {
+			errs = append(errs, err.Error())
+			return nil
+		},
+	}
+	fds, err := parser.ParseFiles(names...)
+	if len(errs) > 0 {
+		return nil, &compileErr{errs: errs}
+	}
+	if err != nil {
+		return nil, &compileErr{errs: []string{err.Error()}}
+	}
+
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(fd *desc.FileDescriptor)
+	add = func(fd *desc.FileDescriptor) {
+		if seen[fd.GetName()] {
+			return
+		}
+		seen[fd.GetName()] = true
+		for _, dep := range fd.GetDependencies() {
+			add(dep)
+		}
+		if _, own := sources[fd.GetName()]; !own && depFiles[fd.GetName()] != nil {
+			return
+		}
+		set.File = append(set.File, fd.AsFileDescriptorProto())
+	}
+	for _, fd := range fds {
+		add(fd)
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(set)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal compiled descriptor set. %w", err)
+	}
+	return data, nil
+}
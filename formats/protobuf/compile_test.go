@@ -0,0 +1,66 @@
+package protobuf_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/raystack/stencil/formats/protobuf"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func fileNames(t *testing.T, data []byte) []string {
+	t.Helper()
+	fds := &descriptorpb.FileDescriptorSet{}
+	assert.NoError(t, proto.Unmarshal(data, fds))
+	var names []string
+	for _, f := range fds.File {
+		names = append(names, f.GetName())
+	}
+	return names
+}
+
+func TestCompile(t *testing.T) {
+	valid, err := os.ReadFile("./testdata/valid/1.proto")
+	assert.NoError(t, err)
+	t.Run("should compile files under import root along with well-known imports", func(t *testing.T) {
+		files := map[string][]byte{
+			"proto/1.proto": valid,
+			"README.md":     []byte("readme"),
+			"other/2.proto": []byte("invalid"),
+		}
+		data, err := protobuf.Compile(files, "proto")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"google/protobuf/duration.proto", "1.proto"}, fileNames(t, data))
+		sc, err := protobuf.GetParsedSchema(data)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"google.protobuf.Duration", "a.Test"}, sc.GetCanonicalValue().Types)
+	})
+	t.Run("should return error if there are no files under import root", func(t *testing.T) {
+		_, err := protobuf.Compile(map[string][]byte{"proto/1.proto": valid}, "src")
+		assert.Error(t, err)
+	})
+	t.Run("should return compile errors with positions", func(t *testing.T) {
+		files := map[string][]byte{
+			"a.proto": []byte("syntax = \"proto3\";\nimport \"missing.proto\";\nmessage A {}\n"),
+		}
+		_, err := protobuf.Compile(files, "")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "a.proto:2:8")
+	})
+	t.Run("should resolve imports from dependencies and leave them out", func(t *testing.T) {
+		dependency := getDescriptorData(t, "./testdata/valid", true)
+		files := map[string][]byte{
+			"b.proto": []byte("syntax = \"proto3\";\npackage b;\nimport \"1.proto\";\nmessage B {\n  a.Test test = 1;\n}\n"),
+		}
+		data, err := protobuf.Compile(files, "", dependency)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"b.proto"}, fileNames(t, data))
+		sc, err := protobuf.GetParsedSchema(data, dependency)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"b.B"}, sc.GetCanonicalValue().Types)
+	})
+}
@@ -0,0 +1,88 @@
+package protobuf
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/raystack/stencil/core/schema"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// compatibilityModes classifies changes listed by diff
+var compatibilityModes = schema.CompatibilityModes[diffKind]{
+	Backward: backwardCompatibility,
+	Forward:  forwardCompatibility,
+	Full:     fullCompatibility,
+}
+
+// Diff returns changes made in the schema since given earlier schema, across messages, fields, enums, services, options and reserved ranges.
+func (s *Schema) Diff(against schema.ParsedSchema) ([]schema.Change, error) {
+	prev, ok := against.(*Schema)
+	if !ok {
+		return nil, errors.New("different schema formats")
+	}
+	changes := schema.NewChangeSet(compatibilityModes)
+	walkSchemas(s.Files, prev.Files, diffRecorder{changes})
+	return changes.Changes(), nil
+}
+
+func methodSignature(md protoreflect.MethodDescriptor) string {
+	input, output := string(md.Input().FullName()), string(md.Output().FullName())
+	if md.IsStreamingClient() {
+		input = "stream " + input
+	}
+	if md.IsStreamingServer() {
+		output = "stream " + output
+	}
+	return fmt.Sprintf("(%s) returns (%s)", input, output)
+}
+
+// optionsString returns options set on the element in name=value form sorted by name, empty if there are none
+func optionsString(desc protoreflect.Descriptor) string {
+	opts := desc.Options()
+	if opts == nil {
+		return ""
+	}
+	return messageString(opts.ProtoReflect())
+}
+
+func messageString(m protoreflect.Message) string {
+	var parts []string
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := string(fd.Name())
+		if fd.IsExtension() {
+			name = fmt.Sprintf("(%s)", fd.FullName())
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", name, fieldValueString(fd, v)))
+		return true
+	})
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func fieldValueString(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if fd.IsList() {
+		list := v.List()
+		values := make([]string, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			values = append(values, singularValueString(fd, list.Get(i)))
+		}
+		return "[" + strings.Join(values, ",") + "]"
+	}
+	return singularValueString(fd, v)
+}
+
+func singularValueString(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if value := fd.Enum().Values().ByNumber(v.Enum()); value != nil {
+			return string(value.Name())
+		}
+		return fmt.Sprint(v.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return "{" + messageString(v.Message()) + "}"
+	}
+	return fmt.Sprint(v.Interface())
+}
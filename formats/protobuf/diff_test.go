@@ -0,0 +1,112 @@
+package protobuf_test
+
+import (
+	"testing"
+
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/formats/formattest"
+	"github.com/raystack/stencil/formats/protobuf"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseSource(t *testing.T, files map[string]string) schema.ParsedSchema {
+	t.Helper()
+	sources := map[string][]byte{}
+	for name, content := range files {
+		sources[name] = []byte(content)
+	}
+	data, err := protobuf.Compile(sources, "")
+	assert.NoError(t, err)
+	sc, err := protobuf.GetParsedSchema(data)
+	assert.NoError(t, err)
+	return sc
+}
+
+func TestDiff(t *testing.T) {
+	prev := parseSource(t, map[string]string{
+		"a.proto": `syntax = "proto3";
+package a;
+option java_package = "com.a";
+message Test {
+  reserved 10;
+  string name = 1;
+  int64 count = 2;
+  string old = 3;
+}
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_OLD = 1;
+}
+service TestService {
+  rpc Get(Test) returns (Test);
+  rpc Remove(Test) returns (Test);
+}
+`,
+		"b.proto": `syntax = "proto3";
+package b;
+message Gone {}
+`,
+	})
+	current := parseSource(t, map[string]string{
+		"a.proto": `syntax = "proto3";
+package a;
+option java_package = "com.a.v2";
+message Test {
+  reserved 3, 10;
+  reserved "old";
+  string name = 1 [deprecated = true];
+  string count = 2;
+  bool active = 4;
+}
+message Added {}
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_NEW = 2;
+}
+service TestService {
+  rpc Get(Test) returns (stream Test);
+  rpc List(Test) returns (Test);
+}
+`,
+	})
+	changes, err := current.(schema.Differ).Diff(prev)
+	assert.NoError(t, err)
+
+	for _, test := range []struct {
+		kind        string
+		path        string
+		breakingFor []string
+	}{
+		{"fieldAdd", "a.Test.active", nil},
+		{"fieldDelete", "a.Test.old", []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FULL"}},
+		{"fieldKindChange", "a.Test.count", []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}},
+		{"reservedRangeAdd", "a.Test", nil},
+		{"reservedNameAdd", "a.Test", nil},
+		{"optionChange", "a.Test.name", nil},
+		{"optionChange", "a.proto", nil},
+		{"messageAdd", "a.Added", nil},
+		{"messageDelete", "b.Gone", []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}},
+		{"fileDelete", "b.proto", nil},
+		{"enumValueAdd", "a.STATUS_NEW", nil},
+		{"enumValueDelete", "a.STATUS_OLD", []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FULL"}},
+		{"methodAdd", "a.TestService.List", nil},
+		{"methodDelete", "a.TestService.Remove", nil},
+		{"methodSignatureChange", "a.TestService.Get", nil},
+	} {
+		change := formattest.FindChange(changes, test.kind, test.path)
+		if assert.NotNil(t, change, "%s %s", test.kind, test.path) {
+			assert.Equal(t, test.breakingFor, change.BreakingFor, "%s %s", test.kind, test.path)
+		}
+	}
+	assert.Equal(t, `a.proto: field "count" kind changed from "int64" to "string"`, formattest.FindChange(changes, "fieldKindChange", "a.Test.count").Message)
+	assert.Equal(t, "(a.Test) returns (a.Test)", formattest.FindChange(changes, "methodSignatureChange", "a.TestService.Get").OldValue)
+	assert.Equal(t, "(a.Test) returns (stream a.Test)", formattest.FindChange(changes, "methodSignatureChange", "a.TestService.Get").NewValue)
+	assert.Equal(t, `java_package=com.a.v2`, formattest.FindChange(changes, "optionChange", "a.proto").NewValue)
+	assert.Nil(t, formattest.FindChange(changes, "fieldAdd", "a.Test.name"))
+
+	t.Run("should return no changes for same schema", func(t *testing.T) {
+		changes, err := current.(schema.Differ).Diff(current)
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+}
@@ -2,43 +2,36 @@ package protobuf
 
 import (
 	"fmt"
-	"strings"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"github.com/raystack/stencil/core/schema"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-type diff struct {
-	kind diffKind
-	msg  string
-}
-
 type compatibilityErr struct {
-	notAllowed []diffKind
-	diffs      []diff
+	schema.ViolationCollector[diffKind]
 }
 
-func (c *compatibilityErr) add(kind diffKind, desc protoreflect.Descriptor, format string, args ...interface{}) {
-	msg := fmt.Sprintf(format, args...)
-	path := desc.ParentFile().Path()
-	if kind.contains(c.notAllowed) && msg != "" {
-		c.diffs = append(c.diffs, diff{kind: kind, msg: fmt.Sprintf("%s: %s", path, msg)})
-	}
+// diffRecorder formats changes found while walking schemas for a compatibility error or a change set,
+// changes are reported against the element and messages are prefixed with path of file having the element.
+type diffRecorder struct {
+	schema.DiffRecorder[diffKind]
 }
 
-func (c *compatibilityErr) isEmpty() bool {
-	return len(c.diffs) == 0
+func (r diffRecorder) add(kind diffKind, desc protoreflect.Descriptor, oldValue, newValue interface{}, format string, args ...interface{}) {
+	msg := fmt.Sprintf("%s: %s", desc.ParentFile().Path(), fmt.Sprintf(format, args...))
+	r.Add(kind, elementPath(desc), msg, valueString(oldValue), valueString(newValue))
 }
 
-func (c *compatibilityErr) Error() string {
-	var msgs []string
-	for _, val := range c.diffs {
-		msgs = append(msgs, val.msg)
+func elementPath(desc protoreflect.Descriptor) string {
+	if fd, ok := desc.(protoreflect.FileDescriptor); ok {
+		return fd.Path()
 	}
-	return strings.Join(msgs, ";")
+	return string(desc.FullName())
 }
 
-func (c *compatibilityErr) GRPCStatus() *status.Status {
-	return status.New(codes.InvalidArgument, c.Error())
+func valueString(val interface{}) string {
+	if val == nil {
+		return ""
+	}
+	return fmt.Sprint(val)
 }
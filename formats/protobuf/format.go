@@ -0,0 +1,67 @@
+package protobuf
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoprint"
+	"github.com/raystack/stencil/core/schema"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Format is protobuf schema format plugin, schema data is a descriptor set
+type Format struct{}
+
+func (Format) Name() string {
+	return protobufFormat
+}
+
+func (Format) ContentType() string {
+	return "application/octet-stream"
+}
+
+func (Format) ParseSchema(data []byte) (schema.ParsedSchema, error) {
+	return GetParsedSchema(data)
+}
+
+func (Format) ParseSchemaWithReferences(data []byte, dependencies ...[]byte) (schema.ParsedSchema, error) {
+	return GetParsedSchema(data, dependencies...)
+}
+
+func (Format) CompileSchema(files map[string][]byte, importRoot string, dependencies ...[]byte) ([]byte, error) {
+	return Compile(files, importRoot, dependencies...)
+}
+
+// PrintSchema prints .proto source of files in descriptor set having path prefixed by filter
+func (Format) PrintSchema(data []byte, filter string) (string, string, error) {
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, fds); err != nil {
+		return "", "", fmt.Errorf("descriptor set file is not valid. %w", err)
+	}
+	fdsMap, err := desc.CreateFileDescriptorsFromSet(fds)
+	if err != nil {
+		return "", "", err
+	}
+	var names []string
+	for fdName := range fdsMap {
+		if filter != "" && !strings.HasPrefix(fdName, filter) {
+			continue
+		}
+		names = append(names, fdName)
+	}
+	sort.Strings(names)
+
+	protoPrinter := &protoprint.Printer{}
+	var source string
+	for _, name := range names {
+		protoAsString, err := protoPrinter.PrintProtoToString(fdsMap[name])
+		if err != nil {
+			return "", "", err
+		}
+		source = source + fmt.Sprintf("\n//Schema file:: %s\n\n%s", name, protoAsString)
+	}
+	return source, "Protocol Buffer", nil
+}
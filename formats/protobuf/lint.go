@@ -0,0 +1,186 @@
+package protobuf
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/schema"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// lint rules
+const (
+	packageDefined           = "PACKAGE_DEFINED"
+	packageLowerSnakeCase    = "PACKAGE_LOWER_SNAKE_CASE"
+	messagePascalCase        = "MESSAGE_PASCAL_CASE"
+	fieldLowerSnakeCase      = "FIELD_LOWER_SNAKE_CASE"
+	enumPascalCase           = "ENUM_PASCAL_CASE"
+	enumValueUpperSnakeCase  = "ENUM_VALUE_UPPER_SNAKE_CASE"
+	servicePascalCase        = "SERVICE_PASCAL_CASE"
+	rpcPascalCase            = "RPC_PASCAL_CASE"
+	enumZeroValueUnspecified = "ENUM_ZERO_VALUE_UNSPECIFIED"
+	enumValuePrefix          = "ENUM_VALUE_PREFIX"
+	javaPackageDefined       = "JAVA_PACKAGE_DEFINED"
+)
+
+var (
+	minimalLintRules = []string{packageDefined}
+	basicLintRules   = append(append([]string{}, minimalLintRules...), packageLowerSnakeCase, messagePascalCase, fieldLowerSnakeCase,
+		enumPascalCase, enumValueUpperSnakeCase, servicePascalCase, rpcPascalCase)
+	defaultLintRules = append(append([]string{}, basicLintRules...), enumZeroValueUnspecified, enumValuePrefix, javaPackageDefined)
+
+	lintRuleSets = map[string][]string{
+		namespace.LintRuleSetMinimal: minimalLintRules,
+		namespace.LintRuleSetBasic:   basicLintRules,
+		namespace.LintRuleSetDefault: defaultLintRules,
+	}
+
+	lowerSnakeCase = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+	upperSnakeCase = regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`)
+	pascalCase     = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+)
+
+// lintRuleSeverities returns severity of each enabled rule, rules of the rule set have error severity unless overridden
+func lintRuleSeverities(ruleSet string, rules map[string]string) map[string]string {
+	severities := map[string]string{}
+	for _, rule := range lintRuleSets[ruleSet] {
+		severities[rule] = namespace.LintSeverityError
+	}
+	for rule, severity := range rules {
+		if severity == namespace.LintSeverityOff {
+			delete(severities, rule)
+			continue
+		}
+		severities[rule] = severity
+	}
+	return severities
+}
+
+type linter struct {
+	severities map[string]string
+	issues     []schema.LintIssue
+}
+
+func (l *linter) report(rule string, desc protoreflect.Descriptor, format string, args ...interface{}) {
+	severity, ok := l.severities[rule]
+	if !ok {
+		return
+	}
+	l.issues = append(l.issues, schema.LintIssue{
+		Rule:     rule,
+		Severity: severity,
+		Path:     elementPath(desc),
+		Message:  fmt.Sprintf("%s: %s", desc.ParentFile().Path(), fmt.Sprintf(format, args...)),
+	})
+}
+
+func (l *linter) lintFile(fd protoreflect.FileDescriptor) {
+	if fd.Package() == "" {
+		l.report(packageDefined, fd, "package is not defined")
+	} else {
+		for _, part := range strings.Split(string(fd.Package()), ".") {
+			if !lowerSnakeCase.MatchString(part) {
+				l.report(packageLowerSnakeCase, fd, "package %q should be lower_snake_case", fd.Package())
+				break
+			}
+		}
+	}
+	if opts, ok := fd.Options().(*descriptorpb.FileOptions); !ok || opts.GetJavaPackage() == "" {
+		l.report(javaPackageDefined, fd, "java_package option is not defined")
+	}
+	l.lintMessages(fd.Messages())
+	l.lintEnums(fd.Enums())
+	for i := 0; i < fd.Services().Len(); i++ {
+		sd := fd.Services().Get(i)
+		if !pascalCase.MatchString(string(sd.Name())) {
+			l.report(servicePascalCase, sd, "service name %q should be PascalCase", sd.Name())
+		}
+		for j := 0; j < sd.Methods().Len(); j++ {
+			md := sd.Methods().Get(j)
+			if !pascalCase.MatchString(string(md.Name())) {
+				l.report(rpcPascalCase, md, "rpc name %q should be PascalCase", md.Name())
+			}
+		}
+	}
+}
+
+func (l *linter) lintMessages(messages protoreflect.MessageDescriptors) {
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		if md.IsMapEntry() {
+			continue
+		}
+		if !pascalCase.MatchString(string(md.Name())) {
+			l.report(messagePascalCase, md, "message name %q should be PascalCase", md.Name())
+		}
+		for j := 0; j < md.Fields().Len(); j++ {
+			field := md.Fields().Get(j)
+			if !lowerSnakeCase.MatchString(string(field.Name())) {
+				l.report(fieldLowerSnakeCase, field, "field name %q should be lower_snake_case", field.Name())
+			}
+		}
+		l.lintMessages(md.Messages())
+		l.lintEnums(md.Enums())
+	}
+}
+
+func (l *linter) lintEnums(enums protoreflect.EnumDescriptors) {
+	for i := 0; i < enums.Len(); i++ {
+		ed := enums.Get(i)
+		if !pascalCase.MatchString(string(ed.Name())) {
+			l.report(enumPascalCase, ed, "enum name %q should be PascalCase", ed.Name())
+		}
+		prefix := toUpperSnakeCase(string(ed.Name())) + "_"
+		zero := ed.Values().ByNumber(0)
+		if zero == nil || !strings.HasSuffix(string(zero.Name()), "_UNSPECIFIED") {
+			l.report(enumZeroValueUnspecified, ed, "enum %q should have zero value named %sUNSPECIFIED", ed.Name(), prefix)
+		}
+		for j := 0; j < ed.Values().Len(); j++ {
+			value := ed.Values().Get(j)
+			if !upperSnakeCase.MatchString(string(value.Name())) {
+				l.report(enumValueUpperSnakeCase, value, "enum value name %q should be UPPER_SNAKE_CASE", value.Name())
+			}
+			if !strings.HasPrefix(string(value.Name()), prefix) {
+				l.report(enumValuePrefix, value, "enum value name %q should be prefixed with %q", value.Name(), prefix)
+			}
+		}
+	}
+}
+
+func toUpperSnakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			prev := name[i-1]
+			if prev >= 'a' && prev <= 'z' || prev >= '0' && prev <= '9' {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToUpper(b.String())
+}
+
+// Lint runs enabled lint rules on files of the schema, well-known google/protobuf files are skipped
+func (s *Schema) Lint(ruleSet string, rules map[string]string) []schema.LintIssue {
+	l := &linter{severities: lintRuleSeverities(ruleSet, rules)}
+	if len(l.severities) == 0 {
+		return nil
+	}
+	var files []protoreflect.FileDescriptor
+	s.own.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if !strings.HasPrefix(fd.Path(), "google/protobuf/") {
+			files = append(files, fd)
+		}
+		return true
+	})
+	sort.Slice(files, func(i, j int) bool { return files[i].Path() < files[j].Path() })
+	for _, fd := range files {
+		l.lintFile(fd)
+	}
+	return l.issues
+}
@@ -0,0 +1,91 @@
+package protobuf_test
+
+import (
+	"testing"
+
+	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/formats/protobuf"
+	"github.com/stretchr/testify/assert"
+)
+
+func lintSource(t *testing.T, source, ruleSet string, rules map[string]string) []schema.LintIssue {
+	t.Helper()
+	data, err := protobuf.Compile(map[string][]byte{"a.proto": []byte(source)}, "")
+	assert.NoError(t, err)
+	sc, err := protobuf.GetParsedSchema(data)
+	assert.NoError(t, err)
+	linter, ok := sc.(schema.Linter)
+	assert.True(t, ok)
+	return linter.Lint(ruleSet, rules)
+}
+
+func lintRules(issues []schema.LintIssue) []string {
+	var rules []string
+	for _, issue := range issues {
+		rules = append(rules, issue.Rule)
+	}
+	return rules
+}
+
+func TestLint(t *testing.T) {
+	clean := `syntax = "proto3";
+package a.b;
+option java_package = "com.a.b";
+import "google/protobuf/duration.proto";
+message TestMessage {
+  string field_name = 1;
+  map<string, string> labels = 2;
+  google.protobuf.Duration timeout = 3;
+}
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_ACTIVE = 1;
+}
+service TestService {
+  rpc GetTest(TestMessage) returns (TestMessage);
+}
+`
+	bad := `syntax = "proto3";
+message test_message {
+  string fieldName = 1;
+}
+enum Status {
+  ACTIVE = 0;
+}
+`
+	t.Run("should return no issues for schema following rules", func(t *testing.T) {
+		assert.Empty(t, lintSource(t, clean, namespace.LintRuleSetDefault, nil))
+	})
+	t.Run("should return no issues if no rules are enabled", func(t *testing.T) {
+		assert.Empty(t, lintSource(t, bad, "", nil))
+	})
+	t.Run("should run only rules of the rule set", func(t *testing.T) {
+		issues := lintSource(t, bad, namespace.LintRuleSetMinimal, nil)
+		assert.Equal(t, []schema.LintIssue{{
+			Rule:     "PACKAGE_DEFINED",
+			Severity: namespace.LintSeverityError,
+			Path:     "a.proto",
+			Message:  "a.proto: package is not defined",
+		}}, issues)
+	})
+	t.Run("should report failed rules of default rule set", func(t *testing.T) {
+		issues := lintSource(t, bad, namespace.LintRuleSetDefault, nil)
+		assert.ElementsMatch(t, []string{
+			"PACKAGE_DEFINED", "JAVA_PACKAGE_DEFINED", "MESSAGE_PASCAL_CASE", "FIELD_LOWER_SNAKE_CASE",
+			"ENUM_ZERO_VALUE_UNSPECIFIED", "ENUM_VALUE_PREFIX",
+		}, lintRules(issues))
+	})
+	t.Run("should apply severity overrides of rules", func(t *testing.T) {
+		issues := lintSource(t, bad, namespace.LintRuleSetMinimal, map[string]string{
+			"PACKAGE_DEFINED":        namespace.LintSeverityOff,
+			"FIELD_LOWER_SNAKE_CASE": namespace.LintSeverityWarning,
+		})
+		assert.Equal(t, []schema.LintIssue{{
+			Rule:     "FIELD_LOWER_SNAKE_CASE",
+			Severity: namespace.LintSeverityWarning,
+			Path:     "test_message.fieldName",
+			Message:  `a.proto: field name "fieldName" should be lower_snake_case`,
+		}}, issues)
+	})
+}
@@ -10,21 +10,69 @@ import (
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
-func getRegistry(fds *descriptorpb.FileDescriptorSet, data []byte) (*protoregistry.Files, error) {
-	if err := proto.Unmarshal(data, fds); err != nil {
-		return nil, fmt.Errorf("descriptor set file is not valid. %w", err)
-	}
+func getRegistry(fds *descriptorpb.FileDescriptorSet) (*protoregistry.Files, error) {
 	files, err := protodesc.NewFiles(fds)
 	if err != nil {
-		return files, fmt.Errorf("file is not fully contained descriptor file. hint: generate file descriptorset with --include_imports option. %w", err)
+		return files, fmt.Errorf("file is not fully contained descriptor file. hint: generate file descriptorset with --include_imports option or reference schemas providing missing imports. %w", err)
 	}
 	return files, err
 }
 
-// GetParsedSchema converts data into enriched data type to deal with protobuf schema
-func GetParsedSchema(data []byte) (schema.ParsedSchema, error) {
+// withDependencies returns descriptor set having files of dependencies followed by files of fds.
+// Files of fds take precedence over dependency files with same name.
+func withDependencies(fds *descriptorpb.FileDescriptorSet, dependencies [][]byte) (*descriptorpb.FileDescriptorSet, error) {
+	if len(dependencies) == 0 {
+		return fds, nil
+	}
+	seen := map[string]bool{}
+	for _, f := range fds.File {
+		seen[f.GetName()] = true
+	}
+	all := &descriptorpb.FileDescriptorSet{}
+	for _, dep := range dependencies {
+		depSet := &descriptorpb.FileDescriptorSet{}
+		if err := proto.Unmarshal(dep, depSet); err != nil {
+			return nil, fmt.Errorf("referenced descriptor set file is not valid. %w", err)
+		}
+		for _, f := range depSet.File {
+			if seen[f.GetName()] {
+				continue
+			}
+			seen[f.GetName()] = true
+			all.File = append(all.File, f)
+		}
+	}
+	all.File = append(all.File, fds.File...)
+	return all, nil
+}
+
+// ownFiles returns registry having only files of fds, files of dependencies are left out
+func ownFiles(files *protoregistry.Files, fds *descriptorpb.FileDescriptorSet) *protoregistry.Files {
+	own := &protoregistry.Files{}
+	for _, f := range fds.File {
+		if fd, err := files.FindFileByPath(f.GetName()); err == nil {
+			own.RegisterFile(fd)
+		}
+	}
+	return own
+}
+
+// GetParsedSchema converts data into enriched data type to deal with protobuf schema.
+// Dependencies are descriptor sets of referenced schemas, used to resolve imports missing in data.
+func GetParsedSchema(data []byte, dependencies ...[]byte) (schema.ParsedSchema, error) {
 	fds := &descriptorpb.FileDescriptorSet{}
-	files, err := getRegistry(fds, data)
+	if err := proto.Unmarshal(data, fds); err != nil {
+		return &Schema{
+			isValid: false,
+		}, fmt.Errorf("descriptor set file is not valid. %w", err)
+	}
+	all, err := withDependencies(fds, dependencies)
+	if err != nil {
+		return &Schema{
+			isValid: false,
+		}, err
+	}
+	files, err := getRegistry(all)
 	if err != nil {
 		return &Schema{
 			isValid: false,
@@ -32,8 +80,10 @@ func GetParsedSchema(data []byte) (schema.ParsedSchema, error) {
 	}
 	orderedData, _ := proto.MarshalOptions{Deterministic: true}.Marshal(fds)
 	return &Schema{
-		isValid: err == nil,
-		Files:   files,
-		data:    orderedData,
+		isValid:   err == nil,
+		Files:     files,
+		own:       ownFiles(files, fds),
+		data:      orderedData,
+		assembled: all,
 	}, nil
 }
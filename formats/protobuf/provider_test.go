@@ -11,8 +11,11 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/raystack/stencil/core/schema"
 	"github.com/raystack/stencil/formats/protobuf"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 func runProtoc(
@@ -91,3 +94,56 @@ func TestGetParsedSchema(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+// splitDescriptorData splits descriptor set into data having file with given name and data having rest of the files
+func splitDescriptorData(t *testing.T, data []byte, name string) ([]byte, []byte) {
+	t.Helper()
+	fds := &descriptorpb.FileDescriptorSet{}
+	assert.NoError(t, proto.Unmarshal(data, fds))
+	matched, rest := &descriptorpb.FileDescriptorSet{}, &descriptorpb.FileDescriptorSet{}
+	for _, f := range fds.File {
+		if f.GetName() == name {
+			matched.File = append(matched.File, f)
+		} else {
+			rest.File = append(rest.File, f)
+		}
+	}
+	matchedData, err := proto.Marshal(matched)
+	assert.NoError(t, err)
+	restData, err := proto.Marshal(rest)
+	assert.NoError(t, err)
+	return matchedData, restData
+}
+
+func TestGetParsedSchemaWithDependencies(t *testing.T) {
+	data := getDescriptorData(t, "./testdata/valid", true)
+	dependency, main := splitDescriptorData(t, data, "google/protobuf/duration.proto")
+	t.Run("should return error if import is missing in data and dependencies", func(t *testing.T) {
+		_, err := protobuf.GetParsedSchema(main)
+		assert.Error(t, err)
+	})
+	t.Run("should return error if dependency data is not valid", func(t *testing.T) {
+		_, err := protobuf.GetParsedSchema(main, []byte("invalid data"))
+		assert.Error(t, err)
+	})
+	t.Run("should resolve imports from dependencies", func(t *testing.T) {
+		parsedSchema, err := protobuf.GetParsedSchema(main, dependency)
+		assert.NoError(t, err)
+		scFile := parsedSchema.GetCanonicalValue()
+		assert.ElementsMatch(t, []string{"a.Test"}, scFile.Types)
+		assembler, ok := parsedSchema.(schema.Assembler)
+		assert.True(t, ok)
+		assembled, err := assembler.Assemble()
+		assert.NoError(t, err)
+		fds := &descriptorpb.FileDescriptorSet{}
+		assert.NoError(t, proto.Unmarshal(assembled, fds))
+		var names []string
+		for _, f := range fds.File {
+			names = append(names, f.GetName())
+		}
+		assert.Equal(t, []string{"google/protobuf/duration.proto", "1.proto"}, names)
+		full, err := protobuf.GetParsedSchema(assembled)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"google.protobuf.Duration", "a.Test"}, full.GetCanonicalValue().Types)
+	})
+}
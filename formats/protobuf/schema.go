@@ -5,15 +5,21 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/raystack/stencil/core/schema"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 const protobufFormat = "FORMAT_PROTOBUF"
 
 type Schema struct {
+	// Files has files of the schema along with files of referenced schemas
 	*protoregistry.Files
-	isValid bool
-	data    []byte
+	// own has only files of the schema
+	own       *protoregistry.Files
+	isValid   bool
+	data      []byte
+	assembled *descriptorpb.FileDescriptorSet
 }
 
 func (s *Schema) Format() string {
@@ -24,12 +30,17 @@ func (s *Schema) GetCanonicalValue() *schema.SchemaFile {
 	id := uuid.NewSHA1(uuid.NameSpaceOID, s.data)
 	return &schema.SchemaFile{
 		ID:     id.String(),
-		Types:  getAllMessages(s.Files),
+		Types:  getAllMessages(s.own),
 		Data:   s.data,
-		Fields: getAllFields(s.Files),
+		Fields: getAllFields(s.own),
 	}
 }
 
+// Assemble returns descriptor set having files of the schema along with files of referenced schemas
+func (s *Schema) Assemble() ([]byte, error) {
+	return proto.MarshalOptions{Deterministic: true}.Marshal(s.assembled)
+}
+
 func (s *Schema) verify(against schema.ParsedSchema) (*Schema, error) {
 	prev, ok := against.(*Schema)
 	if against.Format() != protobufFormat && !ok {
@@ -105,3 +105,23 @@ func getEnum(files *protoregistry.Files, fullName protoreflect.FullName) protore
 	}
 	return nil
 }
+
+func eachService(services protoreflect.ServiceDescriptors, f func(protoreflect.ServiceDescriptor) bool) {
+	for i := 0; i < services.Len(); i++ {
+		if !f(services.Get(i)) {
+			return
+		}
+	}
+}
+
+func getService(files *protoregistry.Files, fullName protoreflect.FullName) protoreflect.ServiceDescriptor {
+	desc, err := files.FindDescriptorByName(fullName)
+	if err != nil {
+		return nil
+	}
+	service, ok := desc.(protoreflect.ServiceDescriptor)
+	if ok {
+		return service
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package thrift
+
+import (
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/raystack/stencil/core/schema"
+)
+
+type diffKind int
+
+const (
+	_ diffKind = iota
+	structDelete
+	structKindChange
+	fieldDelete
+	requiredFieldDelete
+	requiredFieldAdd
+	fieldNameChange
+	fieldRequirednessChange
+	fieldTypeChange
+	enumDelete
+	enumValueDelete
+	enumValueNumberChange
+	// changes below are only reported by diff, none of the compatibility modes reject them
+	structAdd
+	fieldAdd
+	fieldDefaultChange
+	enumAdd
+	enumValueAdd
+	serviceAdd
+	serviceDelete
+	functionAdd
+	functionDelete
+	functionSignatureChange
+)
+
+var diffKindNames = map[diffKind]string{
+	structDelete:            "structDelete",
+	structKindChange:        "structKindChange",
+	fieldDelete:             "fieldDelete",
+	requiredFieldDelete:     "requiredFieldDelete",
+	requiredFieldAdd:        "requiredFieldAdd",
+	fieldNameChange:         "fieldNameChange",
+	fieldRequirednessChange: "fieldRequirednessChange",
+	fieldTypeChange:         "fieldTypeChange",
+	enumDelete:              "enumDelete",
+	enumValueDelete:         "enumValueDelete",
+	enumValueNumberChange:   "enumValueNumberChange",
+	structAdd:               "structAdd",
+	fieldAdd:                "fieldAdd",
+	fieldDefaultChange:      "fieldDefaultChange",
+	enumAdd:                 "enumAdd",
+	enumValueAdd:            "enumValueAdd",
+	serviceAdd:              "serviceAdd",
+	serviceDelete:           "serviceDelete",
+	functionAdd:             "functionAdd",
+	functionDelete:          "functionDelete",
+	functionSignatureChange: "functionSignatureChange",
+}
+
+var (
+	backwardCompatibility = []diffKind{
+		structDelete,
+		structKindChange,
+		fieldDelete,
+		requiredFieldAdd,
+		fieldNameChange,
+		fieldRequirednessChange,
+		fieldTypeChange,
+		enumDelete,
+		enumValueDelete,
+		enumValueNumberChange}
+	forwardCompatibility = []diffKind{
+		structDelete,
+		structKindChange,
+		requiredFieldDelete,
+		fieldNameChange,
+		fieldRequirednessChange,
+		fieldTypeChange,
+		enumDelete,
+		enumValueNumberChange}
+	fullCompatibility = []diffKind{
+		structDelete,
+		structKindChange,
+		fieldDelete,
+		requiredFieldAdd,
+		fieldNameChange,
+		fieldRequirednessChange,
+		fieldTypeChange,
+		enumDelete,
+		enumValueDelete,
+		enumValueNumberChange}
+)
+
+func (d diffKind) String() string {
+	return diffKindNames[d]
+}
+
+func compareSchemas(current, prev *Schema, notAllowedChanges []diffKind) error {
+	diffs := &compatibilityErr{}
+	walkSchemas(current, prev, diffRecorder{schema.RejectedChanges[diffKind](diffs, notAllowedChanges)})
+	if diffs.IsEmpty() {
+		return nil
+	}
+	return diffs
+}
+
+// walkSchemas records every change from prev to current schema, fields are matched by field id as encoded on wire
+func walkSchemas(current, prev *Schema, diffs diffRecorder) {
+	for _, prevStruct := range structLikes(prev.file) {
+		currentStruct := getStructLike(current.file, prevStruct.Name)
+		if currentStruct == nil {
+			diffs.add(structDelete, prevStruct.Name, prevStruct.Name, nil, `%s "%s" is removed`, prevStruct.Category, prevStruct.Name)
+			continue
+		}
+		compareStructs(current, prev, currentStruct, prevStruct, diffs)
+	}
+	for _, currentStruct := range structLikes(current.file) {
+		if getStructLike(prev.file, currentStruct.Name) == nil {
+			diffs.add(structAdd, currentStruct.Name, nil, currentStruct.Name, `%s "%s" is added`, currentStruct.Category, currentStruct.Name)
+		}
+	}
+	for _, prevEnum := range prev.file.Enums {
+		currentEnum := getEnum(current.file, prevEnum.Name)
+		if currentEnum == nil {
+			diffs.add(enumDelete, prevEnum.Name, prevEnum.Name, nil, `enum "%s" is removed`, prevEnum.Name)
+			continue
+		}
+		compareEnums(currentEnum, prevEnum, diffs)
+	}
+	for _, currentEnum := range current.file.Enums {
+		if getEnum(prev.file, currentEnum.Name) == nil {
+			diffs.add(enumAdd, currentEnum.Name, nil, currentEnum.Name, `enum "%s" is added`, currentEnum.Name)
+		}
+	}
+	for _, prevService := range prev.file.Services {
+		currentService := getService(current.file, prevService.Name)
+		if currentService == nil {
+			diffs.add(serviceDelete, prevService.Name, prevService.Name, nil, `service "%s" is removed`, prevService.Name)
+			continue
+		}
+		compareServices(current, prev, currentService, prevService, diffs)
+	}
+	for _, currentService := range current.file.Services {
+		if getService(prev.file, currentService.Name) == nil {
+			diffs.add(serviceAdd, currentService.Name, nil, currentService.Name, `service "%s" is added`, currentService.Name)
+		}
+	}
+}
+
+func compareStructs(current, prev *Schema, currentStruct, prevStruct *parser.StructLike, diffs diffRecorder) {
+	if prevStruct.Category != currentStruct.Category {
+		diffs.add(structKindChange, prevStruct.Name, prevStruct.Category, currentStruct.Category, `"%s" changed from %s to %s`, prevStruct.Name, prevStruct.Category, currentStruct.Category)
+	}
+	for _, prevField := range prevStruct.Fields {
+		path := prevStruct.Name + "." + prevField.Name
+		currentField := getFieldByID(currentStruct, prevField.ID)
+		if currentField == nil {
+			diffs.add(fieldDelete, path, prevField.Name, nil, `field "%s" with id "%d" is deleted`, prevField.Name, prevField.ID)
+			if prevField.Requiredness == parser.FieldType_Required {
+				diffs.add(requiredFieldDelete, path, prevField.Name, nil, `required field "%s" with id "%d" is deleted`, prevField.Name, prevField.ID)
+			}
+			continue
+		}
+		compareFields(current, prev, path, currentField, prevField, diffs)
+	}
+	for _, currentField := range currentStruct.Fields {
+		if getFieldByID(prevStruct, currentField.ID) != nil {
+			continue
+		}
+		path := currentStruct.Name + "." + currentField.Name
+		if currentField.Requiredness == parser.FieldType_Required {
+			diffs.add(requiredFieldAdd, path, nil, currentField.Name, `required field "%s" with id "%d" is added`, currentField.Name, currentField.ID)
+		} else {
+			diffs.add(fieldAdd, path, nil, currentField.Name, `field "%s" with id "%d" is added`, currentField.Name, currentField.ID)
+		}
+	}
+}
+
+func compareFields(current, prev *Schema, path string, currentField, prevField *parser.Field, diffs diffRecorder) {
+	if prevField.Name != currentField.Name {
+		diffs.add(fieldNameChange, path, prevField.Name, currentField.Name, `field name with id "%d" changed from "%s" to "%s"`, prevField.ID, prevField.Name, currentField.Name)
+	}
+	if prevField.Requiredness != currentField.Requiredness {
+		diffs.add(fieldRequirednessChange, path, requiredness(prevField), requiredness(currentField), `field "%s" requiredness changed from "%s" to "%s"`, prevField.Name, requiredness(prevField), requiredness(currentField))
+	}
+	prevType, currentType := prev.resolvedTypeString(prevField.Type), current.resolvedTypeString(currentField.Type)
+	if prevType != currentType {
+		diffs.add(fieldTypeChange, path, prevType, currentType, `field "%s" type changed from "%s" to "%s"`, prevField.Name, prevType, currentType)
+	}
+	prevDefault, currentDefault := constString(prevField.Default), constString(currentField.Default)
+	if prevDefault != currentDefault {
+		diffs.add(fieldDefaultChange, path, prevDefault, currentDefault, `field "%s" default changed from "%s" to "%s"`, prevField.Name, prevDefault, currentDefault)
+	}
+}
+
+func compareEnums(current, prev *parser.Enum, diffs diffRecorder) {
+	for _, prevValue := range prev.Values {
+		path := prev.Name + "." + prevValue.Name
+		currentValue := getEnumValue(current, prevValue.Name)
+		if currentValue == nil {
+			diffs.add(enumValueDelete, path, prevValue.Name, nil, `enum value "%s" with number "%d" is deleted from "%s"`, prevValue.Name, prevValue.Value, prev.Name)
+			continue
+		}
+		if prevValue.Value != currentValue.Value {
+			diffs.add(enumValueNumberChange, path, prevValue.Value, currentValue.Value, `enum value number for "%s" changed from "%d" to "%d"`, path, prevValue.Value, currentValue.Value)
+		}
+	}
+	for _, currentValue := range current.Values {
+		if getEnumValue(prev, currentValue.Name) == nil {
+			diffs.add(enumValueAdd, current.Name+"."+currentValue.Name, nil, currentValue.Value, `enum value "%s" with number "%d" is added to "%s"`, currentValue.Name, currentValue.Value, current.Name)
+		}
+	}
+}
+
+func compareServices(current, prev *Schema, currentService, prevService *parser.Service, diffs diffRecorder) {
+	for _, prevFn := range prevService.Functions {
+		path := prevService.Name + "." + prevFn.Name
+		currentFn := getFunction(currentService, prevFn.Name)
+		if currentFn == nil {
+			diffs.add(functionDelete, path, prevFn.Name, nil, `function "%s" is deleted from "%s"`, prevFn.Name, prevService.Name)
+			continue
+		}
+		prevSignature, currentSignature := prev.functionSignature(prevFn), current.functionSignature(currentFn)
+		if prevSignature != currentSignature {
+			diffs.add(functionSignatureChange, path, prevSignature, currentSignature, `function "%s" changed from "%s" to "%s"`, prevFn.Name, prevSignature, currentSignature)
+		}
+	}
+	for _, currentFn := range currentService.Functions {
+		if getFunction(prevService, currentFn.Name) == nil {
+			diffs.add(functionAdd, currentService.Name+"."+currentFn.Name, nil, current.functionSignature(currentFn), `function "%s" is added to "%s"`, currentFn.Name, currentService.Name)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package thrift_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompatibility(t *testing.T) {
+	prev := parse(t, `
+typedef i64 Timestamp
+enum Status { OPEN = 1, CLOSED = 2 }
+struct Booking {
+  1: required string id
+  2: optional Timestamp created_at
+  3: string note
+}
+`)
+	for _, test := range []struct {
+		name     string
+		current  string
+		backward string
+		forward  string
+	}{
+		{"optional field addition is allowed", `
+typedef i64 Timestamp
+enum Status { OPEN = 1, CLOSED = 2, CANCELLED = 3 }
+struct Booking { 1: required string id, 2: optional Timestamp created_at, 3: string note, 4: optional Status status }
+`, "", ""},
+		{"typedef alias change with same wire type is allowed", `
+typedef i64 Time
+enum Status { OPEN = 1, CLOSED = 2 }
+struct Booking { 1: required string id, 2: optional Time created_at, 3: string note }
+`, "", ""},
+		{"required field addition is not backward compatible", `
+typedef i64 Timestamp
+enum Status { OPEN = 1, CLOSED = 2 }
+struct Booking { 1: required string id, 2: optional Timestamp created_at, 3: string note, 4: required string user }
+`, `Booking.user: required field "user" with id "4" is added`, ""},
+		{"optional field deletion is only forward compatible", `
+typedef i64 Timestamp
+enum Status { OPEN = 1, CLOSED = 2 }
+struct Booking { 1: required string id, 2: optional Timestamp created_at }
+`, `Booking.note: field "note" with id "3" is deleted`, ""},
+		{"required field deletion is not compatible", `
+typedef i64 Timestamp
+enum Status { OPEN = 1, CLOSED = 2 }
+struct Booking { 2: optional Timestamp created_at, 3: string note }
+`, `Booking.id: field "id" with id "1" is deleted`, `Booking.id: required field "id" with id "1" is deleted`},
+		{"field type change is not compatible", `
+typedef i32 Timestamp
+enum Status { OPEN = 1, CLOSED = 2 }
+struct Booking { 1: required string id, 2: optional Timestamp created_at, 3: string note }
+`, `Booking.created_at: field "created_at" type changed from "i64" to "i32"`, `Booking.created_at: field "created_at" type changed from "i64" to "i32"`},
+		{"field name change is not compatible", `
+typedef i64 Timestamp
+enum Status { OPEN = 1, CLOSED = 2 }
+struct Booking { 1: required string id, 2: optional Timestamp created_at, 3: string comment }
+`, `Booking.note: field name with id "3" changed from "note" to "comment"`, `Booking.note: field name with id "3" changed from "note" to "comment"`},
+		{"requiredness change is not compatible", `
+typedef i64 Timestamp
+enum Status { OPEN = 1, CLOSED = 2 }
+struct Booking { 1: optional string id, 2: optional Timestamp created_at, 3: string note }
+`, `Booking.id: field "id" requiredness changed from "required" to "optional"`, `Booking.id: field "id" requiredness changed from "required" to "optional"`},
+		{"enum value deletion is only forward compatible", `
+typedef i64 Timestamp
+enum Status { OPEN = 1 }
+struct Booking { 1: required string id, 2: optional Timestamp created_at, 3: string note }
+`, `Status.CLOSED: enum value "CLOSED" with number "2" is deleted from "Status"`, ""},
+		{"struct deletion is not compatible", `
+typedef i64 Timestamp
+enum Status { OPEN = 1, CLOSED = 2 }
+`, `Booking: struct "Booking" is removed`, `Booking: struct "Booking" is removed`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			current := parse(t, test.current)
+			for _, check := range []struct {
+				err      error
+				expected string
+			}{
+				{current.IsBackwardCompatible(prev), test.backward},
+				{current.IsForwardCompatible(prev), test.forward},
+			} {
+				if check.expected == "" {
+					assert.NoError(t, check.err)
+				} else if assert.Error(t, check.err) {
+					assert.Equal(t, check.expected, check.err.Error())
+				}
+			}
+			fullErr := current.IsFullCompatible(prev)
+			assert.Equal(t, test.backward == "" && test.forward == "", fullErr == nil)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package thrift
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/raystack/stencil/core/schema"
+)
+
+// compatibilityModes classifies changes listed by diff
+var compatibilityModes = schema.CompatibilityModes[diffKind]{
+	Backward: backwardCompatibility,
+	Forward:  forwardCompatibility,
+	Full:     fullCompatibility,
+}
+
+// Diff returns changes made in the schema since given earlier schema, across structs, fields, enums and services.
+func (s *Schema) Diff(against schema.ParsedSchema) ([]schema.Change, error) {
+	prev, err := s.verify(against)
+	if err != nil {
+		return nil, err
+	}
+	changes := schema.NewChangeSet(compatibilityModes)
+	walkSchemas(s, prev, diffRecorder{changes})
+	return changes.Changes(), nil
+}
+
+// functionSignature returns function signature with typedef aliases resolved, like "i64(1:string) throws (1:Err)"
+func (s *Schema) functionSignature(fn *parser.Function) string {
+	fields := func(list []*parser.Field) string {
+		parts := make([]string, 0, len(list))
+		for _, f := range list {
+			parts = append(parts, fmt.Sprintf("%d:%s", f.ID, s.resolvedTypeString(f.Type)))
+		}
+		return strings.Join(parts, ",")
+	}
+	signature := fmt.Sprintf("%s(%s)", s.resolvedTypeString(fn.FunctionType), fields(fn.Arguments))
+	if fn.Oneway {
+		signature = "oneway " + signature
+	}
+	if len(fn.Throws) > 0 {
+		signature = fmt.Sprintf("%s throws (%s)", signature, fields(fn.Throws))
+	}
+	return signature
+}
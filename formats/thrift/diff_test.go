@@ -0,0 +1,59 @@
+package thrift_test
+
+import (
+	"testing"
+
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/formats/formattest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	prev := parse(t, bookingIDL)
+	current := parse(t, `namespace java com.raystack.booking
+typedef i64 Timestamp
+enum Status { OPEN = 1, CANCELLED = 3 }
+struct Booking {
+  1: required string id
+  2: optional Timestamp created_at
+  3: list<Status> history
+  4: optional i32 count = 1
+}
+union Source { 1: string app, 2: string web }
+service BookingService {
+  Booking get(1: string id, 2: bool cached)
+  list<Booking> list()
+}
+`)
+	changes, err := current.(schema.Differ).Diff(prev)
+	assert.NoError(t, err)
+
+	all := []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FORWARD", "COMPATIBILITY_FULL"}
+	for _, test := range []struct {
+		kind        string
+		path        string
+		breakingFor []string
+	}{
+		{"fieldAdd", "Booking.count", nil},
+		{"structAdd", "Source", nil},
+		{"structDelete", "NotFound", all},
+		{"enumValueAdd", "Status.CANCELLED", nil},
+		{"enumValueDelete", "Status.CLOSED", []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FULL"}},
+		{"functionAdd", "BookingService.list", nil},
+		{"functionSignatureChange", "BookingService.get", nil},
+	} {
+		change := formattest.FindChange(changes, test.kind, test.path)
+		if assert.NotNil(t, change, "%s %s", test.kind, test.path) {
+			assert.Equal(t, test.breakingFor, change.BreakingFor, "%s %s", test.kind, test.path)
+		}
+	}
+	signature := formattest.FindChange(changes, "functionSignatureChange", "BookingService.get")
+	assert.Equal(t, "Booking(1:string) throws (1:NotFound)", signature.OldValue)
+	assert.Equal(t, "Booking(1:string,2:bool)", signature.NewValue)
+
+	t.Run("should return no changes for same schema", func(t *testing.T) {
+		changes, err := current.(schema.Differ).Diff(current)
+		assert.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+}
@@ -0,0 +1,30 @@
+package thrift
+
+import (
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/raystack/stencil/core/schema"
+)
+
+// Format is thrift schema format plugin, schema data is source of a single thrift IDL file
+type Format struct{}
+
+func (Format) Name() string {
+	return thriftFormat
+}
+
+func (Format) ContentType() string {
+	return "text/plain"
+}
+
+func (Format) ParseSchema(data []byte) (schema.ParsedSchema, error) {
+	return ParseSchema(data)
+}
+
+// PrintSchema prints formatted thrift IDL along with comments
+func (Format) PrintSchema(data []byte, filter string) (string, string, error) {
+	file, err := parser.ParseString(fileName, string(data))
+	if err != nil {
+		return "", "", err
+	}
+	return printIDL(file, true), "Thrift", nil
+}
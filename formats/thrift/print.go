@@ -0,0 +1,176 @@
+package thrift
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// printIDL renders thrift file as IDL source in a fixed layout, doc comments are kept if comments is set
+func printIDL(file *parser.Thrift, comments bool) string {
+	p := &idlPrinter{comments: comments}
+	for _, ns := range file.Namespaces {
+		p.line(0, "namespace %s %s%s", ns.Language, ns.Name, annotationsString(ns.Annotations))
+	}
+	p.section()
+	for _, td := range file.Typedefs {
+		p.doc(0, td.ReservedComments)
+		p.line(0, "typedef %s %s%s", typeString(td.Type), td.Alias, annotationsString(td.Annotations))
+	}
+	p.section()
+	for _, c := range file.Constants {
+		p.doc(0, c.ReservedComments)
+		p.line(0, "const %s %s = %s", typeString(c.Type), c.Name, constString(c.Value))
+	}
+	p.section()
+	for _, e := range file.Enums {
+		p.doc(0, e.ReservedComments)
+		p.line(0, "enum %s {", e.Name)
+		for _, v := range e.Values {
+			p.doc(1, v.ReservedComments)
+			p.line(1, "%s = %d%s", v.Name, v.Value, annotationsString(v.Annotations))
+		}
+		p.line(0, "}%s", annotationsString(e.Annotations))
+		p.section()
+	}
+	for _, st := range structLikes(file) {
+		p.doc(0, st.ReservedComments)
+		p.line(0, "%s %s {", st.Category, st.Name)
+		for _, f := range st.Fields {
+			p.doc(1, f.ReservedComments)
+			p.line(1, "%s", fieldString(f))
+		}
+		p.line(0, "}%s", annotationsString(st.Annotations))
+		p.section()
+	}
+	for _, svc := range file.Services {
+		p.doc(0, svc.ReservedComments)
+		extends := ""
+		if svc.Extends != "" {
+			extends = " extends " + svc.Extends
+		}
+		p.line(0, "service %s%s {", svc.Name, extends)
+		for _, fn := range svc.Functions {
+			p.doc(1, fn.ReservedComments)
+			p.line(1, "%s", functionString(fn))
+		}
+		p.line(0, "}%s", annotationsString(svc.Annotations))
+		p.section()
+	}
+	return strings.TrimSpace(p.String()) + "\n"
+}
+
+type idlPrinter struct {
+	strings.Builder
+	comments bool
+}
+
+func (p *idlPrinter) line(indent int, format string, args ...interface{}) {
+	p.WriteString(strings.Repeat("  ", indent))
+	p.WriteString(fmt.Sprintf(format, args...))
+	p.WriteString("\n")
+}
+
+func (p *idlPrinter) doc(indent int, comment string) {
+	if !p.comments || comment == "" {
+		return
+	}
+	for _, l := range strings.Split(comment, "\n") {
+		p.line(indent, "%s", strings.TrimSpace(l))
+	}
+}
+
+// section separates groups of definitions with an empty line
+func (p *idlPrinter) section() {
+	if s := p.String(); s != "" && !strings.HasSuffix(s, "\n\n") {
+		p.WriteString("\n")
+	}
+}
+
+func fieldString(f *parser.Field) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d: ", f.ID)
+	if f.Requiredness != parser.FieldType_Default {
+		fmt.Fprintf(&b, "%s ", requiredness(f))
+	}
+	fmt.Fprintf(&b, "%s %s", typeString(f.Type), f.Name)
+	if f.Default != nil {
+		fmt.Fprintf(&b, " = %s", constString(f.Default))
+	}
+	b.WriteString(annotationsString(f.Annotations))
+	return b.String()
+}
+
+func functionString(fn *parser.Function) string {
+	fields := func(list []*parser.Field) string {
+		parts := make([]string, 0, len(list))
+		for _, f := range list {
+			parts = append(parts, fieldString(f))
+		}
+		return strings.Join(parts, ", ")
+	}
+	s := fmt.Sprintf("%s %s(%s)", typeString(fn.FunctionType), fn.Name, fields(fn.Arguments))
+	if fn.Oneway {
+		s = "oneway " + s
+	}
+	if len(fn.Throws) > 0 {
+		s = fmt.Sprintf("%s throws (%s)", s, fields(fn.Throws))
+	}
+	return s + annotationsString(fn.Annotations)
+}
+
+func requiredness(f *parser.Field) string {
+	switch f.Requiredness {
+	case parser.FieldType_Required:
+		return "required"
+	case parser.FieldType_Optional:
+		return "optional"
+	}
+	return "default"
+}
+
+func annotationsString(annotations parser.Annotations) string {
+	if len(annotations) == 0 {
+		return ""
+	}
+	var parts []string
+	for _, a := range annotations {
+		for _, v := range a.Values {
+			parts = append(parts, fmt.Sprintf("%s = %s", a.Key, strconv.Quote(v)))
+		}
+	}
+	return " (" + strings.Join(parts, ", ") + ")"
+}
+
+// constString returns constant value as written in IDL, empty if value is not set
+func constString(v *parser.ConstValue) string {
+	if v == nil || v.TypedValue == nil {
+		return ""
+	}
+	tv := v.TypedValue
+	switch v.Type {
+	case parser.ConstType_ConstDouble:
+		return strconv.FormatFloat(*tv.Double, 'g', -1, 64)
+	case parser.ConstType_ConstInt:
+		return strconv.FormatInt(*tv.Int, 10)
+	case parser.ConstType_ConstLiteral:
+		return strconv.Quote(*tv.Literal)
+	case parser.ConstType_ConstIdentifier:
+		return *tv.Identifier
+	case parser.ConstType_ConstList:
+		parts := make([]string, 0, len(tv.List))
+		for _, item := range tv.List {
+			parts = append(parts, constString(item))
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	case parser.ConstType_ConstMap:
+		parts := make([]string, 0, len(tv.Map))
+		for _, item := range tv.Map {
+			parts = append(parts, fmt.Sprintf("%s: %s", constString(item.Key), constString(item.Value)))
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	}
+	return ""
+}
@@ -0,0 +1,95 @@
+package thrift
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/raystack/stencil/core/schema"
+)
+
+const fileName = "schema.thrift"
+
+// ParseSchema parses thrift IDL source into ParsedSchema
+func ParseSchema(data []byte) (schema.ParsedSchema, error) {
+	file, err := parser.ParseString(fileName, string(data))
+	if err != nil {
+		return nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: err}
+	}
+	sc := &Schema{data: data, file: file, typedefs: map[string]*parser.Type{}}
+	for _, td := range file.Typedefs {
+		sc.typedefs[td.Alias] = td.Type
+	}
+	if err := validate(sc); err != nil {
+		return nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: err}
+	}
+	return sc, nil
+}
+
+// validate checks schema is self contained and every field has explicit id
+func validate(s *Schema) error {
+	if len(s.file.Includes) > 0 {
+		return fmt.Errorf("includes are not supported, found include of %q", s.file.Includes[0].Path)
+	}
+	known := map[string]bool{}
+	for _, name := range getAllTypes(s.file) {
+		known[name] = true
+	}
+	for alias := range s.typedefs {
+		known[alias] = true
+	}
+	var checkType func(t *parser.Type, where string) error
+	checkType = func(t *parser.Type, where string) error {
+		if t == nil {
+			return nil
+		}
+		if err := checkType(t.KeyType, where); err != nil {
+			return err
+		}
+		if err := checkType(t.ValueType, where); err != nil {
+			return err
+		}
+		if !isBaseType(t.Name) && !isContainer(t.Name) && !known[t.Name] {
+			return fmt.Errorf("unknown type %q used in %s", t.Name, where)
+		}
+		return nil
+	}
+	for _, td := range s.file.Typedefs {
+		if err := checkType(td.Type, td.Alias); err != nil {
+			return err
+		}
+		aliased := td.Type
+		for i := 0; aliased != nil && i <= len(s.typedefs); i++ {
+			aliased = s.typedefs[aliased.Name]
+		}
+		if aliased != nil {
+			return fmt.Errorf("typedef %q refers to itself", td.Alias)
+		}
+	}
+	for _, st := range structLikes(s.file) {
+		for _, f := range st.Fields {
+			where := st.Name + "." + f.Name
+			if f.ID <= 0 {
+				return fmt.Errorf("field %q must have a positive field id", where)
+			}
+			if err := checkType(f.Type, where); err != nil {
+				return err
+			}
+		}
+	}
+	for _, svc := range s.file.Services {
+		for _, fn := range svc.Functions {
+			where := svc.Name + "." + fn.Name
+			if err := checkType(fn.FunctionType, where); err != nil {
+				return err
+			}
+			for _, f := range append(append([]*parser.Field{}, fn.Arguments...), fn.Throws...) {
+				if err := checkType(f.Type, where); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package thrift_test
+
+import (
+	"testing"
+
+	"github.com/raystack/stencil/formats/formattest"
+	"github.com/raystack/stencil/formats/thrift"
+	"github.com/stretchr/testify/assert"
+)
+
+const bookingIDL = `namespace java com.raystack.booking
+
+typedef i64 Timestamp
+
+enum Status {
+  OPEN = 1,
+  CLOSED = 2
+}
+
+struct Booking {
+  1: required string id
+  2: optional Timestamp created_at
+  3: list<Status> history
+}
+
+exception NotFound {
+  1: string message
+}
+
+service BookingService {
+  Booking get(1: string id) throws (1: NotFound err)
+}
+`
+
+var parse = formattest.Parser(thrift.ParseSchema)
+
+func TestParseSchema(t *testing.T) {
+	t.Run("should return search data of structs, exceptions and enums", func(t *testing.T) {
+		sc := parse(t, bookingIDL)
+		file := sc.GetCanonicalValue()
+		assert.Equal(t, "FORMAT_THRIFT", sc.Format())
+		assert.ElementsMatch(t, []string{"Booking", "NotFound", "Status"}, file.Types)
+		assert.ElementsMatch(t, []string{"Booking.id", "Booking.created_at", "Booking.history", "NotFound.message"}, file.Fields)
+		assert.Equal(t, []byte(bookingIDL), file.Data)
+	})
+	t.Run("should have same id when only comments and formatting change", func(t *testing.T) {
+		sc := parse(t, bookingIDL)
+		formatted := parse(t, "// bookings\nnamespace java com.raystack.booking\ntypedef i64 Timestamp\nenum Status { OPEN = 1; CLOSED = 2 }\n"+
+			"struct Booking { 1: required string id, 2: optional Timestamp created_at, /* states */ 3: list<Status> history }\n"+
+			"exception NotFound { 1: string message }\nservice BookingService { Booking get(1: string id) throws (1: NotFound err) }")
+		formattest.AssertID(t, sc, formatted, parse(t, bookingIDL+"struct Other {}\n"))
+	})
+	for _, test := range []struct {
+		name string
+		idl  string
+		err  string
+	}{
+		{"should return error for invalid IDL", "struct A { 1: string }", ""},
+		{"should return error for unknown type", "struct A { 1: Missing m }", `unknown type "Missing" used in A.m`},
+		{"should return error for includes", "include \"other.thrift\"\nstruct A {}", `includes are not supported, found include of "other.thrift"`},
+		{"should return error for field without id", "struct A { -1: string name }", `field "A.name" must have a positive field id`},
+		{"should return error for cyclic typedef", "typedef B A\ntypedef A B", `refers to itself`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := thrift.ParseSchema([]byte(test.idl))
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), test.err)
+			}
+		})
+	}
+}
+
+func TestPrintSchema(t *testing.T) {
+	source, language, err := thrift.Format{}.PrintSchema([]byte("/** booking */\nstruct Booking { 1: required string id (go.tag = \"json:\\\"id\\\"\"), 2: i32 count = 1 }"), "")
+	assert.NoError(t, err)
+	assert.Equal(t, "Thrift", language)
+	assert.Equal(t, "/** booking */\nstruct Booking {\n  1: required string id (go.tag = \"json:\\\"id\\\"\")\n  2: i32 count = 1\n}\n", source)
+}
@@ -0,0 +1,72 @@
+package thrift
+
+import (
+	"errors"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/google/uuid"
+	"github.com/raystack/stencil/core/schema"
+)
+
+const thriftFormat = "FORMAT_THRIFT"
+
+type Schema struct {
+	data []byte
+	file *parser.Thrift
+	// typedefs maps alias to the aliased type
+	typedefs map[string]*parser.Type
+}
+
+func (s *Schema) Format() string {
+	return thriftFormat
+}
+
+// GetCanonicalValue returns schema file identified by canonical IDL source, so changes to comments and formatting are not new versions
+func (s *Schema) GetCanonicalValue() *schema.SchemaFile {
+	id := uuid.NewSHA1(uuid.NameSpaceOID, []byte(printIDL(s.file, false)))
+	return &schema.SchemaFile{
+		ID:     id.String(),
+		Types:  getAllTypes(s.file),
+		Data:   s.data,
+		Fields: getAllFields(s.file),
+	}
+}
+
+func (s *Schema) verify(against schema.ParsedSchema) (*Schema, error) {
+	prev, ok := against.(*Schema)
+	if !ok || against.Format() != thriftFormat {
+		return nil, errors.New("different schema formats")
+	}
+	return prev, nil
+}
+
+// IsBackwardCompatible checks backward compatibility against given schema
+// Allowed changes: optional field addition
+// Disallowed changes: field type change, field id change, requiredness change, field deletion, required field addition
+func (s *Schema) IsBackwardCompatible(against schema.ParsedSchema) error {
+	prev, err := s.verify(against)
+	if err != nil {
+		return err
+	}
+	return compareSchemas(s, prev, backwardCompatibility)
+}
+
+// IsForwardCompatible checks forward compatibility against given schema
+// Allowed changes: field addition, optional field deletion
+// Disallowed changes: field type change, field id change, requiredness change, required field deletion
+func (s *Schema) IsForwardCompatible(against schema.ParsedSchema) error {
+	prev, err := s.verify(against)
+	if err != nil {
+		return err
+	}
+	return compareSchemas(s, prev, forwardCompatibility)
+}
+
+// IsFullCompatible checks for both backward and forward compatibility
+func (s *Schema) IsFullCompatible(against schema.ParsedSchema) error {
+	prev, err := s.verify(against)
+	if err != nil {
+		return err
+	}
+	return compareSchemas(s, prev, fullCompatibility)
+}
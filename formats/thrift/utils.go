@@ -0,0 +1,140 @@
+package thrift
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+var baseTypes = map[string]bool{
+	"bool": true, "byte": true, "i8": true, "i16": true, "i32": true, "i64": true,
+	"double": true, "string": true, "binary": true, "uuid": true, "void": true,
+}
+
+func isBaseType(name string) bool {
+	return baseTypes[name]
+}
+
+func isContainer(name string) bool {
+	return name == "list" || name == "set" || name == "map"
+}
+
+// structLikes returns structs, unions and exceptions of the file
+func structLikes(file *parser.Thrift) []*parser.StructLike {
+	var all []*parser.StructLike
+	all = append(all, file.Structs...)
+	all = append(all, file.Unions...)
+	all = append(all, file.Exceptions...)
+	return all
+}
+
+func getStructLike(file *parser.Thrift, name string) *parser.StructLike {
+	for _, st := range structLikes(file) {
+		if st.Name == name {
+			return st
+		}
+	}
+	return nil
+}
+
+func getEnum(file *parser.Thrift, name string) *parser.Enum {
+	for _, e := range file.Enums {
+		if e.Name == name {
+			return e
+		}
+	}
+	return nil
+}
+
+func getService(file *parser.Thrift, name string) *parser.Service {
+	for _, svc := range file.Services {
+		if svc.Name == name {
+			return svc
+		}
+	}
+	return nil
+}
+
+func getFieldByID(st *parser.StructLike, id int32) *parser.Field {
+	for _, f := range st.Fields {
+		if f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+func getFunction(svc *parser.Service, name string) *parser.Function {
+	for _, fn := range svc.Functions {
+		if fn.Name == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+func getEnumValue(e *parser.Enum, name string) *parser.EnumValue {
+	for _, v := range e.Values {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// getAllTypes returns names of structs, unions, exceptions and enums
+func getAllTypes(file *parser.Thrift) []string {
+	var types []string
+	for _, st := range structLikes(file) {
+		types = append(types, st.Name)
+	}
+	for _, e := range file.Enums {
+		types = append(types, e.Name)
+	}
+	return types
+}
+
+// getAllFields returns fields of structs, unions and exceptions as type.field
+func getAllFields(file *parser.Thrift) []string {
+	var fields []string
+	for _, st := range structLikes(file) {
+		for _, f := range st.Fields {
+			fields = append(fields, fmt.Sprintf("%s.%s", st.Name, f.Name))
+		}
+	}
+	return fields
+}
+
+// typeString returns type as written in IDL
+func typeString(t *parser.Type) string {
+	if t == nil {
+		return "void"
+	}
+	switch t.Name {
+	case "list", "set":
+		return fmt.Sprintf("%s<%s>", t.Name, typeString(t.ValueType))
+	case "map":
+		return fmt.Sprintf("map<%s,%s>", typeString(t.KeyType), typeString(t.ValueType))
+	}
+	return t.Name
+}
+
+// resolvedTypeString returns type with typedef aliases replaced by aliased types, as encoded on wire
+func (s *Schema) resolvedTypeString(t *parser.Type) string {
+	if t == nil {
+		return "void"
+	}
+	switch t.Name {
+	case "list", "set":
+		return fmt.Sprintf("%s<%s>", t.Name, s.resolvedTypeString(t.ValueType))
+	case "map":
+		return fmt.Sprintf("map<%s,%s>", s.resolvedTypeString(t.KeyType), s.resolvedTypeString(t.ValueType))
+	}
+	if aliased, ok := s.typedefs[t.Name]; ok {
+		return s.resolvedTypeString(aliased)
+	}
+	if t.Name == "byte" {
+		return "i8"
+	}
+	return t.Name
+}
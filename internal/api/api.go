@@ -8,13 +8,31 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/raystack/stencil/core/audit"
+	"github.com/raystack/stencil/core/auth"
 	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/retention"
 	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/core/schema/provider"
 	"github.com/raystack/stencil/core/search"
+	"github.com/raystack/stencil/core/webhook"
 	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
 	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
+const (
+	// globalIDHeader carries global ID of the served schema version, used by clients to frame messages
+	globalIDHeader = "X-Global-Id"
+	// warningHeader is set when served schema version is deleted but not purged yet
+	warningHeader  = "Warning"
+	deletedWarning = `299 stencil "schema version is deleted and will be purged after retention period"`
+	// versionStateHeader carries lifecycle state of the served schema version
+	versionStateHeader       = "X-Version-State"
+	versionStateReasonHeader = "X-Version-State-Reason"
+	// sunsetHeader is set to sunset time of deprecated versions as per RFC 8594
+	sunsetHeader = "Sunset"
+)
+
 type getSchemaData func(http.ResponseWriter, *http.Request, map[string]string) (*schema.Metadata, []byte, error)
 type errHandleFunc func(http.ResponseWriter, *http.Request, map[string]string) error
 
@@ -24,38 +42,87 @@ type NamespaceService interface {
 	List(ctx context.Context) ([]namespace.Namespace, error)
 	Get(ctx context.Context, name string) (namespace.Namespace, error)
 	Delete(ctx context.Context, name string) error
+	Restore(ctx context.Context, name string) (namespace.Namespace, error)
 }
 
 type SchemaService interface {
-	CheckCompatibility(ctx context.Context, nsName, schemaName, compatibility string, data []byte) error
+	CheckCompatibility(ctx context.Context, nsName, schemaName string, metadata *schema.Metadata, data []byte) error
+	CheckCompatibilityWithVersion(ctx context.Context, nsName, schemaName string, version int32, metadata *schema.Metadata, data []byte) error
 	Create(ctx context.Context, nsName string, schemaName string, metadata *schema.Metadata, data []byte) (schema.SchemaInfo, error)
+	CreateDryRun(ctx context.Context, nsName string, schemaName string, metadata *schema.Metadata, data []byte) (schema.SchemaInfo, error)
+	LookupVersion(ctx context.Context, nsName string, schemaName string, metadata *schema.Metadata, data []byte) (schema.SchemaInfo, error)
 	Get(ctx context.Context, namespace string, schemaName string, version int32) (*schema.Metadata, []byte, error)
+	GetAssembled(ctx context.Context, namespace string, schemaName string, version int32) (*schema.Metadata, []byte, error)
 	Delete(ctx context.Context, namespace string, schemaName string) error
 	DeleteVersion(ctx context.Context, namespace string, schemaName string, version int32) error
+	Restore(ctx context.Context, namespace string, schemaName string) error
+	RestoreVersion(ctx context.Context, namespace string, schemaName string, version int32) error
 	GetLatest(ctx context.Context, namespace string, schemaName string) (*schema.Metadata, []byte, error)
 	GetMetadata(ctx context.Context, namespace, schemaName string) (*schema.Metadata, error)
 	UpdateMetadata(ctx context.Context, namespace, schemaName string, meta *schema.Metadata) (*schema.Metadata, error)
 	List(ctx context.Context, namespaceID string) ([]schema.Schema, error)
 	ListVersions(ctx context.Context, namespaceID string, schemaName string) ([]int32, error)
+	ListVersionDetails(ctx context.Context, namespaceID string, schemaName string, pageSize int, pageToken string) ([]schema.Version, string, error)
+	UpdateVersionState(ctx context.Context, namespaceID, schemaName string, version int32, state schema.VersionState) (schema.Version, error)
+	GetGlobalID(ctx context.Context, namespace, schemaName string, version int32) (int32, error)
+	GetVersionRef(ctx context.Context, namespace, schemaName string, version int32) (schema.VersionRef, error)
+	GetLatestVersionRef(ctx context.Context, namespace, schemaName string) (schema.VersionRef, error)
+	GetByGlobalID(ctx context.Context, globalID int32) (schema.VersionRef, *schema.Metadata, []byte, error)
+	ListReferences(ctx context.Context, namespace, schemaName string, version int32) ([]schema.Reference, []schema.Reference, error)
+	Diff(ctx context.Context, namespace, schemaName string, fromVersion, toVersion int32, compatibility string) (*schema.SchemaDiff, error)
+	Watch(ctx context.Context, namespace, schemaName string) <-chan schema.Event
 }
 
 type SearchService interface {
 	Search(ctx context.Context, req *search.SearchRequest) (*search.SearchResponse, error)
 }
 
+type WebhookService interface {
+	Create(ctx context.Context, sub webhook.Subscription) (webhook.Subscription, error)
+	List(ctx context.Context, namespaceID string) ([]webhook.Subscription, error)
+	Delete(ctx context.Context, namespaceID string, id int64) error
+	ListDeliveries(ctx context.Context, namespaceID string, subscriptionID int64) ([]webhook.Delivery, error)
+}
+
+type AuthService interface {
+	Authenticate(ctx context.Context, token string) (string, error)
+	Authorize(ctx context.Context, principal, namespaceID string, role auth.Role) error
+	Grant(ctx context.Context, binding auth.RoleBinding) (auth.RoleBinding, error)
+	Revoke(ctx context.Context, namespaceID, principal string) error
+	List(ctx context.Context, namespaceID string) ([]auth.RoleBinding, error)
+	AuthorizedNamespaces(ctx context.Context, principal string, required auth.Role) (map[string]bool, bool, error)
+}
+
+type AuditService interface {
+	List(ctx context.Context, namespaceID, schemaName string, pageSize int, pageToken string) ([]audit.Event, string, error)
+}
+
+type RetentionService interface {
+	List(ctx context.Context, namespaceID string) ([]retention.Item, error)
+}
+
 type API struct {
 	stencilv1beta1.UnimplementedStencilServiceServer
 	grpc_health_v1.UnimplementedHealthServer
 	namespace NamespaceService
 	schema    SchemaService
 	search    SearchService
+	webhook   WebhookService
+	auth      AuthService
+	audit     AuditService
+	retention RetentionService
 }
 
-func NewAPI(namespace NamespaceService, schema SchemaService, search SearchService) *API {
+// NewAPI creates API handlers. Authentication and authorization are skipped if auth is nil.
+func NewAPI(namespace NamespaceService, schema SchemaService, search SearchService, webhook WebhookService, auth AuthService, audit AuditService, retention RetentionService) *API {
 	return &API{
 		namespace: namespace,
 		schema:    schema,
 		search:    search,
+		webhook:   webhook,
+		auth:      auth,
+		audit:     audit,
+		retention: retention,
 	}
 }
 
@@ -64,10 +131,12 @@ func (a *API) RegisterSchemaHandlers(mux *runtime.ServeMux, app *newrelic.Applic
 	mux.HandlePath("GET", "/ping", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
 		fmt.Fprint(w, "pong")
 	})
-	mux.HandlePath(wrapHandler(app, "GET", "/v1beta1/namespaces/{namespace}/schemas/{name}/versions/{version}", handleSchemaResponse(mux, a.HTTPGetSchema)))
-	mux.HandlePath(wrapHandler(app, "GET", "/v1beta1/namespaces/{namespace}/schemas/{name}", handleSchemaResponse(mux, a.HTTPLatestSchema)))
-	mux.HandlePath(wrapHandler(app, "POST", "/v1beta1/namespaces/{namespace}/schemas/{name}", wrapErrHandler(mux, a.HTTPUpload)))
-	mux.HandlePath(wrapHandler(app, "POST", "/v1beta1/namespaces/{namespace}/schemas/{name}/check", wrapErrHandler(mux, a.HTTPCheckCompatibility)))
+	onErr := httpErrorWriter(mux)
+	mux.HandlePath(wrapHandler(app, "GET", "/v1beta1/schemas/ids/{id}", a.withAuth("", onErr, handleSchemaResponse(mux, a.HTTPGetSchemaByGlobalID))))
+	mux.HandlePath(wrapHandler(app, "GET", "/v1beta1/namespaces/{namespace}/schemas/{name}/versions/{version}", a.withAuth(auth.RoleReader, onErr, handleSchemaResponse(mux, a.HTTPGetSchema))))
+	mux.HandlePath(wrapHandler(app, "GET", "/v1beta1/namespaces/{namespace}/schemas/{name}", a.withAuth(auth.RoleReader, onErr, handleSchemaResponse(mux, a.HTTPLatestSchema))))
+	mux.HandlePath(wrapHandler(app, "POST", "/v1beta1/namespaces/{namespace}/schemas/{name}", a.withAuth(auth.RoleWriter, onErr, wrapErrHandler(mux, a.HTTPUpload))))
+	mux.HandlePath(wrapHandler(app, "POST", "/v1beta1/namespaces/{namespace}/schemas/{name}/check", a.withAuth(auth.RoleReader, onErr, wrapErrHandler(mux, a.HTTPCheckCompatibility))))
 }
 
 func handleSchemaResponse(mux *runtime.ServeMux, getSchemaFn getSchemaData) runtime.HandlerFunc {
@@ -79,10 +148,11 @@ func handleSchemaResponse(mux *runtime.ServeMux, getSchemaFn getSchemaData) runt
 			return
 		}
 		contentType := "application/json"
-		if meta.Format == "FORMAT_PROTOBUF" {
-			contentType = "application/octet-stream"
+		if format, ok := provider.Lookup(meta.Format); ok {
+			contentType = format.ContentType()
 		}
 		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("X-Format", meta.Format)
 		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
 		w.WriteHeader(http.StatusOK)
 		w.Write(data)
@@ -7,11 +7,42 @@ import (
 )
 
 func setup() (*mocks.NamespaceService, *mocks.SchemaService, *mocks.SearchService, *runtime.ServeMux, *api.API) {
+	nsService, schemaService, searchService, _, mux, v1beta1 := setupWithWebhook()
+	return nsService, schemaService, searchService, mux, v1beta1
+}
+
+func setupWithWebhook() (*mocks.NamespaceService, *mocks.SchemaService, *mocks.SearchService, *mocks.WebhookService, *runtime.ServeMux, *api.API) {
 	nsService := &mocks.NamespaceService{}
 	schemaService := &mocks.SchemaService{}
 	searchService := &mocks.SearchService{}
+	webhookService := &mocks.WebhookService{}
 	mux := runtime.NewServeMux()
-	v1beta1 := api.NewAPI(nsService, schemaService, searchService)
+	v1beta1 := api.NewAPI(nsService, schemaService, searchService, webhookService, nil, &mocks.AuditService{}, &mocks.RetentionService{})
 	v1beta1.RegisterSchemaHandlers(mux, nil)
-	return nsService, schemaService, searchService, mux, v1beta1
+	v1beta1.RegisterConfluentHandlers(mux, nil)
+	return nsService, schemaService, searchService, webhookService, mux, v1beta1
+}
+
+func setupWithAuth() (*mocks.SchemaService, *mocks.AuthService, *runtime.ServeMux, *api.API) {
+	schemaService := &mocks.SchemaService{}
+	authService := &mocks.AuthService{}
+	mux := runtime.NewServeMux()
+	v1beta1 := api.NewAPI(&mocks.NamespaceService{}, schemaService, &mocks.SearchService{}, &mocks.WebhookService{}, authService, &mocks.AuditService{}, &mocks.RetentionService{})
+	v1beta1.RegisterSchemaHandlers(mux, nil)
+	v1beta1.RegisterConfluentHandlers(mux, nil)
+	return schemaService, authService, mux, v1beta1
+}
+
+func setupWithAudit() (*mocks.AuditService, *api.API) {
+	auditService := &mocks.AuditService{}
+	v1beta1 := api.NewAPI(&mocks.NamespaceService{}, &mocks.SchemaService{}, &mocks.SearchService{}, &mocks.WebhookService{}, nil, auditService, &mocks.RetentionService{})
+	return auditService, v1beta1
+}
+
+func setupWithRetention() (*mocks.NamespaceService, *mocks.SchemaService, *mocks.RetentionService, *api.API) {
+	nsService := &mocks.NamespaceService{}
+	schemaService := &mocks.SchemaService{}
+	retentionService := &mocks.RetentionService{}
+	v1beta1 := api.NewAPI(nsService, schemaService, &mocks.SearchService{}, &mocks.WebhookService{}, nil, &mocks.AuditService{}, retentionService)
+	return nsService, schemaService, retentionService, v1beta1
 }
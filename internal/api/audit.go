@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+
+	"github.com/raystack/stencil/core/audit"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toStruct(data []byte) (*structpb.Struct, error) {
+	if data == nil {
+		return nil, nil
+	}
+	s := &structpb.Struct{}
+	return s, protojson.Unmarshal(data, s)
+}
+
+func auditEventToProto(e audit.Event) (*stencilv1beta1.AuditEvent, error) {
+	before, err := toStruct(e.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := toStruct(e.After)
+	if err != nil {
+		return nil, err
+	}
+	return &stencilv1beta1.AuditEvent{
+		Id:          e.ID,
+		Actor:       e.Actor,
+		RequestId:   e.RequestID,
+		Operation:   string(e.Operation),
+		NamespaceId: e.NamespaceID,
+		SchemaId:    e.SchemaName,
+		Version:     e.Version,
+		Before:      before,
+		After:       after,
+		CreatedAt:   timestamppb.New(e.CreatedAt),
+	}, nil
+}
+
+// ListAuditEvents returns mutations made to namespaces and schemas, latest first
+func (a *API) ListAuditEvents(ctx context.Context, in *stencilv1beta1.ListAuditEventsRequest) (*stencilv1beta1.ListAuditEventsResponse, error) {
+	events, next, err := a.audit.List(ctx, in.GetNamespaceId(), in.GetSchemaId(), int(in.GetPageSize()), in.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	res := &stencilv1beta1.ListAuditEventsResponse{NextPageToken: next}
+	for _, e := range events {
+		event, err := auditEventToProto(e)
+		if err != nil {
+			return nil, err
+		}
+		res.Events = append(res.Events, event)
+	}
+	return res, nil
+}
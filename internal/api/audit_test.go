@@ -0,0 +1,41 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raystack/stencil/core/audit"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	req := &stencilv1beta1.ListAuditEventsRequest{NamespaceId: "ns", SchemaId: "sc", PageSize: 2, PageToken: "10"}
+	t.Run("should return error if list fails", func(t *testing.T) {
+		auditSvc, api := setupWithAudit()
+		auditSvc.On("List", mock.Anything, "ns", "sc", 2, "10").Return(nil, "", audit.ErrInvalidPageToken)
+		_, err := api.ListAuditEvents(ctx, req)
+		assert.ErrorIs(t, err, audit.ErrInvalidPageToken)
+	})
+	t.Run("should convert before and after states", func(t *testing.T) {
+		auditSvc, api := setupWithAudit()
+		events := []audit.Event{
+			{ID: 9, Actor: "user1", RequestID: "req1", Operation: audit.OperationUpdateSchemaMetadata, NamespaceID: "ns", SchemaName: "sc", Before: []byte(`{"compatibility": "COMPATIBILITY_FULL"}`), After: []byte(`{"compatibility": "COMPATIBILITY_BACKWARD"}`), CreatedAt: time.Now()},
+			{ID: 8, Operation: audit.OperationCreateVersion, NamespaceID: "ns", SchemaName: "sc", Version: 1, After: []byte(`{"version_id": "abc"}`), CreatedAt: time.Now()},
+		}
+		auditSvc.On("List", mock.Anything, "ns", "sc", 2, "10").Return(events, "8", nil)
+		res, err := api.ListAuditEvents(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "8", res.NextPageToken)
+		assert.Equal(t, 2, len(res.Events))
+		assert.Equal(t, "user1", res.Events[0].Actor)
+		assert.Equal(t, "req1", res.Events[0].RequestId)
+		assert.Equal(t, "COMPATIBILITY_FULL", res.Events[0].Before.AsMap()["compatibility"])
+		assert.Equal(t, "COMPATIBILITY_BACKWARD", res.Events[0].After.AsMap()["compatibility"])
+		assert.Nil(t, res.Events[1].Before)
+		assert.Equal(t, int32(1), res.Events[1].Version)
+	})
+}
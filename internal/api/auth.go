@@ -0,0 +1,268 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/raystack/stencil/core/auth"
+	"github.com/raystack/stencil/pkg/actor"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+	serviceMethodPrefix = "/raystack.stencil.v1beta1.StencilService/"
+)
+
+var errAuthDisabled = status.Error(codes.FailedPrecondition, "authentication is not enabled on the server")
+
+// authRule describes role required to call a method. Empty role only requires caller to be authenticated,
+// handlers of such methods authorize the caller themselves once namespace is known.
+type authRule struct {
+	role      auth.Role
+	namespace func(req interface{}) string
+}
+
+var publicMethods = map[string]bool{
+	"/grpc.health.v1.Health/Check": true,
+	"/grpc.health.v1.Health/Watch": true,
+}
+
+var methodRules = map[string]authRule{
+	// namespaces are filtered to ones caller can read
+	"ListNamespaces":        {},
+	"GetNamespace":          {role: auth.RoleReader, namespace: requestID},
+	"CreateNamespace":       {role: auth.RoleAdmin, namespace: serverWide},
+	"UpdateNamespace":       {role: auth.RoleAdmin, namespace: requestID},
+	"DeleteNamespace":       {role: auth.RoleAdmin, namespace: requestID},
+	"ListSchemas":           {role: auth.RoleReader, namespace: requestID},
+	"CreateSchema":          {role: auth.RoleWriter, namespace: requestNamespaceID},
+	"CheckCompatibility":    {role: auth.RoleReader, namespace: requestNamespaceID},
+	"GetSchemaMetadata":     {role: auth.RoleReader, namespace: requestNamespaceID},
+	"UpdateSchemaMetadata":  {role: auth.RoleWriter, namespace: requestNamespaceID},
+	"GetLatestSchema":       {role: auth.RoleReader, namespace: requestNamespaceID},
+	"DeleteSchema":          {role: auth.RoleAdmin, namespace: requestNamespaceID},
+	"GetSchema":             {role: auth.RoleReader, namespace: requestNamespaceID},
+	"GetSchemaByGlobalID":   {},
+	"ListVersions":          {role: auth.RoleReader, namespace: requestNamespaceID},
+	"DeleteVersion":         {role: auth.RoleWriter, namespace: requestNamespaceID},
+	"WatchSchemas":          {role: auth.RoleReader, namespace: requestNamespaceID},
+	"CreateWebhook":         {role: auth.RoleAdmin, namespace: requestNamespaceID},
+	"ListWebhooks":          {role: auth.RoleAdmin, namespace: requestNamespaceID},
+	"DeleteWebhook":         {role: auth.RoleAdmin, namespace: requestNamespaceID},
+	"ListWebhookDeliveries": {role: auth.RoleAdmin, namespace: requestNamespaceID},
+	"ListRoleBindings":      {role: auth.RoleAdmin, namespace: requestNamespaceID},
+	"GrantRole":             {role: auth.RoleAdmin, namespace: requestNamespaceID},
+	"RevokeRole":            {role: auth.RoleAdmin, namespace: requestNamespaceID},
+	"ListAuditEvents":       {role: auth.RoleAdmin, namespace: requestNamespaceID},
+	"RestoreNamespace":      {role: auth.RoleAdmin, namespace: requestID},
+	"RestoreSchema":         {role: auth.RoleAdmin, namespace: requestNamespaceID},
+	"RestoreVersion":        {role: auth.RoleWriter, namespace: requestNamespaceID},
+	"ListDeletedItems":      {role: auth.RoleReader, namespace: requestNamespaceID},
+	"UpdateVersionState":    {role: auth.RoleWriter, namespace: requestNamespaceID},
+	"ListReferences":        {role: auth.RoleReader, namespace: requestNamespaceID},
+	"DiffSchemaVersions":    {role: auth.RoleReader, namespace: requestNamespaceID},
+	// search without namespace spans all namespaces, so it is allowed only for admins
+	"Search": {role: auth.RoleReader, namespace: requestNamespaceID},
+}
+
+func requestID(req interface{}) string {
+	if r, ok := req.(interface{ GetId() string }); ok {
+		return r.GetId()
+	}
+	return ""
+}
+
+func requestNamespaceID(req interface{}) string {
+	if r, ok := req.(interface{ GetNamespaceId() string }); ok {
+		return r.GetNamespaceId()
+	}
+	return ""
+}
+
+func serverWide(interface{}) string {
+	return ""
+}
+
+func bearerToken(value string) string {
+	if len(value) > len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+		return value[len(bearerPrefix):]
+	}
+	return ""
+}
+
+// authenticate returns context carrying principal identified by authorization header value
+func (a *API) authenticate(ctx context.Context, authorization string) (context.Context, error) {
+	principal, err := a.auth.Authenticate(ctx, bearerToken(authorization))
+	if err != nil {
+		return ctx, err
+	}
+	return actor.WithActor(ctx, principal), nil
+}
+
+// authorize checks authenticated caller has role on the namespace, it's a no-op if auth is disabled
+func (a *API) authorize(ctx context.Context, namespaceID string, role auth.Role) error {
+	if a.auth == nil {
+		return nil
+	}
+	return a.auth.Authorize(ctx, actor.FromContext(ctx), namespaceID, role)
+}
+
+func (a *API) authorizeMethod(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	if publicMethods[method] {
+		return ctx, nil
+	}
+	rule, ok := methodRules[strings.TrimPrefix(method, serviceMethodPrefix)]
+	if !ok || !strings.HasPrefix(method, serviceMethodPrefix) {
+		return ctx, auth.ErrPermissionDenied
+	}
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationHeader); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+	ctx, err := a.authenticate(ctx, authorization)
+	if err != nil {
+		return ctx, err
+	}
+	if rule.role == "" {
+		return ctx, nil
+	}
+	return ctx, a.authorize(ctx, rule.namespace(req), rule.role)
+}
+
+// UnaryAuthInterceptor authenticates caller using bearer token and checks caller has role required by the method
+func (a *API) UnaryAuthInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if a.auth == nil {
+			return handler(ctx, req)
+		}
+		ctx, err := a.authorizeMethod(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor is the streaming counterpart of UnaryAuthInterceptor.
+// Request is authorized when handler receives it, since namespace is only known at that point.
+func (a *API) StreamAuthInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if a.auth == nil {
+			return handler(srv, ss)
+		}
+		return handler(srv, &authServerStream{WrappedServerStream: grpc_middleware.WrapServerStream(ss), api: a, method: info.FullMethod})
+	}
+}
+
+type authServerStream struct {
+	*grpc_middleware.WrappedServerStream
+	api        *API
+	method     string
+	authorized bool
+}
+
+func (s *authServerStream) RecvMsg(m interface{}) error {
+	if err := s.WrappedServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.authorized {
+		return nil
+	}
+	ctx, err := s.api.authorizeMethod(s.WrappedContext, s.method, m)
+	if err != nil {
+		return err
+	}
+	s.WrappedContext = ctx
+	s.authorized = true
+	return nil
+}
+
+// withAuth authenticates HTTP request and checks caller has role on the namespace in request path.
+// Errors are written with onErr, so that each API can respond in its own error format.
+func (a *API) withAuth(role auth.Role, onErr func(http.ResponseWriter, *http.Request, error), handler runtime.HandlerFunc) runtime.HandlerFunc {
+	if a.auth == nil {
+		return handler
+	}
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx, err := a.authenticate(r.Context(), r.Header.Get(authorizationHeader))
+		if err == nil && role != "" {
+			err = a.authorize(ctx, pathParams["namespace"], role)
+		}
+		if err != nil {
+			onErr(w, r, err)
+			return
+		}
+		handler(w, r.WithContext(ctx), pathParams)
+	}
+}
+
+func httpErrorWriter(mux *runtime.ServeMux) func(http.ResponseWriter, *http.Request, error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+	}
+}
+
+func roleBindingToProto(binding auth.RoleBinding) *stencilv1beta1.RoleBinding {
+	return &stencilv1beta1.RoleBinding{
+		NamespaceId: binding.NamespaceID,
+		Principal:   binding.Principal,
+		Role:        stencilv1beta1.RoleBinding_Role(stencilv1beta1.RoleBinding_Role_value[string(binding.Role)]),
+		CreatedAt:   timestamppb.New(binding.CreatedAt),
+		UpdatedAt:   timestamppb.New(binding.UpdatedAt),
+	}
+}
+
+func (a *API) ListRoleBindings(ctx context.Context, in *stencilv1beta1.ListRoleBindingsRequest) (*stencilv1beta1.ListRoleBindingsResponse, error) {
+	if a.auth == nil {
+		return nil, errAuthDisabled
+	}
+	bindings, err := a.auth.List(ctx, in.GetNamespaceId())
+	if err != nil {
+		return nil, err
+	}
+	var res []*stencilv1beta1.RoleBinding
+	for _, binding := range bindings {
+		res = append(res, roleBindingToProto(binding))
+	}
+	return &stencilv1beta1.ListRoleBindingsResponse{RoleBindings: res}, nil
+}
+
+func (a *API) GrantRole(ctx context.Context, in *stencilv1beta1.GrantRoleRequest) (*stencilv1beta1.GrantRoleResponse, error) {
+	if a.auth == nil {
+		return nil, errAuthDisabled
+	}
+	binding, err := a.auth.Grant(ctx, auth.RoleBinding{
+		NamespaceID: in.GetNamespaceId(),
+		Principal:   in.GetPrincipal(),
+		Role:        auth.Role(in.GetRole().String()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &stencilv1beta1.GrantRoleResponse{RoleBinding: roleBindingToProto(binding)}, nil
+}
+
+func (a *API) RevokeRole(ctx context.Context, in *stencilv1beta1.RevokeRoleRequest) (*stencilv1beta1.RevokeRoleResponse, error) {
+	if a.auth == nil {
+		return nil, errAuthDisabled
+	}
+	err := a.auth.Revoke(ctx, in.GetNamespaceId(), in.GetPrincipal())
+	message := "success"
+	if err != nil {
+		message = "failed"
+	}
+	return &stencilv1beta1.RevokeRoleResponse{Message: message}, err
+}
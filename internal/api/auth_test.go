@@ -0,0 +1,184 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/raystack/stencil/core/auth"
+	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/internal/api"
+	"github.com/raystack/stencil/internal/api/mocks"
+	"github.com/raystack/stencil/pkg/actor"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func callUnary(api interface {
+	UnaryAuthInterceptor() grpc.UnaryServerInterceptor
+}, ctx context.Context, method string, req interface{}) (string, error) {
+	var principal string
+	_, err := api.UnaryAuthInterceptor()(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		principal = actor.FromContext(ctx)
+		return nil, nil
+	})
+	return principal, err
+}
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	method := "/raystack.stencil.v1beta1.StencilService/CreateSchema"
+	req := &stencilv1beta1.CreateSchemaRequest{NamespaceId: "ns", SchemaId: "sc"}
+	t.Run("should skip auth if disabled", func(t *testing.T) {
+		_, _, _, _, api := setup()
+		_, err := callUnary(api, context.Background(), method, req)
+		assert.NoError(t, err)
+	})
+	t.Run("should allow health checks without credentials", func(t *testing.T) {
+		_, _, _, api := setupWithAuth()
+		_, err := callUnary(api, context.Background(), "/grpc.health.v1.Health/Check", nil)
+		assert.NoError(t, err)
+	})
+	t.Run("should reject requests without token", func(t *testing.T) {
+		_, authSvc, _, api := setupWithAuth()
+		authSvc.On("Authenticate", mock.Anything, "").Return("", auth.ErrUnauthenticated)
+		_, err := callUnary(api, context.Background(), method, req)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("should check role required by the method on request namespace", func(t *testing.T) {
+		_, authSvc, _, api := setupWithAuth()
+		authSvc.On("Authenticate", mock.Anything, "token1").Return("user1", nil)
+		authSvc.On("Authorize", mock.Anything, "user1", "ns", auth.RoleWriter).Return(auth.ErrPermissionDenied)
+		_, err := callUnary(api, withToken("token1"), method, req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	t.Run("should pass authenticated principal to handler", func(t *testing.T) {
+		_, authSvc, _, api := setupWithAuth()
+		authSvc.On("Authenticate", mock.Anything, "token1").Return("user1", nil)
+		authSvc.On("Authorize", mock.Anything, "user1", "ns", auth.RoleAdmin).Return(nil)
+		principal, err := callUnary(api, withToken("token1"), "/raystack.stencil.v1beta1.StencilService/DeleteNamespace", &stencilv1beta1.DeleteNamespaceRequest{Id: "ns"})
+		assert.NoError(t, err)
+		assert.Equal(t, "user1", principal)
+	})
+	t.Run("should deny unknown methods", func(t *testing.T) {
+		_, _, _, api := setupWithAuth()
+		_, err := callUnary(api, withToken("token1"), "/raystack.stencil.v1beta1.StencilService/Unknown", nil)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestGetSchemaByGlobalIDAuthorization(t *testing.T) {
+	t.Run("should check caller can read namespace of the schema", func(t *testing.T) {
+		schemaSvc, authSvc, _, api := setupWithAuth()
+		ref := schema.VersionRef{NamespaceID: "namespace1", Name: "scName", Version: 2, GlobalID: 7}
+		schemaSvc.On("GetByGlobalID", mock.Anything, int32(7)).Return(ref, &schema.Metadata{Format: "FORMAT_AVRO"}, []byte("data"), nil)
+		authSvc.On("Authorize", mock.Anything, "user1", "namespace1", auth.RoleReader).Return(auth.ErrPermissionDenied)
+		_, err := api.GetSchemaByGlobalID(actor.WithActor(context.Background(), "user1"), &stencilv1beta1.GetSchemaByGlobalIDRequest{GlobalId: 7})
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+	})
+}
+
+func TestListNamespacesAuthorization(t *testing.T) {
+	namespaces := []namespace.Namespace{{ID: "namespace1"}, {ID: "namespace2"}}
+	setupNamespaces := func() (*mocks.AuthService, *api.API) {
+		nsSvc := &mocks.NamespaceService{}
+		authSvc := &mocks.AuthService{}
+		nsSvc.On("List", mock.Anything).Return(namespaces, nil)
+		return authSvc, api.NewAPI(nsSvc, &mocks.SchemaService{}, &mocks.SearchService{}, &mocks.WebhookService{}, authSvc, &mocks.AuditService{}, &mocks.RetentionService{})
+	}
+	t.Run("should list only namespaces caller can read", func(t *testing.T) {
+		authSvc, v1beta1 := setupNamespaces()
+		authSvc.On("AuthorizedNamespaces", mock.Anything, "user1", auth.RoleReader).Return(map[string]bool{"namespace2": true}, false, nil)
+		res, err := v1beta1.ListNamespaces(actor.WithActor(context.Background(), "user1"), &stencilv1beta1.ListNamespacesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(res.Namespaces))
+		assert.Equal(t, "namespace2", res.Namespaces[0].Id)
+	})
+	t.Run("should list all namespaces to admins", func(t *testing.T) {
+		authSvc, v1beta1 := setupNamespaces()
+		authSvc.On("AuthorizedNamespaces", mock.Anything, "admin", auth.RoleReader).Return(nil, true, nil)
+		res, err := v1beta1.ListNamespaces(actor.WithActor(context.Background(), "admin"), &stencilv1beta1.ListNamespacesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(res.Namespaces))
+	})
+	t.Run("should return error if role bindings can not be fetched", func(t *testing.T) {
+		authSvc, v1beta1 := setupNamespaces()
+		authSvc.On("AuthorizedNamespaces", mock.Anything, "user1", auth.RoleReader).Return(nil, false, errors.New("db error"))
+		_, err := v1beta1.ListNamespaces(actor.WithActor(context.Background(), "user1"), &stencilv1beta1.ListNamespacesRequest{})
+		assert.EqualError(t, err, "db error")
+	})
+}
+
+func TestHTTPAuth(t *testing.T) {
+	t.Run("should return unauthenticated if token is invalid", func(t *testing.T) {
+		_, authSvc, mux, _ := setupWithAuth()
+		authSvc.On("Authenticate", mock.Anything, "invalid").Return("", auth.ErrUnauthenticated)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/v1beta1/namespaces/ns/schemas/sc", nil)
+		req.Header.Set("Authorization", "Bearer invalid")
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 401, w.Code)
+	})
+	t.Run("should check writer role for upload", func(t *testing.T) {
+		_, authSvc, mux, _ := setupWithAuth()
+		authSvc.On("Authenticate", mock.Anything, "token1").Return("user1", nil)
+		authSvc.On("Authorize", mock.Anything, "user1", "ns", auth.RoleWriter).Return(auth.ErrPermissionDenied)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/v1beta1/namespaces/ns/schemas/sc", nil)
+		req.Header.Set("Authorization", "Bearer token1")
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 403, w.Code)
+	})
+	t.Run("should serve schema to authorized reader", func(t *testing.T) {
+		schemaSvc, authSvc, mux, _ := setupWithAuth()
+		authSvc.On("Authenticate", mock.Anything, "token1").Return("user1", nil)
+		authSvc.On("Authorize", mock.Anything, "user1", "ns", auth.RoleReader).Return(nil)
+		schemaSvc.On("GetLatestVersionRef", mock.Anything, "ns", "sc").Return(schema.VersionRef{Version: 2}, nil)
+		schemaSvc.On("Get", mock.Anything, "ns", "sc", int32(2)).Return(&schema.Metadata{Format: "FORMAT_JSON"}, []byte("{}"), nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/v1beta1/namespaces/ns/schemas/sc", nil)
+		req.Header.Set("Authorization", "Bearer token1")
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+	})
+	t.Run("should return confluent formatted error for confluent API", func(t *testing.T) {
+		_, authSvc, mux, _ := setupWithAuth()
+		authSvc.On("Authenticate", mock.Anything, "token1").Return("user1", nil)
+		authSvc.On("Authorize", mock.Anything, "user1", "ns", auth.RoleAdmin).Return(auth.ErrPermissionDenied)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/confluent/ns/subjects/sc", nil)
+		req.Header.Set("Authorization", "Bearer token1")
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 403, w.Code)
+		assert.JSONEq(t, `{"error_code": 40301, "message": "caller does not have required role on the namespace"}`, w.Body.String())
+	})
+}
+
+func TestGrantRole(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should return error if auth is disabled", func(t *testing.T) {
+		_, _, _, _, api := setup()
+		_, err := api.GrantRole(ctx, &stencilv1beta1.GrantRoleRequest{NamespaceId: "ns", Principal: "user1", Role: stencilv1beta1.RoleBinding_ROLE_WRITER})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("should grant role on the namespace", func(t *testing.T) {
+		_, authSvc, _, api := setupWithAuth()
+		binding := auth.RoleBinding{NamespaceID: "ns", Principal: "user1", Role: auth.RoleWriter}
+		authSvc.On("Grant", mock.Anything, binding).Return(binding, nil)
+		res, err := api.GrantRole(ctx, &stencilv1beta1.GrantRoleRequest{NamespaceId: "ns", Principal: "user1", Role: stencilv1beta1.RoleBinding_ROLE_WRITER})
+		assert.NoError(t, err)
+		assert.Equal(t, stencilv1beta1.RoleBinding_ROLE_WRITER, res.RoleBinding.Role)
+		assert.Equal(t, "user1", res.RoleBinding.Principal)
+	})
+}
@@ -0,0 +1,529 @@
+package api
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/raystack/stencil/core/auth"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Confluent schema registry error codes
+const (
+	confluentUnauthorized         = 40101
+	confluentForbidden            = 40301
+	confluentSubjectNotFound      = 40401
+	confluentVersionNotFound      = 40402
+	confluentSchemaNotFound       = 40403
+	confluentIncompatibleSchema   = 409
+	confluentInvalidSchema        = 42201
+	confluentInvalidVersion       = 42202
+	confluentInvalidCompatibility = 42203
+	confluentUnprocessableEntity  = 422
+	confluentInternalServerError  = 50001
+)
+
+const (
+	confluentContentType         = "application/vnd.schemaregistry.v1+json"
+	confluentLatestVersion       = "latest"
+	confluentLatestVersionNumber = "-1"
+	confluentDefaultSchemaType   = "AVRO"
+	confluentCompatibilityNone   = "NONE"
+)
+
+var confluentSchemaTypes = map[string]string{
+	"AVRO": "FORMAT_AVRO",
+	"JSON": "FORMAT_JSON",
+}
+
+var confluentCompatibilities = map[string]string{
+	"NONE":                "COMPATIBILITY_UNSPECIFIED",
+	"BACKWARD":            "COMPATIBILITY_BACKWARD",
+	"BACKWARD_TRANSITIVE": "COMPATIBILITY_BACKWARD_TRANSITIVE",
+	"FORWARD":             "COMPATIBILITY_FORWARD",
+	"FORWARD_TRANSITIVE":  "COMPATIBILITY_FORWARD_TRANSITIVE",
+	"FULL":                "COMPATIBILITY_FULL",
+	"FULL_TRANSITIVE":     "COMPATIBILITY_FULL_TRANSITIVE",
+}
+
+type confluentError struct {
+	HTTPStatus int    `json:"-"`
+	ErrorCode  int    `json:"error_code"`
+	Message    string `json:"message"`
+}
+
+func (e *confluentError) Error() string {
+	return e.Message
+}
+
+type confluentSchemaRequest struct {
+	Schema     string            `json:"schema"`
+	SchemaType string            `json:"schemaType,omitempty"`
+	References []json.RawMessage `json:"references,omitempty"`
+}
+
+type confluentSchemaResponse struct {
+	Subject    string `json:"subject,omitempty"`
+	ID         int32  `json:"id,omitempty"`
+	Version    int32  `json:"version,omitempty"`
+	SchemaType string `json:"schemaType,omitempty"`
+	Schema     string `json:"schema"`
+}
+
+type confluentSubjectVersion struct {
+	Subject string `json:"subject"`
+	Version int32  `json:"version"`
+}
+
+type confluentConfig struct {
+	CompatibilityLevel string `json:"compatibilityLevel,omitempty"`
+	Compatibility      string `json:"compatibility,omitempty"`
+}
+
+type confluentCompatibilityResponse struct {
+	IsCompatible bool     `json:"is_compatible"`
+	Messages     []string `json:"messages,omitempty"`
+}
+
+type confluentHandlerFunc func(*http.Request, map[string]string) (interface{}, error)
+
+// RegisterConfluentHandlers registers Confluent schema registry compatible HTTP handlers.
+// Each namespace is exposed as a separate registry under /confluent/{namespace}, subjects map to schema names in that namespace.
+func (a *API) RegisterConfluentHandlers(mux *runtime.ServeMux, app *newrelic.Application) {
+	prefix := "/confluent/{namespace}"
+	onErr := func(w http.ResponseWriter, r *http.Request, err error) { writeConfluentError(w, err) }
+	mux.HandlePath(wrapHandler(app, "GET", prefix+"/schemas/types", a.withAuth(auth.RoleReader, onErr, confluentHandler(a.confluentSchemaTypes))))
+	mux.HandlePath(wrapHandler(app, "GET", prefix+"/schemas/ids/{id}", a.withAuth(auth.RoleReader, onErr, confluentHandler(a.confluentGetSchemaByID))))
+	mux.HandlePath(wrapHandler(app, "GET", prefix+"/schemas/ids/{id}/versions", a.withAuth(auth.RoleReader, onErr, confluentHandler(a.confluentGetSchemaVersionsByID))))
+	mux.HandlePath(wrapHandler(app, "GET", prefix+"/subjects", a.withAuth(auth.RoleReader, onErr, confluentHandler(a.confluentListSubjects))))
+	mux.HandlePath(wrapHandler(app, "POST", prefix+"/subjects/{subject}", a.withAuth(auth.RoleReader, onErr, confluentHandler(a.confluentLookupSchema))))
+	mux.HandlePath(wrapHandler(app, "DELETE", prefix+"/subjects/{subject}", a.withAuth(auth.RoleAdmin, onErr, confluentHandler(a.confluentDeleteSubject))))
+	mux.HandlePath(wrapHandler(app, "GET", prefix+"/subjects/{subject}/versions", a.withAuth(auth.RoleReader, onErr, confluentHandler(a.confluentListVersions))))
+	mux.HandlePath(wrapHandler(app, "POST", prefix+"/subjects/{subject}/versions", a.withAuth(auth.RoleWriter, onErr, confluentHandler(a.confluentRegisterSchema))))
+	mux.HandlePath(wrapHandler(app, "GET", prefix+"/subjects/{subject}/versions/{version}", a.withAuth(auth.RoleReader, onErr, confluentHandler(a.confluentGetVersion))))
+	mux.HandlePath(wrapHandler(app, "DELETE", prefix+"/subjects/{subject}/versions/{version}", a.withAuth(auth.RoleWriter, onErr, confluentHandler(a.confluentDeleteVersion))))
+	mux.HandlePath(wrapHandler(app, "GET", prefix+"/subjects/{subject}/versions/{version}/schema", a.withAuth(auth.RoleReader, onErr, confluentRawSchemaHandler(a.confluentGetRawSchema))))
+	mux.HandlePath(wrapHandler(app, "POST", prefix+"/compatibility/subjects/{subject}/versions/{version}", a.withAuth(auth.RoleReader, onErr, confluentHandler(a.confluentCheckCompatibility))))
+	mux.HandlePath(wrapHandler(app, "GET", prefix+"/config", a.withAuth(auth.RoleReader, onErr, confluentHandler(a.confluentGetConfig))))
+	mux.HandlePath(wrapHandler(app, "GET", prefix+"/config/{subject}", a.withAuth(auth.RoleReader, onErr, confluentHandler(a.confluentGetSubjectConfig))))
+	mux.HandlePath(wrapHandler(app, "PUT", prefix+"/config/{subject}", a.withAuth(auth.RoleWriter, onErr, confluentHandler(a.confluentUpdateSubjectConfig))))
+}
+
+func confluentHandler(handler confluentHandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		resp, err := handler(r, pathParams)
+		if err != nil {
+			writeConfluentError(w, err)
+			return
+		}
+		data, _ := json.Marshal(resp)
+		w.Header().Set("Content-Type", confluentContentType)
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	}
+}
+
+func confluentRawSchemaHandler(handler func(*http.Request, map[string]string) ([]byte, error)) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		data, err := handler(r, pathParams)
+		if err != nil {
+			writeConfluentError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", confluentContentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	}
+}
+
+func writeConfluentError(w http.ResponseWriter, err error) {
+	cErr := toConfluentError(err, confluentSchemaNotFound)
+	data, _ := json.Marshal(cErr)
+	w.Header().Set("Content-Type", confluentContentType)
+	w.WriteHeader(cErr.HTTPStatus)
+	w.Write(data)
+}
+
+// toConfluentError converts service errors into confluent error, notFoundCode is used when resource doesn't exist
+func toConfluentError(err error, notFoundCode int) *confluentError {
+	var cErr *confluentError
+	if errors.As(err, &cErr) {
+		return cErr
+	}
+	var compErr *schema.CompatibilityErr
+	if errors.As(err, &compErr) {
+		return &confluentError{HTTPStatus: http.StatusConflict, ErrorCode: confluentIncompatibleSchema, Message: compErr.Error()}
+	}
+	var lintErr *schema.LintErr
+	if errors.As(err, &lintErr) {
+		return &confluentError{HTTPStatus: http.StatusUnprocessableEntity, ErrorCode: confluentInvalidSchema, Message: lintErr.Error()}
+	}
+	if errors.Is(err, store.NoRowsErr) {
+		return &confluentError{HTTPStatus: http.StatusNotFound, ErrorCode: notFoundCode, Message: err.Error()}
+	}
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return &confluentError{HTTPStatus: http.StatusUnauthorized, ErrorCode: confluentUnauthorized, Message: status.Convert(err).Message()}
+	case codes.PermissionDenied:
+		return &confluentError{HTTPStatus: http.StatusForbidden, ErrorCode: confluentForbidden, Message: status.Convert(err).Message()}
+	}
+	var httpErr *runtime.HTTPStatusError
+	if errors.As(err, &httpErr) && httpErr.HTTPStatus == http.StatusBadRequest {
+		return &confluentError{HTTPStatus: http.StatusUnprocessableEntity, ErrorCode: confluentInvalidSchema, Message: err.Error()}
+	}
+	return &confluentError{HTTPStatus: http.StatusInternalServerError, ErrorCode: confluentInternalServerError, Message: err.Error()}
+}
+
+func confluentNotFound(err error, code int) error {
+	if err == nil {
+		return nil
+	}
+	return toConfluentError(err, code)
+}
+
+func toConfluentSchemaType(format string) (string, error) {
+	for schemaType, f := range confluentSchemaTypes {
+		if f == format {
+			return schemaType, nil
+		}
+	}
+	return "", &confluentError{HTTPStatus: http.StatusUnprocessableEntity, ErrorCode: confluentInvalidSchema, Message: fmt.Sprintf("schemas with format %s are not supported", format)}
+}
+
+func newConfluentSchemaResponse(subject string, id, version int32, meta *schema.Metadata, data []byte) (*confluentSchemaResponse, error) {
+	schemaType, err := toConfluentSchemaType(meta.Format)
+	if err != nil {
+		return nil, err
+	}
+	if schemaType == confluentDefaultSchemaType {
+		schemaType = ""
+	}
+	return &confluentSchemaResponse{
+		Subject:    subject,
+		ID:         id,
+		Version:    version,
+		SchemaType: schemaType,
+		Schema:     string(data),
+	}, nil
+}
+
+func readConfluentSchemaRequest(req *http.Request) (*confluentSchemaRequest, string, error) {
+	var body confluentSchemaRequest
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, "", &confluentError{HTTPStatus: http.StatusUnprocessableEntity, ErrorCode: confluentUnprocessableEntity, Message: err.Error()}
+	}
+	if len(body.References) > 0 {
+		return nil, "", &confluentError{HTTPStatus: http.StatusUnprocessableEntity, ErrorCode: confluentInvalidSchema, Message: "schema references are not supported"}
+	}
+	schemaType := cmp.Or(body.SchemaType, confluentDefaultSchemaType)
+	format, ok := confluentSchemaTypes[schemaType]
+	if !ok {
+		return nil, "", &confluentError{HTTPStatus: http.StatusUnprocessableEntity, ErrorCode: confluentInvalidSchema, Message: fmt.Sprintf("schema type %s is not supported", schemaType)}
+	}
+	return &body, format, nil
+}
+
+func (a *API) confluentResolveVersion(req *http.Request, namespaceID, subject, versionString string) (int32, error) {
+	if versionString == confluentLatestVersion || versionString == confluentLatestVersionNumber {
+		versions, err := a.schema.ListVersions(req.Context(), namespaceID, subject)
+		if err != nil {
+			return 0, confluentNotFound(err, confluentSubjectNotFound)
+		}
+		if len(versions) == 0 {
+			return 0, &confluentError{HTTPStatus: http.StatusNotFound, ErrorCode: confluentSubjectNotFound, Message: fmt.Sprintf("subject %s not found", subject)}
+		}
+		latest := versions[0]
+		for _, v := range versions {
+			if v > latest {
+				latest = v
+			}
+		}
+		return latest, nil
+	}
+	v, err := strconv.ParseInt(versionString, 10, 32)
+	if err != nil || v <= 0 {
+		return 0, &confluentError{HTTPStatus: http.StatusUnprocessableEntity, ErrorCode: confluentInvalidVersion, Message: fmt.Sprintf("invalid version %s", versionString)}
+	}
+	return int32(v), nil
+}
+
+func parseGlobalID(idString string) (int32, error) {
+	id, err := strconv.ParseInt(idString, 10, 32)
+	if err != nil {
+		return 0, &confluentError{HTTPStatus: http.StatusNotFound, ErrorCode: confluentSchemaNotFound, Message: fmt.Sprintf("schema %s not found", idString)}
+	}
+	return int32(id), nil
+}
+
+func (a *API) confluentSchemaTypes(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	return []string{"AVRO", "JSON"}, nil
+}
+
+// confluentGetByGlobalID returns schema version referred by id path param.
+// Global IDs are unique across namespaces, versions of other namespaces are reported as not found,
+// reading the namespace in path is authorized for the route.
+func (a *API) confluentGetByGlobalID(req *http.Request, pathParams map[string]string) (schema.VersionRef, *schema.Metadata, []byte, error) {
+	idString := pathParams["id"]
+	id, err := parseGlobalID(idString)
+	if err != nil {
+		return schema.VersionRef{}, nil, nil, err
+	}
+	ref, meta, data, err := a.schema.GetByGlobalID(req.Context(), id)
+	if err != nil {
+		return ref, nil, nil, confluentNotFound(err, confluentSchemaNotFound)
+	}
+	if ref.NamespaceID != pathParams["namespace"] {
+		return ref, nil, nil, &confluentError{HTTPStatus: http.StatusNotFound, ErrorCode: confluentSchemaNotFound, Message: fmt.Sprintf("schema %s not found", idString)}
+	}
+	return ref, meta, data, nil
+}
+
+func (a *API) confluentGetSchemaByID(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	_, meta, data, err := a.confluentGetByGlobalID(req, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	return newConfluentSchemaResponse("", 0, 0, meta, data)
+}
+
+func (a *API) confluentGetSchemaVersionsByID(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	ref, _, _, err := a.confluentGetByGlobalID(req, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	return []confluentSubjectVersion{{Subject: ref.Name, Version: ref.Version}}, nil
+}
+
+func (a *API) confluentListSubjects(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	schemas, err := a.schema.List(req.Context(), pathParams["namespace"])
+	if err != nil {
+		return nil, err
+	}
+	subjects := make([]string, 0, len(schemas))
+	for _, s := range schemas {
+		subjects = append(subjects, s.Name)
+	}
+	return subjects, nil
+}
+
+func (a *API) confluentListVersions(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	versions, err := a.schema.ListVersions(req.Context(), pathParams["namespace"], pathParams["subject"])
+	if err != nil {
+		return nil, confluentNotFound(err, confluentSubjectNotFound)
+	}
+	if len(versions) == 0 {
+		return nil, &confluentError{HTTPStatus: http.StatusNotFound, ErrorCode: confluentSubjectNotFound, Message: fmt.Sprintf("subject %s not found", pathParams["subject"])}
+	}
+	return versions, nil
+}
+
+func (a *API) confluentGetVersion(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	namespaceID := pathParams["namespace"]
+	subject := pathParams["subject"]
+	version, err := a.confluentResolveVersion(req, namespaceID, subject, pathParams["version"])
+	if err != nil {
+		return nil, err
+	}
+	meta, data, err := a.schema.Get(req.Context(), namespaceID, subject, version)
+	if err != nil {
+		return nil, confluentNotFound(err, confluentVersionNotFound)
+	}
+	id, err := a.schema.GetGlobalID(req.Context(), namespaceID, subject, version)
+	if err != nil {
+		return nil, confluentNotFound(err, confluentVersionNotFound)
+	}
+	return newConfluentSchemaResponse(subject, id, version, meta, data)
+}
+
+func (a *API) confluentGetRawSchema(req *http.Request, pathParams map[string]string) ([]byte, error) {
+	namespaceID := pathParams["namespace"]
+	subject := pathParams["subject"]
+	version, err := a.confluentResolveVersion(req, namespaceID, subject, pathParams["version"])
+	if err != nil {
+		return nil, err
+	}
+	meta, data, err := a.schema.Get(req.Context(), namespaceID, subject, version)
+	if err != nil {
+		return nil, confluentNotFound(err, confluentVersionNotFound)
+	}
+	if _, err := toConfluentSchemaType(meta.Format); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (a *API) confluentRegisterSchema(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	namespaceID := pathParams["namespace"]
+	subject := pathParams["subject"]
+	body, format, err := readConfluentSchemaRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	sc, err := a.schema.Create(req.Context(), namespaceID, subject, &schema.Metadata{Format: format}, []byte(body.Schema))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]int32{"id": sc.GlobalID}, nil
+}
+
+func (a *API) confluentLookupSchema(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	namespaceID := pathParams["namespace"]
+	subject := pathParams["subject"]
+	body, format, err := readConfluentSchemaRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := a.schema.GetMetadata(req.Context(), namespaceID, subject); err != nil {
+		return nil, confluentNotFound(err, confluentSubjectNotFound)
+	}
+	meta := &schema.Metadata{Format: format}
+	sc, err := a.schema.LookupVersion(req.Context(), namespaceID, subject, meta, []byte(body.Schema))
+	if errors.Is(err, store.NoRowsErr) {
+		return nil, &confluentError{HTTPStatus: http.StatusNotFound, ErrorCode: confluentSchemaNotFound, Message: "schema not found"}
+	}
+	if err != nil {
+		return nil, toConfluentError(err, confluentSchemaNotFound)
+	}
+	return newConfluentSchemaResponse(subject, sc.GlobalID, sc.Version, meta, []byte(body.Schema))
+}
+
+func (a *API) confluentDeleteSubject(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	namespaceID := pathParams["namespace"]
+	subject := pathParams["subject"]
+	versions, err := a.schema.ListVersions(req.Context(), namespaceID, subject)
+	if err != nil {
+		return nil, confluentNotFound(err, confluentSubjectNotFound)
+	}
+	if len(versions) == 0 {
+		return nil, &confluentError{HTTPStatus: http.StatusNotFound, ErrorCode: confluentSubjectNotFound, Message: fmt.Sprintf("subject %s not found", subject)}
+	}
+	if err := a.schema.Delete(req.Context(), namespaceID, subject); err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+func (a *API) confluentDeleteVersion(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	namespaceID := pathParams["namespace"]
+	subject := pathParams["subject"]
+	version, err := a.confluentResolveVersion(req, namespaceID, subject, pathParams["version"])
+	if err != nil {
+		return nil, err
+	}
+	if err := a.schema.DeleteVersion(req.Context(), namespaceID, subject, version); err != nil {
+		return nil, confluentNotFound(err, confluentVersionNotFound)
+	}
+	return version, nil
+}
+
+// confluentCheckCompatibility checks schema against the version in path using non transitive form of subject compatibility mode
+func (a *API) confluentCheckCompatibility(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	namespaceID := pathParams["namespace"]
+	subject := pathParams["subject"]
+	version, err := a.confluentResolveVersion(req, namespaceID, subject, pathParams["version"])
+	if err != nil {
+		return nil, err
+	}
+	body, _, err := readConfluentSchemaRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := a.schema.GetMetadata(req.Context(), namespaceID, subject)
+	if err != nil {
+		return nil, confluentNotFound(err, confluentSubjectNotFound)
+	}
+	err = a.schema.CheckCompatibilityWithVersion(req.Context(), namespaceID, subject, version,
+		&schema.Metadata{Format: meta.Format, Compatibility: meta.Compatibility}, []byte(body.Schema))
+	var lintErr *schema.LintErr
+	var compErr *schema.CompatibilityErr
+	hasLintErr, hasCompErr := errors.As(err, &lintErr), errors.As(err, &compErr)
+	if !hasLintErr && !hasCompErr {
+		if err != nil {
+			return nil, err
+		}
+		return &confluentCompatibilityResponse{IsCompatible: true}, nil
+	}
+	resp := &confluentCompatibilityResponse{IsCompatible: false}
+	if verbose, _ := strconv.ParseBool(req.URL.Query().Get("verbose")); verbose {
+		if hasLintErr {
+			for _, issue := range lintErr.Issues {
+				resp.Messages = append(resp.Messages, issue.Message)
+			}
+		}
+		if hasCompErr {
+			for _, v := range compErr.Violations {
+				resp.Messages = append(resp.Messages, v.Message)
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (a *API) confluentGetConfig(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	ns, err := a.namespace.Get(req.Context(), pathParams["namespace"])
+	if err != nil {
+		return nil, confluentNotFound(err, confluentSubjectNotFound)
+	}
+	return &confluentConfig{CompatibilityLevel: toConfluentCompatibility(ns.Compatibility)}, nil
+}
+
+func (a *API) confluentGetSubjectConfig(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	namespaceID := pathParams["namespace"]
+	meta, err := a.schema.GetMetadata(req.Context(), namespaceID, pathParams["subject"])
+	if err != nil {
+		return nil, confluentNotFound(err, confluentSubjectNotFound)
+	}
+	compatibility := meta.Compatibility
+	if compatibility == "" {
+		ns, err := a.namespace.Get(req.Context(), namespaceID)
+		if err != nil {
+			return nil, err
+		}
+		compatibility = ns.Compatibility
+	}
+	return &confluentConfig{CompatibilityLevel: toConfluentCompatibility(compatibility)}, nil
+}
+
+func (a *API) confluentUpdateSubjectConfig(req *http.Request, pathParams map[string]string) (interface{}, error) {
+	var body confluentConfig
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, &confluentError{HTTPStatus: http.StatusUnprocessableEntity, ErrorCode: confluentUnprocessableEntity, Message: err.Error()}
+	}
+	compatibility, ok := confluentCompatibilities[body.Compatibility]
+	if !ok {
+		return nil, &confluentError{HTTPStatus: http.StatusUnprocessableEntity, ErrorCode: confluentInvalidCompatibility, Message: fmt.Sprintf("invalid compatibility level %s", body.Compatibility)}
+	}
+	meta, err := a.schema.UpdateMetadata(req.Context(), pathParams["namespace"], pathParams["subject"], &schema.Metadata{Compatibility: compatibility})
+	if err != nil {
+		return nil, confluentNotFound(err, confluentSubjectNotFound)
+	}
+	return &confluentConfig{Compatibility: toConfluentCompatibility(meta.Compatibility)}, nil
+}
+
+func toConfluentCompatibility(compatibility string) string {
+	for level, c := range confluentCompatibilities {
+		if c == compatibility {
+			return level
+		}
+	}
+	return confluentCompatibilityNone
+}
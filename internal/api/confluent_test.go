@@ -0,0 +1,263 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestConfluentSubjects(t *testing.T) {
+	nsName := "namespace"
+	t.Run("should list schemas in namespace as subjects", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("List", mock.Anything, nsName).Return([]schema.Schema{{Name: "orders-value"}, {Name: "users-value"}}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/confluent/namespace/subjects", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "application/vnd.schemaregistry.v1+json", w.Header().Get("Content-Type"))
+		assert.JSONEq(t, `["orders-value", "users-value"]`, w.Body.String())
+	})
+	t.Run("should return subject not found if no versions present", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("ListVersions", mock.Anything, nsName, "orders-value").Return([]int32{}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/confluent/namespace/subjects/orders-value/versions", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 404, w.Code)
+		assert.JSONEq(t, `{"error_code": 40401, "message": "subject orders-value not found"}`, w.Body.String())
+	})
+	t.Run("should delete subject and return deleted versions", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("ListVersions", mock.Anything, nsName, "orders-value").Return([]int32{1, 2}, nil)
+		schemaSvc.On("Delete", mock.Anything, nsName, "orders-value").Return(nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/confluent/namespace/subjects/orders-value", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `[1, 2]`, w.Body.String())
+		schemaSvc.AssertExpectations(t)
+	})
+}
+
+func TestConfluentRegisterSchema(t *testing.T) {
+	nsName := "namespace"
+	avroSchema := `{"type": "string"}`
+	t.Run("should register avro schema and return global ID", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("Create", mock.Anything, nsName, "orders-value", &schema.Metadata{Format: "FORMAT_AVRO"}, []byte(avroSchema)).Return(schema.SchemaInfo{Version: 3, GlobalID: 21}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/confluent/namespace/subjects/orders-value/versions", bytes.NewBufferString(`{"schema": "{\"type\": \"string\"}"}`))
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `{"id": 21}`, w.Body.String())
+		schemaSvc.AssertExpectations(t)
+	})
+	t.Run("should return conflict if schema is not compatible", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		compErr := &schema.CompatibilityErr{Violations: []schema.Violation{{Message: "field removed"}}}
+		schemaSvc.On("Create", mock.Anything, nsName, "orders-value", &schema.Metadata{Format: "FORMAT_JSON"}, []byte(avroSchema)).Return(schema.SchemaInfo{}, compErr)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/confluent/namespace/subjects/orders-value/versions", bytes.NewBufferString(`{"schema": "{\"type\": \"string\"}", "schemaType": "JSON"}`))
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 409, w.Code)
+		assert.JSONEq(t, `{"error_code": 409, "message": "field removed"}`, w.Body.String())
+	})
+	t.Run("should reject unsupported schema types", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/confluent/namespace/subjects/orders-value/versions", bytes.NewBufferString(`{"schema": "syntax = \"proto3\";", "schemaType": "PROTOBUF"}`))
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 422, w.Code)
+		assert.JSONEq(t, `{"error_code": 42201, "message": "schema type PROTOBUF is not supported"}`, w.Body.String())
+		schemaSvc.AssertExpectations(t)
+	})
+	t.Run("should return registered schema on lookup", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("GetMetadata", mock.Anything, nsName, "orders-value").Return(&schema.Metadata{Format: "FORMAT_AVRO"}, nil)
+		schemaSvc.On("LookupVersion", mock.Anything, nsName, "orders-value", &schema.Metadata{Format: "FORMAT_AVRO"}, []byte(avroSchema)).Return(schema.SchemaInfo{Version: 2, GlobalID: 7}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/confluent/namespace/subjects/orders-value", bytes.NewBufferString(`{"schema": "{\"type\": \"string\"}"}`))
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `{"subject": "orders-value", "id": 7, "version": 2, "schema": "{\"type\": \"string\"}"}`, w.Body.String())
+	})
+	t.Run("should return schema not found on lookup if schema is not registered", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("GetMetadata", mock.Anything, nsName, "orders-value").Return(&schema.Metadata{Format: "FORMAT_AVRO"}, nil)
+		schemaSvc.On("LookupVersion", mock.Anything, nsName, "orders-value", &schema.Metadata{Format: "FORMAT_AVRO"}, []byte(avroSchema)).Return(schema.SchemaInfo{}, store.NoRowsErr.WithErr(errors.New("no rows"), "version"))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/confluent/namespace/subjects/orders-value", bytes.NewBufferString(`{"schema": "{\"type\": \"string\"}"}`))
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 404, w.Code)
+		assert.JSONEq(t, `{"error_code": 40403, "message": "schema not found"}`, w.Body.String())
+	})
+	t.Run("should return subject not found on lookup if subject is not present", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("GetMetadata", mock.Anything, nsName, "orders-value").Return(nil, store.NoRowsErr.WithErr(errors.New("no rows"), "schema"))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/confluent/namespace/subjects/orders-value", bytes.NewBufferString(`{"schema": "{\"type\": \"string\"}"}`))
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 404, w.Code)
+		assert.JSONEq(t, `{"error_code": 40401, "message": "no rows"}`, w.Body.String())
+		schemaSvc.AssertNotCalled(t, "LookupVersion", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestConfluentGetSchema(t *testing.T) {
+	nsName := "namespace"
+	data := []byte(`{"type": "string"}`)
+	t.Run("should get schema by global ID", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		ref := schema.VersionRef{NamespaceID: nsName, Name: "orders-value", Version: 2, GlobalID: 7}
+		schemaSvc.On("GetByGlobalID", mock.Anything, int32(7)).Return(ref, &schema.Metadata{Format: "FORMAT_JSON"}, data, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/confluent/namespace/schemas/ids/7", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `{"schemaType": "JSON", "schema": "{\"type\": \"string\"}"}`, w.Body.String())
+	})
+	t.Run("should return schema not found if global ID is unknown", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("GetByGlobalID", mock.Anything, int32(8)).Return(schema.VersionRef{}, nil, nil, store.NoRowsErr.WithErr(errors.New("no rows"), "version"))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/confluent/namespace/schemas/ids/8", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 404, w.Code)
+		assert.JSONEq(t, `{"error_code": 40403, "message": "no rows"}`, w.Body.String())
+	})
+	t.Run("should not return protobuf schemas", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("GetByGlobalID", mock.Anything, int32(9)).Return(schema.VersionRef{NamespaceID: nsName}, &schema.Metadata{Format: "FORMAT_PROTOBUF"}, data, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/confluent/namespace/schemas/ids/9", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 422, w.Code)
+	})
+	t.Run("should return schema not found if global ID belongs to other namespace", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		ref := schema.VersionRef{NamespaceID: "other", Name: "orders-value", Version: 2, GlobalID: 7}
+		schemaSvc.On("GetByGlobalID", mock.Anything, int32(7)).Return(ref, &schema.Metadata{Format: "FORMAT_JSON"}, data, nil)
+		for _, path := range []string{"/confluent/namespace/schemas/ids/7", "/confluent/namespace/schemas/ids/7/versions"} {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", path, nil)
+			mux.ServeHTTP(w, req)
+			assert.Equal(t, 404, w.Code, path)
+			assert.JSONEq(t, `{"error_code": 40403, "message": "schema 7 not found"}`, w.Body.String(), path)
+		}
+	})
+	t.Run("should get latest version of subject", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("ListVersions", mock.Anything, nsName, "orders-value").Return([]int32{1, 3, 2}, nil)
+		schemaSvc.On("Get", mock.Anything, nsName, "orders-value", int32(3)).Return(&schema.Metadata{Format: "FORMAT_AVRO"}, data, nil)
+		schemaSvc.On("GetGlobalID", mock.Anything, nsName, "orders-value", int32(3)).Return(int32(11), nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/confluent/namespace/subjects/orders-value/versions/latest", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `{"subject": "orders-value", "id": 11, "version": 3, "schema": "{\"type\": \"string\"}"}`, w.Body.String())
+	})
+	t.Run("should get raw schema of a version", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("Get", mock.Anything, nsName, "orders-value", int32(1)).Return(&schema.Metadata{Format: "FORMAT_AVRO"}, data, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/confluent/namespace/subjects/orders-value/versions/1/schema", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, data, w.Body.Bytes())
+	})
+	t.Run("should validate version", func(t *testing.T) {
+		_, _, _, mux, _ := setup()
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/confluent/namespace/subjects/orders-value/versions/first", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 422, w.Code)
+		assert.JSONEq(t, `{"error_code": 42202, "message": "invalid version first"}`, w.Body.String())
+	})
+}
+
+func TestConfluentCompatibility(t *testing.T) {
+	nsName := "namespace"
+	body := `{"schema": "{\"type\": \"string\"}"}`
+	data := []byte(`{"type": "string"}`)
+	t.Run("should return is_compatible false with messages in verbose mode", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		compErr := &schema.CompatibilityErr{Violations: []schema.Violation{{Message: "field removed"}}}
+		schemaSvc.On("GetMetadata", mock.Anything, nsName, "orders-value").Return(&schema.Metadata{Format: "FORMAT_AVRO", Compatibility: "COMPATIBILITY_BACKWARD"}, nil)
+		schemaSvc.On("CheckCompatibilityWithVersion", mock.Anything, nsName, "orders-value", int32(1), &schema.Metadata{Format: "FORMAT_AVRO", Compatibility: "COMPATIBILITY_BACKWARD"}, data).Return(compErr)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/confluent/namespace/compatibility/subjects/orders-value/versions/1?verbose=true", bytes.NewBufferString(body))
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `{"is_compatible": false, "messages": ["field removed"]}`, w.Body.String())
+	})
+	t.Run("should return is_compatible true", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("GetMetadata", mock.Anything, nsName, "orders-value").Return(&schema.Metadata{Format: "FORMAT_AVRO", Compatibility: "COMPATIBILITY_BACKWARD"}, nil)
+		schemaSvc.On("CheckCompatibilityWithVersion", mock.Anything, nsName, "orders-value", int32(1), &schema.Metadata{Format: "FORMAT_AVRO", Compatibility: "COMPATIBILITY_BACKWARD"}, data).Return(nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/confluent/namespace/compatibility/subjects/orders-value/versions/1", bytes.NewBufferString(body))
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `{"is_compatible": true}`, w.Body.String())
+	})
+	t.Run("should return is_compatible false if schema fails lint rules", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		lintErr := &schema.LintErr{Issues: []schema.LintIssue{{Severity: "ERROR", Message: "package is not defined"}}}
+		schemaSvc.On("GetMetadata", mock.Anything, nsName, "orders-value").Return(&schema.Metadata{Format: "FORMAT_AVRO", Compatibility: "COMPATIBILITY_BACKWARD"}, nil)
+		schemaSvc.On("CheckCompatibilityWithVersion", mock.Anything, nsName, "orders-value", int32(1), &schema.Metadata{Format: "FORMAT_AVRO", Compatibility: "COMPATIBILITY_BACKWARD"}, data).Return(errors.Join(lintErr))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/confluent/namespace/compatibility/subjects/orders-value/versions/1?verbose=true", bytes.NewBufferString(body))
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `{"is_compatible": false, "messages": ["package is not defined"]}`, w.Body.String())
+	})
+}
+
+func TestConfluentConfig(t *testing.T) {
+	nsName := "namespace"
+	t.Run("should return namespace compatibility as global config", func(t *testing.T) {
+		nsSvc, _, _, mux, _ := setup()
+		nsSvc.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Compatibility: "COMPATIBILITY_FULL_TRANSITIVE"}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/confluent/namespace/config", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `{"compatibilityLevel": "FULL_TRANSITIVE"}`, w.Body.String())
+	})
+	t.Run("should fallback to namespace compatibility if subject compatibility not set", func(t *testing.T) {
+		nsSvc, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("GetMetadata", mock.Anything, nsName, "orders-value").Return(&schema.Metadata{}, nil)
+		nsSvc.On("Get", mock.Anything, nsName).Return(namespace.Namespace{Compatibility: "COMPATIBILITY_BACKWARD"}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/confluent/namespace/config/orders-value", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `{"compatibilityLevel": "BACKWARD"}`, w.Body.String())
+	})
+	t.Run("should update subject compatibility", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("UpdateMetadata", mock.Anything, nsName, "orders-value", &schema.Metadata{Compatibility: "COMPATIBILITY_FORWARD"}).Return(&schema.Metadata{Compatibility: "COMPATIBILITY_FORWARD"}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", "/confluent/namespace/config/orders-value", bytes.NewBufferString(`{"compatibility": "FORWARD"}`))
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `{"compatibility": "FORWARD"}`, w.Body.String())
+	})
+	t.Run("should reject invalid compatibility level", func(t *testing.T) {
+		_, _, _, mux, _ := setup()
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", "/confluent/namespace/config/orders-value", bytes.NewBufferString(`{"compatibility": "SOMETIMES"}`))
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 422, w.Code)
+		assert.JSONEq(t, `{"error_code": 42203, "message": "invalid compatibility level SOMETIMES"}`, w.Body.String())
+	})
+}
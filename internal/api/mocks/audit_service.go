@@ -0,0 +1,61 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	audit "github.com/raystack/stencil/core/audit"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditService is an autogenerated mock type for the AuditService type
+type AuditService struct {
+	mock.Mock
+}
+
+// List provides a mock function with given fields: ctx, namespaceID, schemaName, pageSize, pageToken
+func (_m *AuditService) List(ctx context.Context, namespaceID string, schemaName string, pageSize int, pageToken string) ([]audit.Event, string, error) {
+	ret := _m.Called(ctx, namespaceID, schemaName, pageSize, pageToken)
+
+	var r0 []audit.Event
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string) []audit.Event); ok {
+		r0 = rf(ctx, namespaceID, schemaName, pageSize, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]audit.Event)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string) string); ok {
+		r1 = rf(ctx, namespaceID, schemaName, pageSize, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int, string) error); ok {
+		r2 = rf(ctx, namespaceID, schemaName, pageSize, pageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+type mockConstructorTestingTNewAuditService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAuditService creates a new instance of AuditService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAuditService(t mockConstructorTestingTNewAuditService) *AuditService {
+	mock := &AuditService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
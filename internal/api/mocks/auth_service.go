@@ -0,0 +1,154 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	auth "github.com/raystack/stencil/core/auth"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuthService is an autogenerated mock type for the AuthService type
+type AuthService struct {
+	mock.Mock
+}
+
+// Authenticate provides a mock function with given fields: ctx, token
+func (_m *AuthService) Authenticate(ctx context.Context, token string) (string, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Authorize provides a mock function with given fields: ctx, principal, namespaceID, role
+func (_m *AuthService) Authorize(ctx context.Context, principal string, namespaceID string, role auth.Role) error {
+	ret := _m.Called(ctx, principal, namespaceID, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, auth.Role) error); ok {
+		r0 = rf(ctx, principal, namespaceID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthorizedNamespaces provides a mock function with given fields: ctx, principal, required
+func (_m *AuthService) AuthorizedNamespaces(ctx context.Context, principal string, required auth.Role) (map[string]bool, bool, error) {
+	ret := _m.Called(ctx, principal, required)
+
+	var r0 map[string]bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, auth.Role) map[string]bool); ok {
+		r0 = rf(ctx, principal, required)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(context.Context, string, auth.Role) bool); ok {
+		r1 = rf(ctx, principal, required)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, auth.Role) error); ok {
+		r2 = rf(ctx, principal, required)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Grant provides a mock function with given fields: ctx, binding
+func (_m *AuthService) Grant(ctx context.Context, binding auth.RoleBinding) (auth.RoleBinding, error) {
+	ret := _m.Called(ctx, binding)
+
+	var r0 auth.RoleBinding
+	if rf, ok := ret.Get(0).(func(context.Context, auth.RoleBinding) auth.RoleBinding); ok {
+		r0 = rf(ctx, binding)
+	} else {
+		r0 = ret.Get(0).(auth.RoleBinding)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, auth.RoleBinding) error); ok {
+		r1 = rf(ctx, binding)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, namespaceID
+func (_m *AuthService) List(ctx context.Context, namespaceID string) ([]auth.RoleBinding, error) {
+	ret := _m.Called(ctx, namespaceID)
+
+	var r0 []auth.RoleBinding
+	if rf, ok := ret.Get(0).(func(context.Context, string) []auth.RoleBinding); ok {
+		r0 = rf(ctx, namespaceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]auth.RoleBinding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespaceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Revoke provides a mock function with given fields: ctx, namespaceID, principal
+func (_m *AuthService) Revoke(ctx context.Context, namespaceID string, principal string) error {
+	ret := _m.Called(ctx, namespaceID, principal)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespaceID, principal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewAuthService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAuthService creates a new instance of AuthService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAuthService(t mockConstructorTestingTNewAuthService) *AuthService {
+	mock := &AuthService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -93,6 +93,27 @@ func (_m *NamespaceService) List(ctx context.Context) ([]namespace.Namespace, er
 	return r0, r1
 }
 
+// Restore provides a mock function with given fields: ctx, name
+func (_m *NamespaceService) Restore(ctx context.Context, name string) (namespace.Namespace, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 namespace.Namespace
+	if rf, ok := ret.Get(0).(func(context.Context, string) namespace.Namespace); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(namespace.Namespace)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, ns
 func (_m *NamespaceService) Update(ctx context.Context, ns namespace.Namespace) (namespace.Namespace, error) {
 	ret := _m.Called(ctx, ns)
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	retention "github.com/raystack/stencil/core/retention"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RetentionService is an autogenerated mock type for the RetentionService type
+type RetentionService struct {
+	mock.Mock
+}
+
+// List provides a mock function with given fields: ctx, namespaceID
+func (_m *RetentionService) List(ctx context.Context, namespaceID string) ([]retention.Item, error) {
+	ret := _m.Called(ctx, namespaceID)
+
+	var r0 []retention.Item
+	if rf, ok := ret.Get(0).(func(context.Context, string) []retention.Item); ok {
+		r0 = rf(ctx, namespaceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]retention.Item)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespaceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRetentionService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRetentionService creates a new instance of RetentionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRetentionService(t mockConstructorTestingTNewRetentionService) *RetentionService {
+	mock := &RetentionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -14,13 +14,27 @@ type SchemaService struct {
 	mock.Mock
 }
 
-// CheckCompatibility provides a mock function with given fields: ctx, nsName, schemaName, compatibility, data
-func (_m *SchemaService) CheckCompatibility(ctx context.Context, nsName string, schemaName string, compatibility string, data []byte) error {
-	ret := _m.Called(ctx, nsName, schemaName, compatibility, data)
+// CheckCompatibility provides a mock function with given fields: ctx, nsName, schemaName, metadata, data
+func (_m *SchemaService) CheckCompatibility(ctx context.Context, nsName string, schemaName string, metadata *schema.Metadata, data []byte) error {
+	ret := _m.Called(ctx, nsName, schemaName, metadata, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *schema.Metadata, []byte) error); ok {
+		r0 = rf(ctx, nsName, schemaName, metadata, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CheckCompatibilityWithVersion provides a mock function with given fields: ctx, nsName, schemaName, version, metadata, data
+func (_m *SchemaService) CheckCompatibilityWithVersion(ctx context.Context, nsName string, schemaName string, version int32, metadata *schema.Metadata, data []byte) error {
+	ret := _m.Called(ctx, nsName, schemaName, version, metadata, data)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []byte) error); ok {
-		r0 = rf(ctx, nsName, schemaName, compatibility, data)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, *schema.Metadata, []byte) error); ok {
+		r0 = rf(ctx, nsName, schemaName, version, metadata, data)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -49,6 +63,27 @@ func (_m *SchemaService) Create(ctx context.Context, nsName string, schemaName s
 	return r0, r1
 }
 
+// CreateDryRun provides a mock function with given fields: ctx, nsName, schemaName, metadata, data
+func (_m *SchemaService) CreateDryRun(ctx context.Context, nsName string, schemaName string, metadata *schema.Metadata, data []byte) (schema.SchemaInfo, error) {
+	ret := _m.Called(ctx, nsName, schemaName, metadata, data)
+
+	var r0 schema.SchemaInfo
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *schema.Metadata, []byte) schema.SchemaInfo); ok {
+		r0 = rf(ctx, nsName, schemaName, metadata, data)
+	} else {
+		r0 = ret.Get(0).(schema.SchemaInfo)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *schema.Metadata, []byte) error); ok {
+		r1 = rf(ctx, nsName, schemaName, metadata, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: ctx, namespace, schemaName
 func (_m *SchemaService) Delete(ctx context.Context, namespace string, schemaName string) error {
 	ret := _m.Called(ctx, namespace, schemaName)
@@ -77,6 +112,29 @@ func (_m *SchemaService) DeleteVersion(ctx context.Context, namespace string, sc
 	return r0
 }
 
+// Diff provides a mock function with given fields: ctx, namespace, schemaName, fromVersion, toVersion, compatibility
+func (_m *SchemaService) Diff(ctx context.Context, namespace string, schemaName string, fromVersion int32, toVersion int32, compatibility string) (*schema.SchemaDiff, error) {
+	ret := _m.Called(ctx, namespace, schemaName, fromVersion, toVersion, compatibility)
+
+	var r0 *schema.SchemaDiff
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32, string) *schema.SchemaDiff); ok {
+		r0 = rf(ctx, namespace, schemaName, fromVersion, toVersion, compatibility)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.SchemaDiff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32, int32, string) error); ok {
+		r1 = rf(ctx, namespace, schemaName, fromVersion, toVersion, compatibility)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: ctx, namespace, schemaName, version
 func (_m *SchemaService) Get(ctx context.Context, namespace string, schemaName string, version int32) (*schema.Metadata, []byte, error) {
 	ret := _m.Called(ctx, namespace, schemaName, version)
@@ -109,6 +167,98 @@ func (_m *SchemaService) Get(ctx context.Context, namespace string, schemaName s
 	return r0, r1, r2
 }
 
+// GetAssembled provides a mock function with given fields: ctx, namespace, schemaName, version
+func (_m *SchemaService) GetAssembled(ctx context.Context, namespace string, schemaName string, version int32) (*schema.Metadata, []byte, error) {
+	ret := _m.Called(ctx, namespace, schemaName, version)
+
+	var r0 *schema.Metadata
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) *schema.Metadata); ok {
+		r0 = rf(ctx, namespace, schemaName, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schema.Metadata)
+		}
+	}
+
+	var r1 []byte
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) []byte); ok {
+		r1 = rf(ctx, namespace, schemaName, version)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int32) error); ok {
+		r2 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByGlobalID provides a mock function with given fields: ctx, globalID
+func (_m *SchemaService) GetByGlobalID(ctx context.Context, globalID int32) (schema.VersionRef, *schema.Metadata, []byte, error) {
+	ret := _m.Called(ctx, globalID)
+
+	var r0 schema.VersionRef
+	if rf, ok := ret.Get(0).(func(context.Context, int32) schema.VersionRef); ok {
+		r0 = rf(ctx, globalID)
+	} else {
+		r0 = ret.Get(0).(schema.VersionRef)
+	}
+
+	var r1 *schema.Metadata
+	if rf, ok := ret.Get(1).(func(context.Context, int32) *schema.Metadata); ok {
+		r1 = rf(ctx, globalID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*schema.Metadata)
+		}
+	}
+
+	var r2 []byte
+	if rf, ok := ret.Get(2).(func(context.Context, int32) []byte); ok {
+		r2 = rf(ctx, globalID)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).([]byte)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, int32) error); ok {
+		r3 = rf(ctx, globalID)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// GetGlobalID provides a mock function with given fields: ctx, namespace, schemaName, version
+func (_m *SchemaService) GetGlobalID(ctx context.Context, namespace string, schemaName string, version int32) (int32, error) {
+	ret := _m.Called(ctx, namespace, schemaName, version)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) int32); ok {
+		r0 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLatest provides a mock function with given fields: ctx, namespace, schemaName
 func (_m *SchemaService) GetLatest(ctx context.Context, namespace string, schemaName string) (*schema.Metadata, []byte, error) {
 	ret := _m.Called(ctx, namespace, schemaName)
@@ -141,6 +291,27 @@ func (_m *SchemaService) GetLatest(ctx context.Context, namespace string, schema
 	return r0, r1, r2
 }
 
+// GetLatestVersionRef provides a mock function with given fields: ctx, namespace, schemaName
+func (_m *SchemaService) GetLatestVersionRef(ctx context.Context, namespace string, schemaName string) (schema.VersionRef, error) {
+	ret := _m.Called(ctx, namespace, schemaName)
+
+	var r0 schema.VersionRef
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) schema.VersionRef); ok {
+		r0 = rf(ctx, namespace, schemaName)
+	} else {
+		r0 = ret.Get(0).(schema.VersionRef)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, schemaName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMetadata provides a mock function with given fields: ctx, namespace, schemaName
 func (_m *SchemaService) GetMetadata(ctx context.Context, namespace string, schemaName string) (*schema.Metadata, error) {
 	ret := _m.Called(ctx, namespace, schemaName)
@@ -164,6 +335,27 @@ func (_m *SchemaService) GetMetadata(ctx context.Context, namespace string, sche
 	return r0, r1
 }
 
+// GetVersionRef provides a mock function with given fields: ctx, namespace, schemaName, version
+func (_m *SchemaService) GetVersionRef(ctx context.Context, namespace string, schemaName string, version int32) (schema.VersionRef, error) {
+	ret := _m.Called(ctx, namespace, schemaName, version)
+
+	var r0 schema.VersionRef
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) schema.VersionRef); ok {
+		r0 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r0 = ret.Get(0).(schema.VersionRef)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx, namespaceID
 func (_m *SchemaService) List(ctx context.Context, namespaceID string) ([]schema.Schema, error) {
 	ret := _m.Called(ctx, namespaceID)
@@ -187,6 +379,68 @@ func (_m *SchemaService) List(ctx context.Context, namespaceID string) ([]schema
 	return r0, r1
 }
 
+// ListReferences provides a mock function with given fields: ctx, namespace, schemaName, version
+func (_m *SchemaService) ListReferences(ctx context.Context, namespace string, schemaName string, version int32) ([]schema.Reference, []schema.Reference, error) {
+	ret := _m.Called(ctx, namespace, schemaName, version)
+
+	var r0 []schema.Reference
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) []schema.Reference); ok {
+		r0 = rf(ctx, namespace, schemaName, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schema.Reference)
+		}
+	}
+
+	var r1 []schema.Reference
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) []schema.Reference); ok {
+		r1 = rf(ctx, namespace, schemaName, version)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]schema.Reference)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int32) error); ok {
+		r2 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListVersionDetails provides a mock function with given fields: ctx, namespaceID, schemaName, pageSize, pageToken
+func (_m *SchemaService) ListVersionDetails(ctx context.Context, namespaceID string, schemaName string, pageSize int, pageToken string) ([]schema.Version, string, error) {
+	ret := _m.Called(ctx, namespaceID, schemaName, pageSize, pageToken)
+
+	var r0 []schema.Version
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string) []schema.Version); ok {
+		r0 = rf(ctx, namespaceID, schemaName, pageSize, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schema.Version)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string) string); ok {
+		r1 = rf(ctx, namespaceID, schemaName, pageSize, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int, string) error); ok {
+		r2 = rf(ctx, namespaceID, schemaName, pageSize, pageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // ListVersions provides a mock function with given fields: ctx, namespaceID, schemaName
 func (_m *SchemaService) ListVersions(ctx context.Context, namespaceID string, schemaName string) ([]int32, error) {
 	ret := _m.Called(ctx, namespaceID, schemaName)
@@ -210,6 +464,55 @@ func (_m *SchemaService) ListVersions(ctx context.Context, namespaceID string, s
 	return r0, r1
 }
 
+// LookupVersion provides a mock function with given fields: ctx, nsName, schemaName, metadata, data
+func (_m *SchemaService) LookupVersion(ctx context.Context, nsName string, schemaName string, metadata *schema.Metadata, data []byte) (schema.SchemaInfo, error) {
+	ret := _m.Called(ctx, nsName, schemaName, metadata, data)
+
+	var r0 schema.SchemaInfo
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *schema.Metadata, []byte) schema.SchemaInfo); ok {
+		r0 = rf(ctx, nsName, schemaName, metadata, data)
+	} else {
+		r0 = ret.Get(0).(schema.SchemaInfo)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *schema.Metadata, []byte) error); ok {
+		r1 = rf(ctx, nsName, schemaName, metadata, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: ctx, namespace, schemaName
+func (_m *SchemaService) Restore(ctx context.Context, namespace string, schemaName string) error {
+	ret := _m.Called(ctx, namespace, schemaName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespace, schemaName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreVersion provides a mock function with given fields: ctx, namespace, schemaName, version
+func (_m *SchemaService) RestoreVersion(ctx context.Context, namespace string, schemaName string, version int32) error {
+	ret := _m.Called(ctx, namespace, schemaName, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) error); ok {
+		r0 = rf(ctx, namespace, schemaName, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateMetadata provides a mock function with given fields: ctx, namespace, schemaName, meta
 func (_m *SchemaService) UpdateMetadata(ctx context.Context, namespace string, schemaName string, meta *schema.Metadata) (*schema.Metadata, error) {
 	ret := _m.Called(ctx, namespace, schemaName, meta)
@@ -233,6 +536,43 @@ func (_m *SchemaService) UpdateMetadata(ctx context.Context, namespace string, s
 	return r0, r1
 }
 
+// UpdateVersionState provides a mock function with given fields: ctx, namespaceID, schemaName, version, state
+func (_m *SchemaService) UpdateVersionState(ctx context.Context, namespaceID string, schemaName string, version int32, state schema.VersionState) (schema.Version, error) {
+	ret := _m.Called(ctx, namespaceID, schemaName, version, state)
+
+	var r0 schema.Version
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, schema.VersionState) schema.Version); ok {
+		r0 = rf(ctx, namespaceID, schemaName, version, state)
+	} else {
+		r0 = ret.Get(0).(schema.Version)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32, schema.VersionState) error); ok {
+		r1 = rf(ctx, namespaceID, schemaName, version, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Watch provides a mock function with given fields: ctx, namespace, schemaName
+func (_m *SchemaService) Watch(ctx context.Context, namespace string, schemaName string) <-chan schema.Event {
+	ret := _m.Called(ctx, namespace, schemaName)
+
+	var r0 <-chan schema.Event
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) <-chan schema.Event); ok {
+		r0 = rf(ctx, namespace, schemaName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan schema.Event)
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewSchemaService interface {
 	mock.TestingT
 	Cleanup(func())
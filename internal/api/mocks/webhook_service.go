@@ -0,0 +1,111 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	webhook "github.com/raystack/stencil/core/webhook"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookService is an autogenerated mock type for the WebhookService type
+type WebhookService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, sub
+func (_m *WebhookService) Create(ctx context.Context, sub webhook.Subscription) (webhook.Subscription, error) {
+	ret := _m.Called(ctx, sub)
+
+	var r0 webhook.Subscription
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.Subscription) webhook.Subscription); ok {
+		r0 = rf(ctx, sub)
+	} else {
+		r0 = ret.Get(0).(webhook.Subscription)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, webhook.Subscription) error); ok {
+		r1 = rf(ctx, sub)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, namespaceID, id
+func (_m *WebhookService) Delete(ctx context.Context, namespaceID string, id int64) error {
+	ret := _m.Called(ctx, namespaceID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, namespaceID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx, namespaceID
+func (_m *WebhookService) List(ctx context.Context, namespaceID string) ([]webhook.Subscription, error) {
+	ret := _m.Called(ctx, namespaceID)
+
+	var r0 []webhook.Subscription
+	if rf, ok := ret.Get(0).(func(context.Context, string) []webhook.Subscription); ok {
+		r0 = rf(ctx, namespaceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhook.Subscription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespaceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDeliveries provides a mock function with given fields: ctx, namespaceID, subscriptionID
+func (_m *WebhookService) ListDeliveries(ctx context.Context, namespaceID string, subscriptionID int64) ([]webhook.Delivery, error) {
+	ret := _m.Called(ctx, namespaceID, subscriptionID)
+
+	var r0 []webhook.Delivery
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []webhook.Delivery); ok {
+		r0 = rf(ctx, namespaceID, subscriptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhook.Delivery)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, namespaceID, subscriptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewWebhookService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewWebhookService creates a new instance of WebhookService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewWebhookService(t mockConstructorTestingTNewWebhookService) *WebhookService {
+	mock := &WebhookService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,7 +3,9 @@ package api
 import (
 	"context"
 
+	"github.com/raystack/stencil/core/auth"
 	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/pkg/actor"
 	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -14,9 +16,18 @@ func createNamespaceRequestToNamespace(r *stencilv1beta1.CreateNamespaceRequest)
 		Format:        r.GetFormat().String(),
 		Compatibility: r.GetCompatibility().String(),
 		Description:   r.GetDescription(),
+		LintRuleSet:   r.GetLint().GetRuleSet(),
+		LintRules:     r.GetLint().GetRules(),
 	}
 }
 
+func lintConfigToProto(ns namespace.Namespace) *stencilv1beta1.LintConfig {
+	if ns.LintRuleSet == "" && len(ns.LintRules) == 0 {
+		return nil
+	}
+	return &stencilv1beta1.LintConfig{RuleSet: ns.LintRuleSet, Rules: ns.LintRules}
+}
+
 func namespaceToProto(ns namespace.Namespace) *stencilv1beta1.Namespace {
 	return &stencilv1beta1.Namespace{
 		Id:            ns.ID,
@@ -25,6 +36,7 @@ func namespaceToProto(ns namespace.Namespace) *stencilv1beta1.Namespace {
 		Description:   ns.Description,
 		CreatedAt:     timestamppb.New(ns.CreatedAt),
 		UpdatedAt:     timestamppb.New(ns.UpdatedAt),
+		Lint:          lintConfigToProto(ns),
 	}
 }
 
@@ -36,7 +48,14 @@ func (a *API) CreateNamespace(ctx context.Context, in *stencilv1beta1.CreateName
 }
 
 func (a *API) UpdateNamespace(ctx context.Context, in *stencilv1beta1.UpdateNamespaceRequest) (*stencilv1beta1.UpdateNamespaceResponse, error) {
-	ns, err := a.namespace.Update(ctx, namespace.Namespace{ID: in.GetId(), Format: in.GetFormat().String(), Compatibility: in.GetCompatibility().String(), Description: in.GetDescription()})
+	ns, err := a.namespace.Update(ctx, namespace.Namespace{
+		ID:            in.GetId(),
+		Format:        in.GetFormat().String(),
+		Compatibility: in.GetCompatibility().String(),
+		Description:   in.GetDescription(),
+		LintRuleSet:   in.GetLint().GetRuleSet(),
+		LintRules:     in.GetLint().GetRules(),
+	})
 	return &stencilv1beta1.UpdateNamespaceResponse{Namespace: namespaceToProto(ns)}, err
 }
 
@@ -45,14 +64,36 @@ func (a *API) GetNamespace(ctx context.Context, in *stencilv1beta1.GetNamespaceR
 	return &stencilv1beta1.GetNamespaceResponse{Namespace: namespaceToProto(namespace)}, err
 }
 
-// ListNamespaces handler for returning list of available namespaces
+// ListNamespaces handler for returning list of available namespaces.
+// If auth is enabled only namespaces caller can read are listed.
 func (a *API) ListNamespaces(ctx context.Context, in *stencilv1beta1.ListNamespacesRequest) (*stencilv1beta1.ListNamespacesResponse, error) {
 	namespaces, err := a.namespace.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var allowed map[string]bool
+	all := a.auth == nil
+	if !all {
+		allowed, all, err = a.auth.AuthorizedNamespaces(ctx, actor.FromContext(ctx), auth.RoleReader)
+		if err != nil {
+			return nil, err
+		}
+	}
 	var nsp []*stencilv1beta1.Namespace
 	for _, n := range namespaces {
-		nsp = append(nsp, namespaceToProto(n))
+		if all || allowed[n.ID] {
+			nsp = append(nsp, namespaceToProto(n))
+		}
+	}
+	return &stencilv1beta1.ListNamespacesResponse{Namespaces: nsp}, nil
+}
+
+func (a *API) RestoreNamespace(ctx context.Context, in *stencilv1beta1.RestoreNamespaceRequest) (*stencilv1beta1.RestoreNamespaceResponse, error) {
+	ns, err := a.namespace.Restore(ctx, in.GetId())
+	if err != nil {
+		return nil, err
 	}
-	return &stencilv1beta1.ListNamespacesResponse{Namespaces: nsp}, err
+	return &stencilv1beta1.RestoreNamespaceResponse{Namespace: namespaceToProto(ns)}, nil
 }
 
 func (a *API) DeleteNamespace(ctx context.Context, in *stencilv1beta1.DeleteNamespaceRequest) (*stencilv1beta1.DeleteNamespaceResponse, error) {
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+
+	"github.com/raystack/stencil/core/retention"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func deletedItemToProto(item retention.Item) *stencilv1beta1.DeletedItem {
+	return &stencilv1beta1.DeletedItem{
+		Kind:        stencilv1beta1.DeletedItem_Kind(stencilv1beta1.DeletedItem_Kind_value["KIND_"+string(item.Kind)]),
+		NamespaceId: item.NamespaceID,
+		SchemaId:    item.SchemaName,
+		Version:     item.Version,
+		DeletedAt:   timestamppb.New(item.DeletedAt),
+		PurgeAt:     timestamppb.New(item.PurgeAt),
+	}
+}
+
+// ListDeletedItems returns namespaces, schemas and versions that can still be restored
+func (a *API) ListDeletedItems(ctx context.Context, in *stencilv1beta1.ListDeletedItemsRequest) (*stencilv1beta1.ListDeletedItemsResponse, error) {
+	items, err := a.retention.List(ctx, in.GetNamespaceId())
+	if err != nil {
+		return nil, err
+	}
+	var res []*stencilv1beta1.DeletedItem
+	for _, item := range items {
+		res = append(res, deletedItemToProto(item))
+	}
+	return &stencilv1beta1.ListDeletedItemsResponse{Items: res}, nil
+}
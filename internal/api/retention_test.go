@@ -0,0 +1,67 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/retention"
+	"github.com/raystack/stencil/internal/store"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListDeletedItems(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should return error if list fails", func(t *testing.T) {
+		_, _, retentionSvc, api := setupWithRetention()
+		retentionSvc.On("List", mock.Anything, "ns").Return(nil, errors.New("list error"))
+		_, err := api.ListDeletedItems(ctx, &stencilv1beta1.ListDeletedItemsRequest{NamespaceId: "ns"})
+		assert.Error(t, err)
+	})
+	t.Run("should return deleted items with purge time", func(t *testing.T) {
+		_, _, retentionSvc, api := setupWithRetention()
+		deletedAt := time.Date(2022, 3, 14, 10, 0, 0, 0, time.UTC)
+		items := []retention.Item{
+			{Kind: retention.KindVersion, NamespaceID: "ns", SchemaName: "sc", Version: 2, DeletedAt: deletedAt, PurgeAt: deletedAt.Add(time.Hour)},
+			{Kind: retention.KindSchema, NamespaceID: "ns", SchemaName: "other", DeletedAt: deletedAt},
+		}
+		retentionSvc.On("List", mock.Anything, "ns").Return(items, nil)
+		res, err := api.ListDeletedItems(ctx, &stencilv1beta1.ListDeletedItemsRequest{NamespaceId: "ns"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(res.Items))
+		assert.Equal(t, stencilv1beta1.DeletedItem_KIND_VERSION, res.Items[0].Kind)
+		assert.Equal(t, int32(2), res.Items[0].Version)
+		assert.Equal(t, deletedAt.Add(time.Hour), res.Items[0].PurgeAt.AsTime())
+		assert.Equal(t, stencilv1beta1.DeletedItem_KIND_SCHEMA, res.Items[1].Kind)
+		assert.Equal(t, "other", res.Items[1].SchemaId)
+	})
+}
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should restore namespace", func(t *testing.T) {
+		nsSvc, _, _, api := setupWithRetention()
+		nsSvc.On("Restore", mock.Anything, "ns").Return(namespace.Namespace{ID: "ns", Format: "FORMAT_AVRO"}, nil)
+		res, err := api.RestoreNamespace(ctx, &stencilv1beta1.RestoreNamespaceRequest{Id: "ns"})
+		assert.NoError(t, err)
+		assert.Equal(t, "ns", res.Namespace.Id)
+	})
+	t.Run("should return not found if schema is not deleted", func(t *testing.T) {
+		_, schemaSvc, _, api := setupWithRetention()
+		schemaSvc.On("Restore", mock.Anything, "ns", "sc").Return(store.NoRowsErr)
+		_, err := api.RestoreSchema(ctx, &stencilv1beta1.RestoreSchemaRequest{NamespaceId: "ns", SchemaId: "sc"})
+		assert.ErrorIs(t, err, store.NoRowsErr)
+	})
+	t.Run("should restore version", func(t *testing.T) {
+		_, schemaSvc, _, api := setupWithRetention()
+		schemaSvc.On("RestoreVersion", mock.Anything, "ns", "sc", int32(2)).Return(nil)
+		res, err := api.RestoreVersion(ctx, &stencilv1beta1.RestoreVersionRequest{NamespaceId: "ns", SchemaId: "sc", VersionId: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, "success", res.Message)
+		schemaSvc.AssertExpectations(t)
+	})
+}
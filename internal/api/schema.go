@@ -7,10 +7,15 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/raystack/stencil/core/auth"
 	"github.com/raystack/stencil/core/schema"
 	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func schemaToProto(s schema.Schema) *stencilv1beta1.Schema {
@@ -23,12 +28,26 @@ func schemaToProto(s schema.Schema) *stencilv1beta1.Schema {
 }
 
 func (a *API) CreateSchema(ctx context.Context, in *stencilv1beta1.CreateSchemaRequest) (*stencilv1beta1.CreateSchemaResponse, error) {
-	metadata := &schema.Metadata{Format: in.GetFormat().String(), Compatibility: in.GetCompatibility().String()}
-	sc, err := a.schema.Create(ctx, in.NamespaceId, in.SchemaId, metadata, in.GetData())
+	metadata := &schema.Metadata{Format: in.GetFormat().String(), Compatibility: in.GetCompatibility().String(), Message: in.GetMessage(), ImportRoot: in.GetImportRoot()}
+	for _, ref := range in.GetReferences() {
+		metadata.References = append(metadata.References, schema.Reference{NamespaceID: ref.GetNamespaceId(), Name: ref.GetSchemaId(), Version: ref.GetVersion()})
+	}
+	if err := a.authorizeReferences(ctx, in.NamespaceId, metadata.References); err != nil {
+		return nil, err
+	}
+	createFn := a.schema.Create
+	if in.GetDryRun() {
+		createFn = a.schema.CreateDryRun
+	}
+	sc, err := createFn(ctx, in.NamespaceId, in.SchemaId, metadata, in.GetData())
 	return &stencilv1beta1.CreateSchemaResponse{
-		Version:  sc.Version,
-		Id:       sc.ID,
-		Location: sc.Location,
+		Version:    sc.Version,
+		Id:         sc.ID,
+		Location:   sc.Location,
+		Duplicate:  sc.Duplicate,
+		Restorable: sc.Restorable,
+		GlobalId:   sc.GlobalID,
+		LintIssues: lintIssuesToProto(sc.LintIssues),
 	}, err
 }
 func (a *API) HTTPUpload(w http.ResponseWriter, req *http.Request, pathParams map[string]string) error {
@@ -38,24 +57,114 @@ func (a *API) HTTPUpload(w http.ResponseWriter, req *http.Request, pathParams ma
 	}
 	format := req.Header.Get("X-Format")
 	compatibility := req.Header.Get("X-Compatibility")
-	metadata := &schema.Metadata{Format: format, Compatibility: compatibility}
+	message := req.Header.Get("X-Message")
+	importRoot := req.Header.Get("X-Import-Root")
+	refs, err := parseReferences(req.Header.Get("X-References"))
+	if err != nil {
+		return err
+	}
+	metadata := &schema.Metadata{Format: format, Compatibility: compatibility, Message: message, References: refs, ImportRoot: importRoot}
 	namespaceID := pathParams["namespace"]
 	schemaName := pathParams["name"]
-	sc, err := a.schema.Create(req.Context(), namespaceID, schemaName, metadata, data)
+	if err := a.authorizeReferences(req.Context(), namespaceID, refs); err != nil {
+		return err
+	}
+	createFn := a.schema.Create
+	status := http.StatusCreated
+	if dryRunString := req.URL.Query().Get("dry_run"); dryRunString != "" {
+		dryRun, err := strconv.ParseBool(dryRunString)
+		if err != nil {
+			return &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: errors.New("invalid dry_run value")}
+		}
+		if dryRun {
+			createFn = a.schema.CreateDryRun
+			status = http.StatusOK
+		}
+	}
+	sc, err := createFn(req.Context(), namespaceID, schemaName, metadata, data)
 	if err != nil {
 		return err
 	}
 	respData, _ := json.Marshal(sc)
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
+	w.WriteHeader(status)
 	w.Write(respData)
 	return nil
 }
 
+// parseReferences parses comma separated references in namespace/schema@version form
+func parseReferences(value string) ([]schema.Reference, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var refs []schema.Reference
+	for _, s := range strings.Split(value, ",") {
+		ref, err := schema.ParseReference(s)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+// authorizeReferences checks caller can read namespaces of referenced schemas, since their data is served along with the new version
+func (a *API) authorizeReferences(ctx context.Context, namespaceID string, refs []schema.Reference) error {
+	for _, ref := range refs {
+		if ref.NamespaceID == namespaceID {
+			continue
+		}
+		if err := a.authorize(ctx, ref.NamespaceID, auth.RoleReader); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assembleRequested reports whether data combined with referenced schemas is asked for using assemble query param
+func assembleRequested(req *http.Request) (bool, error) {
+	value := req.URL.Query().Get("assemble")
+	if value == "" {
+		return false, nil
+	}
+	assemble, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: errors.New("invalid assemble value")}
+	}
+	return assemble, nil
+}
+
+// getFn returns function to fetch schema data, combined with data of referenced schemas if assemble is set
+func (a *API) getFn(assemble bool) func(context.Context, string, string, int32) (*schema.Metadata, []byte, error) {
+	if assemble {
+		return a.schema.GetAssembled
+	}
+	return a.schema.Get
+}
+
 func (a *API) CheckCompatibility(ctx context.Context, req *stencilv1beta1.CheckCompatibilityRequest) (*stencilv1beta1.CheckCompatibilityResponse, error) {
-	resp := &stencilv1beta1.CheckCompatibilityResponse{}
-	err := a.schema.CheckCompatibility(ctx, req.GetNamespaceId(), req.GetSchemaId(), req.GetCompatibility().String(), req.GetData())
-	return resp, err
+	metadata := &schema.Metadata{Compatibility: req.GetCompatibility().String(), ImportRoot: req.GetImportRoot()}
+	for _, ref := range req.GetReferences() {
+		metadata.References = append(metadata.References, schema.Reference{NamespaceID: ref.GetNamespaceId(), Name: ref.GetSchemaId(), Version: ref.GetVersion()})
+	}
+	if err := a.authorizeReferences(ctx, req.GetNamespaceId(), metadata.References); err != nil {
+		return nil, err
+	}
+	err := a.schema.CheckCompatibility(ctx, req.GetNamespaceId(), req.GetSchemaId(), metadata, req.GetData())
+	res := &stencilv1beta1.CheckCompatibilityResponse{Compatible: true}
+	var compErr *schema.CompatibilityErr
+	var lintErr *schema.LintErr
+	if errors.As(err, &compErr) {
+		res.Compatible = false
+		res.Violations = violationsToProto(compErr.Violations)
+	}
+	if errors.As(err, &lintErr) {
+		res.LintIssues = lintIssuesToProto(lintErr.Issues)
+	}
+	if err != nil && compErr == nil && lintErr == nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (a *API) HTTPCheckCompatibility(w http.ResponseWriter, req *http.Request, pathParams map[string]string) error {
@@ -64,9 +173,71 @@ func (a *API) HTTPCheckCompatibility(w http.ResponseWriter, req *http.Request, p
 		return err
 	}
 	compatibility := req.Header.Get("X-Compatibility")
+	importRoot := req.Header.Get("X-Import-Root")
+	refs, err := parseReferences(req.Header.Get("X-References"))
+	if err != nil {
+		return err
+	}
 	namespaceID := pathParams["namespace"]
 	schemaName := pathParams["name"]
-	return a.schema.CheckCompatibility(req.Context(), namespaceID, schemaName, compatibility, data)
+	if err := a.authorizeReferences(req.Context(), namespaceID, refs); err != nil {
+		return err
+	}
+	metadata := &schema.Metadata{Compatibility: compatibility, References: refs, ImportRoot: importRoot}
+	err = a.schema.CheckCompatibility(req.Context(), namespaceID, schemaName, metadata, data)
+	resp := struct {
+		Compatible bool               `json:"compatible"`
+		Violations []schema.Violation `json:"violations"`
+		LintIssues []schema.LintIssue `json:"lint_issues,omitempty"`
+	}{Compatible: true, Violations: []schema.Violation{}}
+	status := http.StatusOK
+	var compErr *schema.CompatibilityErr
+	var lintErr *schema.LintErr
+	if errors.As(err, &compErr) {
+		resp.Compatible = false
+		resp.Violations = compErr.Violations
+		status = http.StatusBadRequest
+	}
+	if errors.As(err, &lintErr) {
+		resp.LintIssues = lintErr.Issues
+		status = http.StatusBadRequest
+	}
+	if err != nil && compErr == nil && lintErr == nil {
+		return err
+	}
+	respData, _ := json.Marshal(resp)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(respData)
+	return nil
+}
+
+func violationsToProto(violations []schema.Violation) []*stencilv1beta1.CompatibilityViolation {
+	var res []*stencilv1beta1.CompatibilityViolation
+	for _, v := range violations {
+		res = append(res, &stencilv1beta1.CompatibilityViolation{
+			Kind:          v.Kind,
+			Path:          v.Path,
+			Message:       v.Message,
+			OldValue:      v.OldValue,
+			NewValue:      v.NewValue,
+			Compatibility: stencilv1beta1.Schema_Compatibility(stencilv1beta1.Schema_Compatibility_value[v.Compatibility]),
+		})
+	}
+	return res
+}
+
+func lintIssuesToProto(issues []schema.LintIssue) []*stencilv1beta1.LintIssue {
+	var res []*stencilv1beta1.LintIssue
+	for _, issue := range issues {
+		res = append(res, &stencilv1beta1.LintIssue{
+			Rule:     issue.Rule,
+			Severity: issue.Severity,
+			Path:     issue.Path,
+			Message:  issue.Message,
+		})
+	}
+	return res
 }
 
 func (a *API) ListSchemas(ctx context.Context, in *stencilv1beta1.ListSchemasRequest) (*stencilv1beta1.ListSchemasResponse, error) {
@@ -80,6 +251,16 @@ func (a *API) ListSchemas(ctx context.Context, in *stencilv1beta1.ListSchemasReq
 }
 
 func (a *API) GetLatestSchema(ctx context.Context, in *stencilv1beta1.GetLatestSchemaRequest) (*stencilv1beta1.GetLatestSchemaResponse, error) {
+	if in.GetAssemble() {
+		ref, err := a.schema.GetLatestVersionRef(ctx, in.NamespaceId, in.SchemaId)
+		if err != nil {
+			return &stencilv1beta1.GetLatestSchemaResponse{}, err
+		}
+		_, data, err := a.schema.GetAssembled(ctx, in.NamespaceId, in.SchemaId, ref.Version)
+		return &stencilv1beta1.GetLatestSchemaResponse{
+			Data: data,
+		}, err
+	}
 	_, data, err := a.schema.GetLatest(ctx, in.NamespaceId, in.SchemaId)
 	return &stencilv1beta1.GetLatestSchemaResponse{
 		Data: data,
@@ -89,14 +270,74 @@ func (a *API) GetLatestSchema(ctx context.Context, in *stencilv1beta1.GetLatestS
 func (a *API) HTTPLatestSchema(w http.ResponseWriter, req *http.Request, pathParams map[string]string) (*schema.Metadata, []byte, error) {
 	namespaceID := pathParams["namespace"]
 	schemaName := pathParams["name"]
-	return a.schema.GetLatest(req.Context(), namespaceID, schemaName)
+	assemble, err := assembleRequested(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	ref, err := a.schema.GetLatestVersionRef(req.Context(), namespaceID, schemaName)
+	if err != nil {
+		return nil, nil, err
+	}
+	meta, data, err := a.getFn(assemble)(req.Context(), namespaceID, schemaName, ref.Version)
+	if err != nil {
+		return meta, data, err
+	}
+	setVersionHeaders(w, ref)
+	return meta, data, nil
 }
 
 func (a *API) GetSchema(ctx context.Context, in *stencilv1beta1.GetSchemaRequest) (*stencilv1beta1.GetSchemaResponse, error) {
-	_, data, err := a.schema.Get(ctx, in.NamespaceId, in.SchemaId, in.GetVersionId())
+	_, data, err := a.getFn(in.GetAssemble())(ctx, in.NamespaceId, in.SchemaId, in.GetVersionId())
+	if err != nil {
+		return &stencilv1beta1.GetSchemaResponse{}, err
+	}
+	ref, err := a.schema.GetVersionRef(ctx, in.NamespaceId, in.SchemaId, in.GetVersionId())
+	if err != nil {
+		return &stencilv1beta1.GetSchemaResponse{}, err
+	}
+	setVersionMetadata(ctx, ref)
 	return &stencilv1beta1.GetSchemaResponse{
 		Data: data,
-	}, err
+	}, nil
+}
+
+// setVersionMetadata sends lifecycle state of the version to gRPC clients along with warning if the version is deleted
+func setVersionMetadata(ctx context.Context, ref schema.VersionRef) {
+	md := metadata.MD{}
+	if ref.State != "" {
+		md.Set(versionStateHeader, ref.State)
+	}
+	if ref.Reason != "" {
+		md.Set(versionStateReasonHeader, ref.Reason)
+	}
+	if ref.SunsetAt != nil {
+		md.Set(sunsetHeader, ref.SunsetAt.UTC().Format(http.TimeFormat))
+	}
+	if ref.Deleted {
+		md.Set(warningHeader, deletedWarning)
+	}
+	if len(md) == 0 {
+		return
+	}
+	// fails only if called outside of gRPC request, headers are best-effort
+	_ = grpc.SetHeader(ctx, md)
+}
+
+// setVersionHeaders sets global ID and lifecycle state of the served version in HTTP response headers
+func setVersionHeaders(w http.ResponseWriter, ref schema.VersionRef) {
+	w.Header().Set(globalIDHeader, strconv.Itoa(int(ref.GlobalID)))
+	if ref.State != "" {
+		w.Header().Set(versionStateHeader, ref.State)
+	}
+	if ref.Reason != "" {
+		w.Header().Set(versionStateReasonHeader, ref.Reason)
+	}
+	if ref.SunsetAt != nil {
+		w.Header().Set(sunsetHeader, ref.SunsetAt.UTC().Format(http.TimeFormat))
+	}
+	if ref.Deleted {
+		w.Header().Set(warningHeader, deletedWarning)
+	}
 }
 
 func (a *API) HTTPGetSchema(w http.ResponseWriter, req *http.Request, pathParams map[string]string) (*schema.Metadata, []byte, error) {
@@ -107,12 +348,176 @@ func (a *API) HTTPGetSchema(w http.ResponseWriter, req *http.Request, pathParams
 	if err != nil {
 		return nil, nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: errors.New("invalid version number")}
 	}
-	return a.schema.Get(req.Context(), namespaceID, schemaName, int32(v))
+	assemble, err := assembleRequested(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	meta, data, err := a.getFn(assemble)(req.Context(), namespaceID, schemaName, int32(v))
+	if err != nil {
+		return meta, data, err
+	}
+	ref, err := a.schema.GetVersionRef(req.Context(), namespaceID, schemaName, int32(v))
+	if err != nil {
+		return meta, data, err
+	}
+	setVersionHeaders(w, ref)
+	return meta, data, nil
+}
+
+func (a *API) GetSchemaByGlobalID(ctx context.Context, in *stencilv1beta1.GetSchemaByGlobalIDRequest) (*stencilv1beta1.GetSchemaByGlobalIDResponse, error) {
+	ref, meta, data, err := a.schema.GetByGlobalID(ctx, in.GetGlobalId())
+	if err != nil {
+		return nil, err
+	}
+	if err := a.authorize(ctx, ref.NamespaceID, auth.RoleReader); err != nil {
+		return nil, err
+	}
+	setVersionMetadata(ctx, ref)
+	return &stencilv1beta1.GetSchemaByGlobalIDResponse{
+		NamespaceId: ref.NamespaceID,
+		SchemaId:    ref.Name,
+		Version:     ref.Version,
+		GlobalId:    ref.GlobalID,
+		Format:      stencilv1beta1.Schema_Format(stencilv1beta1.Schema_Format_value[meta.Format]),
+		Data:        data,
+	}, nil
+}
+
+func (a *API) HTTPGetSchemaByGlobalID(w http.ResponseWriter, req *http.Request, pathParams map[string]string) (*schema.Metadata, []byte, error) {
+	id, err := strconv.ParseInt(pathParams["id"], 10, 32)
+	if err != nil {
+		return nil, nil, &runtime.HTTPStatusError{HTTPStatus: http.StatusBadRequest, Err: errors.New("invalid global id")}
+	}
+	assemble, err := assembleRequested(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	ref, meta, data, err := a.schema.GetByGlobalID(req.Context(), int32(id))
+	if err != nil {
+		return meta, data, err
+	}
+	if err := a.authorize(req.Context(), ref.NamespaceID, auth.RoleReader); err != nil {
+		return nil, nil, err
+	}
+	if assemble {
+		meta, data, err = a.schema.GetAssembled(req.Context(), ref.NamespaceID, ref.Name, ref.Version)
+		if err != nil {
+			return meta, data, err
+		}
+	}
+	setVersionHeaders(w, ref)
+	return meta, data, nil
+}
+
+func (a *API) WatchSchemas(in *stencilv1beta1.WatchSchemasRequest, stream stencilv1beta1.StencilService_WatchSchemasServer) error {
+	for event := range a.schema.Watch(stream.Context(), in.NamespaceId, in.SchemaId) {
+		err := stream.Send(&stencilv1beta1.WatchSchemasResponse{
+			Type:        stencilv1beta1.WatchSchemasResponse_EventType(stencilv1beta1.WatchSchemasResponse_EventType_value["EVENT_TYPE_"+string(event.Type)]),
+			NamespaceId: event.NamespaceID,
+			SchemaId:    event.SchemaName,
+			Version:     event.Version,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return stream.Context().Err()
+}
+
+func versionToProto(v schema.Version) *stencilv1beta1.SchemaVersion {
+	res := &stencilv1beta1.SchemaVersion{
+		Version:      v.Version,
+		State:        stencilv1beta1.SchemaVersion_State(stencilv1beta1.SchemaVersion_State_value["STATE_"+v.State]),
+		StateReason:  v.Reason,
+		Id:           v.ID,
+		SchemaFileId: v.SchemaFileID,
+		Creator:      v.Creator,
+		Message:      v.Message,
+		Size:         v.Size,
+	}
+	if !v.CreatedAt.IsZero() {
+		res.CreatedAt = timestamppb.New(v.CreatedAt)
+	}
+	if v.SunsetAt != nil {
+		res.SunsetAt = timestamppb.New(*v.SunsetAt)
+	}
+	return res
 }
 
 func (a *API) ListVersions(ctx context.Context, in *stencilv1beta1.ListVersionsRequest) (*stencilv1beta1.ListVersionsResponse, error) {
-	versions, err := a.schema.ListVersions(ctx, in.NamespaceId, in.SchemaId)
-	return &stencilv1beta1.ListVersionsResponse{Versions: versions}, err
+	versions, next, err := a.schema.ListVersionDetails(ctx, in.NamespaceId, in.SchemaId, int(in.GetPageSize()), in.GetPageToken())
+	res := &stencilv1beta1.ListVersionsResponse{NextPageToken: next}
+	for _, v := range versions {
+		res.Versions = append(res.Versions, v.Version)
+		res.VersionDetails = append(res.VersionDetails, versionToProto(v))
+	}
+	return res, err
+}
+
+func referencesToProto(refs []schema.Reference) []*stencilv1beta1.SchemaReference {
+	res := make([]*stencilv1beta1.SchemaReference, 0, len(refs))
+	for _, ref := range refs {
+		res = append(res, &stencilv1beta1.SchemaReference{NamespaceId: ref.NamespaceID, SchemaId: ref.Name, Version: ref.Version})
+	}
+	return res
+}
+
+func (a *API) ListReferences(ctx context.Context, in *stencilv1beta1.ListReferencesRequest) (*stencilv1beta1.ListReferencesResponse, error) {
+	refs, referrers, err := a.schema.ListReferences(ctx, in.NamespaceId, in.SchemaId, in.GetVersionId())
+	if err != nil {
+		return nil, err
+	}
+	return &stencilv1beta1.ListReferencesResponse{
+		References: referencesToProto(refs),
+		Referrers:  referencesToProto(referrers),
+	}, nil
+}
+
+func (a *API) DiffSchemaVersions(ctx context.Context, in *stencilv1beta1.DiffSchemaVersionsRequest) (*stencilv1beta1.DiffSchemaVersionsResponse, error) {
+	var compatibility string
+	if in.GetCompatibility() != stencilv1beta1.Schema_COMPATIBILITY_UNSPECIFIED {
+		compatibility = in.GetCompatibility().String()
+	}
+	diff, err := a.schema.Diff(ctx, in.NamespaceId, in.SchemaId, in.GetFromVersion(), in.GetToVersion(), compatibility)
+	if err != nil {
+		return nil, err
+	}
+	res := &stencilv1beta1.DiffSchemaVersionsResponse{
+		FromVersion:   diff.FromVersion,
+		ToVersion:     diff.ToVersion,
+		Compatibility: stencilv1beta1.Schema_Compatibility(stencilv1beta1.Schema_Compatibility_value[diff.Compatibility]),
+	}
+	for _, c := range diff.Changes {
+		change := &stencilv1beta1.SchemaChange{
+			Kind:     c.Kind,
+			Path:     c.Path,
+			Message:  c.Message,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+			Breaking: c.Breaking,
+		}
+		for _, mode := range c.BreakingFor {
+			change.BreakingFor = append(change.BreakingFor, stencilv1beta1.Schema_Compatibility(stencilv1beta1.Schema_Compatibility_value[mode]))
+		}
+		res.Changes = append(res.Changes, change)
+	}
+	return res, nil
+}
+
+func (a *API) UpdateVersionState(ctx context.Context, in *stencilv1beta1.UpdateVersionStateRequest) (*stencilv1beta1.UpdateVersionStateResponse, error) {
+	state := schema.VersionState{
+		State:  strings.TrimPrefix(in.GetState().String(), "STATE_"),
+		Reason: in.GetReason(),
+	}
+	if in.GetSunsetAt() != nil {
+		sunsetAt := in.GetSunsetAt().AsTime()
+		state.SunsetAt = &sunsetAt
+	}
+	v, err := a.schema.UpdateVersionState(ctx, in.NamespaceId, in.SchemaId, in.GetVersionId(), state)
+	if err != nil {
+		return nil, err
+	}
+	return &stencilv1beta1.UpdateVersionStateResponse{Version: versionToProto(v)}, nil
 }
 
 func (a *API) GetSchemaMetadata(ctx context.Context, in *stencilv1beta1.GetSchemaMetadataRequest) (*stencilv1beta1.GetSchemaMetadataResponse, error) {
@@ -156,3 +561,25 @@ func (a *API) DeleteVersion(ctx context.Context, in *stencilv1beta1.DeleteVersio
 		Message: message,
 	}, err
 }
+
+func (a *API) RestoreSchema(ctx context.Context, in *stencilv1beta1.RestoreSchemaRequest) (*stencilv1beta1.RestoreSchemaResponse, error) {
+	err := a.schema.Restore(ctx, in.NamespaceId, in.SchemaId)
+	message := "success"
+	if err != nil {
+		message = "failed"
+	}
+	return &stencilv1beta1.RestoreSchemaResponse{
+		Message: message,
+	}, err
+}
+
+func (a *API) RestoreVersion(ctx context.Context, in *stencilv1beta1.RestoreVersionRequest) (*stencilv1beta1.RestoreVersionResponse, error) {
+	err := a.schema.RestoreVersion(ctx, in.NamespaceId, in.SchemaId, in.GetVersionId())
+	message := "success"
+	if err != nil {
+		message = "failed"
+	}
+	return &stencilv1beta1.RestoreVersionResponse{
+		Message: message,
+	}, err
+}
@@ -2,15 +2,21 @@ package api_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/internal/store"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestHTTPGetSchema(t *testing.T) {
@@ -39,12 +45,182 @@ func TestHTTPGetSchema(t *testing.T) {
 		data := []byte("test data")
 		_, schemaSvc, _, mux, _ := setup()
 		schemaSvc.On("Get", mock.Anything, nsName, schemaName, version).Return(&schema.Metadata{Format: "FORMAT_PROTOBUF"}, data, nil)
+		schemaSvc.On("GetVersionRef", mock.Anything, nsName, schemaName, version).Return(schema.VersionRef{GlobalID: 11}, nil)
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/versions/%d", nsName, schemaName, version), nil)
 		mux.ServeHTTP(w, req)
 		assert.Equal(t, 200, w.Code)
 		assert.Equal(t, data, w.Body.Bytes())
 		assert.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+		assert.Equal(t, "11", w.Header().Get("X-Global-Id"))
+		assert.Equal(t, "FORMAT_PROTOBUF", w.Header().Get("X-Format"))
+		assert.Empty(t, w.Header().Get("Warning"))
+	})
+	t.Run("should return content type of registered format", func(t *testing.T) {
+		version := int32(2)
+		data := []byte("struct A {}")
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("Get", mock.Anything, nsName, schemaName, version).Return(&schema.Metadata{Format: "FORMAT_THRIFT"}, data, nil)
+		schemaSvc.On("GetVersionRef", mock.Anything, nsName, schemaName, version).Return(schema.VersionRef{GlobalID: 11}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/versions/%d", nsName, schemaName, version), nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "text/plain", w.Header().Get("Content-Type"))
+	})
+	t.Run("should serve deleted version with warning header", func(t *testing.T) {
+		version := int32(2)
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("Get", mock.Anything, nsName, schemaName, version).Return(&schema.Metadata{Format: "FORMAT_JSON"}, []byte("{}"), nil)
+		schemaSvc.On("GetVersionRef", mock.Anything, nsName, schemaName, version).Return(schema.VersionRef{GlobalID: 11, Deleted: true}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/versions/%d", nsName, schemaName, version), nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.Contains(t, w.Header().Get("Warning"), "deleted")
+	})
+	t.Run("should return lifecycle state of deprecated version in headers", func(t *testing.T) {
+		version := int32(2)
+		sunset := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("Get", mock.Anything, nsName, schemaName, version).Return(&schema.Metadata{Format: "FORMAT_JSON"}, []byte("{}"), nil)
+		ref := schema.VersionRef{GlobalID: 11, VersionState: schema.VersionState{State: schema.VersionStateDeprecated, Reason: "use v3", SunsetAt: &sunset}}
+		schemaSvc.On("GetVersionRef", mock.Anything, nsName, schemaName, version).Return(ref, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/versions/%d", nsName, schemaName, version), nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "DEPRECATED", w.Header().Get("X-Version-State"))
+		assert.Equal(t, "use v3", w.Header().Get("X-Version-State-Reason"))
+		assert.Equal(t, "Wed, 02 Jan 2030 03:04:05 GMT", w.Header().Get("Sunset"))
+	})
+}
+
+func TestHTTPLatestSchema(t *testing.T) {
+	t.Run("should serve latest version along with its headers", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		ref := schema.VersionRef{Version: 3, GlobalID: 12, VersionState: schema.VersionState{State: schema.VersionStateActive}}
+		schemaSvc.On("GetLatestVersionRef", mock.Anything, "namespace1", "scName").Return(ref, nil)
+		schemaSvc.On("Get", mock.Anything, "namespace1", "scName", int32(3)).Return(&schema.Metadata{Format: "FORMAT_AVRO"}, []byte(`"string"`), nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/v1beta1/namespaces/namespace1/schemas/scName", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, `"string"`, w.Body.String())
+		assert.Equal(t, "12", w.Header().Get("X-Global-Id"))
+		assert.Equal(t, "ACTIVE", w.Header().Get("X-Version-State"))
+		assert.Empty(t, w.Header().Get("Sunset"))
+	})
+}
+
+func TestListVersions(t *testing.T) {
+	t.Run("should return versions along with their state", func(t *testing.T) {
+		_, schemaSvc, _, _, api := setup()
+		versions := []schema.Version{
+			{Version: 1, VersionState: schema.VersionState{State: schema.VersionStateDisabled, Reason: "broken"}},
+			{Version: 2, VersionState: schema.VersionState{State: schema.VersionStateActive}},
+		}
+		schemaSvc.On("ListVersionDetails", mock.Anything, "ns", "sc", 0, "").Return(versions, "", nil)
+		res, err := api.ListVersions(context.Background(), &stencilv1beta1.ListVersionsRequest{NamespaceId: "ns", SchemaId: "sc"})
+		assert.Nil(t, err)
+		assert.Equal(t, []int32{1, 2}, res.Versions)
+		assert.Equal(t, stencilv1beta1.SchemaVersion_STATE_DISABLED, res.VersionDetails[0].State)
+		assert.Equal(t, "broken", res.VersionDetails[0].StateReason)
+		assert.Equal(t, stencilv1beta1.SchemaVersion_STATE_ACTIVE, res.VersionDetails[1].State)
+	})
+	t.Run("should return page of version details", func(t *testing.T) {
+		_, schemaSvc, _, _, api := setup()
+		createdAt := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+		versions := []schema.Version{
+			{Version: 3, ID: "id-3", SchemaFileID: "file-3", CreatedAt: createdAt, Creator: "user@example.com", Message: "add field", Size: 42},
+		}
+		schemaSvc.On("ListVersionDetails", mock.Anything, "ns", "sc", 1, "2").Return(versions, "3", nil)
+		res, err := api.ListVersions(context.Background(), &stencilv1beta1.ListVersionsRequest{NamespaceId: "ns", SchemaId: "sc", PageSize: 1, PageToken: "2"})
+		assert.Nil(t, err)
+		assert.Equal(t, "3", res.NextPageToken)
+		v := res.VersionDetails[0]
+		assert.Equal(t, "id-3", v.Id)
+		assert.Equal(t, "file-3", v.SchemaFileId)
+		assert.Equal(t, createdAt, v.CreatedAt.AsTime())
+		assert.Equal(t, "user@example.com", v.Creator)
+		assert.Equal(t, "add field", v.Message)
+		assert.Equal(t, int64(42), v.Size)
+	})
+}
+
+func TestUpdateVersionState(t *testing.T) {
+	t.Run("should pass state along with reason and sunset time", func(t *testing.T) {
+		_, schemaSvc, _, _, api := setup()
+		sunset := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+		state := schema.VersionState{State: schema.VersionStateDeprecated, Reason: "use v3", SunsetAt: &sunset}
+		schemaSvc.On("UpdateVersionState", mock.Anything, "ns", "sc", int32(2), state).Return(schema.Version{Version: 2, VersionState: state}, nil)
+		res, err := api.UpdateVersionState(context.Background(), &stencilv1beta1.UpdateVersionStateRequest{
+			NamespaceId: "ns",
+			SchemaId:    "sc",
+			VersionId:   2,
+			State:       stencilv1beta1.SchemaVersion_STATE_DEPRECATED,
+			Reason:      "use v3",
+			SunsetAt:    timestamppb.New(sunset),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, stencilv1beta1.SchemaVersion_STATE_DEPRECATED, res.Version.State)
+		assert.Equal(t, sunset, res.Version.SunsetAt.AsTime())
+	})
+}
+
+func TestHTTPGetSchemaByGlobalID(t *testing.T) {
+	t.Run("should validate global id", func(t *testing.T) {
+		_, _, _, mux, _ := setup()
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/v1beta1/schemas/ids/invalidID", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 400, w.Code)
+		assert.JSONEq(t, `{"code":2,"message":"invalid global id","details":[]}`, w.Body.String())
+	})
+	t.Run("should return http error if lookup fails", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("GetByGlobalID", mock.Anything, int32(7)).Return(schema.VersionRef{}, nil, nil, errors.New("get error"))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/v1beta1/schemas/ids/7", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 500, w.Code)
+		assert.JSONEq(t, `{"code":2,"message":"get error","details":[]}`, w.Body.String())
+	})
+	t.Run("should return schema data referred by global id", func(t *testing.T) {
+		data := []byte("test data")
+		_, schemaSvc, _, mux, _ := setup()
+		ref := schema.VersionRef{NamespaceID: "namespace1", Name: "scName", Version: 2, GlobalID: 7}
+		schemaSvc.On("GetByGlobalID", mock.Anything, int32(7)).Return(ref, &schema.Metadata{Format: "FORMAT_PROTOBUF"}, data, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/v1beta1/schemas/ids/7", nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, data, w.Body.Bytes())
+		assert.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+		assert.Equal(t, "7", w.Header().Get("X-Global-Id"))
+	})
+}
+
+func TestGetSchemaByGlobalID(t *testing.T) {
+	t.Run("should return schema along with its coordinates", func(t *testing.T) {
+		data := []byte("test data")
+		_, schemaSvc, _, _, api := setup()
+		ref := schema.VersionRef{NamespaceID: "namespace1", Name: "scName", Version: 2, GlobalID: 7}
+		schemaSvc.On("GetByGlobalID", mock.Anything, int32(7)).Return(ref, &schema.Metadata{Format: "FORMAT_AVRO"}, data, nil)
+		res, err := api.GetSchemaByGlobalID(context.Background(), &stencilv1beta1.GetSchemaByGlobalIDRequest{GlobalId: 7})
+		assert.Nil(t, err)
+		assert.Equal(t, "namespace1", res.NamespaceId)
+		assert.Equal(t, "scName", res.SchemaId)
+		assert.Equal(t, int32(2), res.Version)
+		assert.Equal(t, int32(7), res.GlobalId)
+		assert.Equal(t, stencilv1beta1.Schema_FORMAT_AVRO, res.Format)
+		assert.Equal(t, data, res.Data)
+	})
+	t.Run("should return error if lookup fails", func(t *testing.T) {
+		_, schemaSvc, _, _, api := setup()
+		schemaSvc.On("GetByGlobalID", mock.Anything, int32(7)).Return(schema.VersionRef{}, nil, nil, errors.New("get error"))
+		_, err := api.GetSchemaByGlobalID(context.Background(), &stencilv1beta1.GetSchemaByGlobalIDRequest{GlobalId: 7})
+		assert.EqualError(t, err, "get error")
 	})
 }
 
@@ -67,15 +243,323 @@ func TestHTTPSchemaCreate(t *testing.T) {
 	})
 	t.Run("should return schemaInfo in JSON after create", func(t *testing.T) {
 		_, schemaSvc, _, mux, _ := setup()
-		scInfo := schema.SchemaInfo{ID: "someID", Version: int32(2)}
-		schemaSvc.On("Create", mock.Anything, nsName, scName, &schema.Metadata{Format: format, Compatibility: compatibility}, body).Return(scInfo, nil)
+		scInfo := schema.SchemaInfo{ID: "someID", Version: int32(2), GlobalID: int32(5)}
+		schemaSvc.On("Create", mock.Anything, nsName, scName, &schema.Metadata{Format: format, Compatibility: compatibility, Message: "add field"}, body).Return(scInfo, nil)
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s", nsName, scName), bytes.NewBuffer(body))
 		req.Header.Add("X-Format", format)
 		req.Header.Add("X-Compatibility", compatibility)
+		req.Header.Add("X-Message", "add field")
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 201, w.Code)
+		assert.JSONEq(t, `{"id": "someID", "location": "", "version": 2, "global_id": 5}`, w.Body.String())
+		schemaSvc.AssertExpectations(t)
+	})
+	t.Run("should pass import root of source archive along with metadata", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("Create", mock.Anything, nsName, scName, &schema.Metadata{Format: format, Compatibility: compatibility, ImportRoot: "proto"}, body).Return(schema.SchemaInfo{ID: "someID", Version: 1}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s", nsName, scName), bytes.NewBuffer(body))
+		req.Header.Add("X-Format", format)
+		req.Header.Add("X-Compatibility", compatibility)
+		req.Header.Add("X-Import-Root", "proto")
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 201, w.Code)
+		schemaSvc.AssertExpectations(t)
+	})
+	t.Run("should not create schema on dry run", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		scInfo := schema.SchemaInfo{ID: "someID", Version: int32(2), Duplicate: true}
+		schemaSvc.On("CreateDryRun", mock.Anything, nsName, scName, &schema.Metadata{Format: format, Compatibility: compatibility}, body).Return(scInfo, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s?dry_run=true", nsName, scName), bytes.NewBuffer(body))
+		req.Header.Add("X-Format", format)
+		req.Header.Add("X-Compatibility", compatibility)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `{"id": "someID", "location": "", "version": 2, "duplicate": true}`, w.Body.String())
+		schemaSvc.AssertExpectations(t)
+		schemaSvc.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("should validate dry run value", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s?dry_run=maybe", nsName, scName), bytes.NewBuffer(body))
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 400, w.Code)
+		assert.JSONEq(t, `{"code":2,"message":"invalid dry_run value","details":[]}`, w.Body.String())
+		schemaSvc.AssertExpectations(t)
+	})
+}
+
+func TestHTTPCheckCompatibility(t *testing.T) {
+	nsName := "namespace"
+	scName := "schemaName"
+	compatibility := "COMPATIBILITY_BACKWARD"
+	body := []byte("protobuf contents")
+	t.Run("should return compatible true if check passes", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("CheckCompatibility", mock.Anything, nsName, scName, &schema.Metadata{Compatibility: compatibility}, body).Return(nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/check", nsName, scName), bytes.NewBuffer(body))
+		req.Header.Add("X-Compatibility", compatibility)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.JSONEq(t, `{"compatible": true, "violations": []}`, w.Body.String())
+		schemaSvc.AssertExpectations(t)
+	})
+	t.Run("should return violations if check fails", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		compErr := &schema.CompatibilityErr{
+			Compatibility: compatibility,
+			Violations: []schema.Violation{{
+				Kind:          "fieldKindChange",
+				Path:          "a.Message.field",
+				Message:       `1.proto: field "field" kind changed from "string" to "int64"`,
+				OldValue:      "string",
+				NewValue:      "int64",
+				Compatibility: compatibility,
+			}},
+		}
+		schemaSvc.On("CheckCompatibility", mock.Anything, nsName, scName, &schema.Metadata{Compatibility: compatibility}, body).Return(compErr)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/check", nsName, scName), bytes.NewBuffer(body))
+		req.Header.Add("X-Compatibility", compatibility)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 400, w.Code)
+		assert.JSONEq(t, `{"compatible": false, "violations": [{"kind": "fieldKindChange", "path": "a.Message.field", "message": "1.proto: field \"field\" kind changed from \"string\" to \"int64\"", "old_value": "string", "new_value": "int64", "compatibility": "COMPATIBILITY_BACKWARD"}]}`, w.Body.String())
+		schemaSvc.AssertExpectations(t)
+	})
+	t.Run("should return lint issues if lint fails", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		lintErr := &schema.LintErr{Issues: []schema.LintIssue{{
+			Rule:     "PACKAGE_DEFINED",
+			Severity: "ERROR",
+			Path:     "1.proto",
+			Message:  "1.proto: package is not defined",
+		}}}
+		schemaSvc.On("CheckCompatibility", mock.Anything, nsName, scName, &schema.Metadata{Compatibility: compatibility}, body).Return(lintErr)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/check", nsName, scName), bytes.NewBuffer(body))
+		req.Header.Add("X-Compatibility", compatibility)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 400, w.Code)
+		assert.JSONEq(t, `{"compatible": true, "violations": [], "lint_issues": [{"rule": "PACKAGE_DEFINED", "severity": "ERROR", "path": "1.proto", "message": "1.proto: package is not defined"}]}`, w.Body.String())
+		schemaSvc.AssertExpectations(t)
+	})
+	t.Run("should return lint issues along with violations", func(t *testing.T) {
+		_, schemaSvc, _, mux, api := setup()
+		lintErr := &schema.LintErr{Issues: []schema.LintIssue{{Rule: "PACKAGE_DEFINED", Severity: "ERROR", Path: "1.proto", Message: "1.proto: package is not defined"}}}
+		compErr := &schema.CompatibilityErr{Compatibility: compatibility, Violations: []schema.Violation{{Kind: "fieldDelete", Path: "a.Message.field", Message: "field deleted", Compatibility: compatibility}}}
+		schemaSvc.On("CheckCompatibility", mock.Anything, nsName, scName, &schema.Metadata{Compatibility: compatibility}, body).Return(errors.Join(lintErr, compErr))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/check", nsName, scName), bytes.NewBuffer(body))
+		req.Header.Add("X-Compatibility", compatibility)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 400, w.Code)
+		assert.JSONEq(t, `{"compatible": false, "violations": [{"kind": "fieldDelete", "path": "a.Message.field", "message": "field deleted", "old_value": "", "new_value": "", "compatibility": "COMPATIBILITY_BACKWARD"}], "lint_issues": [{"rule": "PACKAGE_DEFINED", "severity": "ERROR", "path": "1.proto", "message": "1.proto: package is not defined"}]}`, w.Body.String())
+
+		res, err := api.CheckCompatibility(context.Background(), &stencilv1beta1.CheckCompatibilityRequest{NamespaceId: nsName, SchemaId: scName, Data: body, Compatibility: stencilv1beta1.Schema_COMPATIBILITY_BACKWARD})
+		assert.Nil(t, err)
+		assert.False(t, res.GetCompatible())
+		assert.Len(t, res.GetViolations(), 1)
+		assert.Len(t, res.GetLintIssues(), 1)
+	})
+	t.Run("should return error if check fails for other reasons", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("CheckCompatibility", mock.Anything, nsName, scName, &schema.Metadata{Compatibility: compatibility}, body).Return(errors.New("check error"))
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/check", nsName, scName), bytes.NewBuffer(body))
+		req.Header.Add("X-Compatibility", compatibility)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 500, w.Code)
+		schemaSvc.AssertExpectations(t)
+	})
+}
+
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*stencilv1beta1.WatchSchemasResponse
+}
+
+func (w *watchStream) Context() context.Context {
+	return w.ctx
+}
+
+func (w *watchStream) Send(res *stencilv1beta1.WatchSchemasResponse) error {
+	w.events = append(w.events, res)
+	return nil
+}
+
+func TestWatchSchemas(t *testing.T) {
+	t.Run("should stream schema events until subscription is closed", func(t *testing.T) {
+		_, schemaSvc, _, _, api := setup()
+		stream := &watchStream{ctx: context.Background()}
+		events := make(chan schema.Event, 2)
+		events <- schema.Event{Type: schema.EventCreate, NamespaceID: "namespace1", SchemaName: "scName", Version: 3}
+		events <- schema.Event{Type: schema.EventUpdateMetadata, NamespaceID: "namespace1", SchemaName: "scName"}
+		close(events)
+		schemaSvc.On("Watch", mock.Anything, "namespace1", "scName").Return((<-chan schema.Event)(events))
+		err := api.WatchSchemas(&stencilv1beta1.WatchSchemasRequest{NamespaceId: "namespace1", SchemaId: "scName"}, stream)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(stream.events))
+		assert.Equal(t, stencilv1beta1.WatchSchemasResponse_EVENT_TYPE_CREATE, stream.events[0].Type)
+		assert.Equal(t, int32(3), stream.events[0].Version)
+		assert.Equal(t, "scName", stream.events[0].SchemaId)
+		assert.Equal(t, stencilv1beta1.WatchSchemasResponse_EVENT_TYPE_UPDATE_METADATA, stream.events[1].Type)
+	})
+	t.Run("should return context error if client goes away", func(t *testing.T) {
+		_, schemaSvc, _, _, api := setup()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		stream := &watchStream{ctx: ctx}
+		events := make(chan schema.Event)
+		close(events)
+		schemaSvc.On("Watch", mock.Anything, "namespace1", "").Return((<-chan schema.Event)(events))
+		err := api.WatchSchemas(&stencilv1beta1.WatchSchemasRequest{NamespaceId: "namespace1"}, stream)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestSchemaReferences(t *testing.T) {
+	nsName := "orders"
+	scName := "order"
+	body := []byte("protobuf contents")
+	common := schema.Reference{NamespaceID: "shared", Name: "common", Version: 3}
+	money := schema.Reference{NamespaceID: "shared", Name: "money", Version: 1}
+	t.Run("should pass references header along with metadata on create", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		meta := &schema.Metadata{Format: "FORMAT_PROTOBUF", References: []schema.Reference{common, money}}
+		schemaSvc.On("Create", mock.Anything, nsName, scName, meta, body).Return(schema.SchemaInfo{ID: "someID", Version: 1}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s", nsName, scName), bytes.NewBuffer(body))
+		req.Header.Add("X-Format", "FORMAT_PROTOBUF")
+		req.Header.Add("X-References", "shared/common@3, shared/money@1")
 		mux.ServeHTTP(w, req)
 		assert.Equal(t, 201, w.Code)
-		assert.JSONEq(t, `{"id": "someID", "location": "", "version": 2}`, w.Body.String())
 		schemaSvc.AssertExpectations(t)
 	})
+	t.Run("should return bad request if reference is invalid", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s", nsName, scName), bytes.NewBuffer(body))
+		req.Header.Add("X-References", "shared/common")
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 400, w.Code)
+		schemaSvc.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("should pass references of create request", func(t *testing.T) {
+		_, schemaSvc, _, _, api := setup()
+		meta := &schema.Metadata{Format: "FORMAT_PROTOBUF", Compatibility: "COMPATIBILITY_UNSPECIFIED", References: []schema.Reference{common}}
+		schemaSvc.On("Create", mock.Anything, nsName, scName, meta, body).Return(schema.SchemaInfo{Version: 1}, nil)
+		_, err := api.CreateSchema(context.Background(), &stencilv1beta1.CreateSchemaRequest{
+			NamespaceId: nsName,
+			SchemaId:    scName,
+			Format:      stencilv1beta1.Schema_FORMAT_PROTOBUF,
+			Data:        body,
+			References:  []*stencilv1beta1.SchemaReference{{NamespaceId: "shared", SchemaId: "common", Version: 3}},
+		})
+		assert.Nil(t, err)
+		schemaSvc.AssertExpectations(t)
+	})
+	t.Run("should pass references header on compatibility check", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		meta := &schema.Metadata{Compatibility: "COMPATIBILITY_BACKWARD", References: []schema.Reference{common}}
+		schemaSvc.On("CheckCompatibility", mock.Anything, nsName, scName, meta, body).Return(nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/check", nsName, scName), bytes.NewBuffer(body))
+		req.Header.Add("X-Compatibility", "COMPATIBILITY_BACKWARD")
+		req.Header.Add("X-References", "shared/common@3")
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		schemaSvc.AssertExpectations(t)
+	})
+	t.Run("should pass references of check compatibility request", func(t *testing.T) {
+		_, schemaSvc, _, _, api := setup()
+		meta := &schema.Metadata{Compatibility: "COMPATIBILITY_BACKWARD", References: []schema.Reference{common}}
+		schemaSvc.On("CheckCompatibility", mock.Anything, nsName, scName, meta, body).Return(nil)
+		res, err := api.CheckCompatibility(context.Background(), &stencilv1beta1.CheckCompatibilityRequest{
+			NamespaceId:   nsName,
+			SchemaId:      scName,
+			Data:          body,
+			Compatibility: stencilv1beta1.Schema_COMPATIBILITY_BACKWARD,
+			References:    []*stencilv1beta1.SchemaReference{{NamespaceId: "shared", SchemaId: "common", Version: 3}},
+		})
+		assert.Nil(t, err)
+		assert.True(t, res.GetCompatible())
+		schemaSvc.AssertExpectations(t)
+	})
+	t.Run("should pass import root header on compatibility check", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		meta := &schema.Metadata{ImportRoot: "proto"}
+		schemaSvc.On("CheckCompatibility", mock.Anything, nsName, scName, meta, body).Return(nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/check", nsName, scName), bytes.NewBuffer(body))
+		req.Header.Add("X-Import-Root", "proto")
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		schemaSvc.AssertExpectations(t)
+	})
+	t.Run("should serve assembled data if asked for", func(t *testing.T) {
+		_, schemaSvc, _, mux, _ := setup()
+		schemaSvc.On("GetAssembled", mock.Anything, nsName, scName, int32(2)).Return(&schema.Metadata{Format: "FORMAT_PROTOBUF"}, []byte("assembled"), nil)
+		schemaSvc.On("GetVersionRef", mock.Anything, nsName, scName, int32(2)).Return(schema.VersionRef{GlobalID: 11}, nil)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/versions/2?assemble=true", nsName, scName), nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, []byte("assembled"), w.Body.Bytes())
+		schemaSvc.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("should validate assemble value", func(t *testing.T) {
+		_, _, _, mux, _ := setup()
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/v1beta1/namespaces/%s/schemas/%s/versions/2?assemble=maybe", nsName, scName), nil)
+		mux.ServeHTTP(w, req)
+		assert.Equal(t, 400, w.Code)
+		assert.JSONEq(t, `{"code":2,"message":"invalid assemble value","details":[]}`, w.Body.String())
+	})
+	t.Run("should list references and referrers of version", func(t *testing.T) {
+		_, schemaSvc, _, _, api := setup()
+		referrer := schema.Reference{NamespaceID: "payments", Name: "payment", Version: 4}
+		schemaSvc.On("ListReferences", mock.Anything, "shared", "common", int32(3)).Return([]schema.Reference{money}, []schema.Reference{referrer}, nil)
+		res, err := api.ListReferences(context.Background(), &stencilv1beta1.ListReferencesRequest{NamespaceId: "shared", SchemaId: "common", VersionId: 3})
+		assert.Nil(t, err)
+		assert.Equal(t, "money", res.References[0].SchemaId)
+		assert.Equal(t, int32(1), res.References[0].Version)
+		assert.Equal(t, "payments", res.Referrers[0].NamespaceId)
+		assert.Equal(t, int32(4), res.Referrers[0].Version)
+	})
+}
+
+func TestDiffSchemaVersions(t *testing.T) {
+	t.Run("should use schema compatibility if compatibility is not given", func(t *testing.T) {
+		_, schemaSvc, _, _, api := setup()
+		schemaSvc.On("Diff", mock.Anything, "ns", "sc", int32(1), int32(2), "").Return(&schema.SchemaDiff{
+			FromVersion:   1,
+			ToVersion:     2,
+			Compatibility: "COMPATIBILITY_BACKWARD",
+			Changes: []schema.Change{{
+				Kind:        "fieldDelete",
+				Path:        "a.Test.name",
+				Message:     `1.proto: field "name" is deleted`,
+				OldValue:    "name",
+				BreakingFor: []string{"COMPATIBILITY_BACKWARD", "COMPATIBILITY_FULL"},
+				Breaking:    true,
+			}},
+		}, nil)
+		res, err := api.DiffSchemaVersions(context.Background(), &stencilv1beta1.DiffSchemaVersionsRequest{NamespaceId: "ns", SchemaId: "sc", FromVersion: 1, ToVersion: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, stencilv1beta1.Schema_COMPATIBILITY_BACKWARD, res.GetCompatibility())
+		assert.Equal(t, "fieldDelete", res.Changes[0].GetKind())
+		assert.True(t, res.Changes[0].GetBreaking())
+		assert.Equal(t, []stencilv1beta1.Schema_Compatibility{stencilv1beta1.Schema_COMPATIBILITY_BACKWARD, stencilv1beta1.Schema_COMPATIBILITY_FULL}, res.Changes[0].GetBreakingFor())
+	})
+	t.Run("should pass given compatibility and return error", func(t *testing.T) {
+		_, schemaSvc, _, _, api := setup()
+		schemaSvc.On("Diff", mock.Anything, "ns", "sc", int32(1), int32(3), "COMPATIBILITY_FORWARD").Return(nil, store.NoRowsErr)
+		_, err := api.DiffSchemaVersions(context.Background(), &stencilv1beta1.DiffSchemaVersionsRequest{NamespaceId: "ns", SchemaId: "sc", FromVersion: 1, ToVersion: 3, Compatibility: stencilv1beta1.Schema_COMPATIBILITY_FORWARD})
+		assert.ErrorIs(t, err, store.NoRowsErr)
+	})
 }
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+
+	"github.com/raystack/stencil/core/webhook"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func webhookToProto(sub webhook.Subscription) *stencilv1beta1.Webhook {
+	return &stencilv1beta1.Webhook{
+		Id:          sub.ID,
+		NamespaceId: sub.NamespaceID,
+		Url:         sub.URL,
+		Events:      sub.Events,
+		CreatedAt:   timestamppb.New(sub.CreatedAt),
+		UpdatedAt:   timestamppb.New(sub.UpdatedAt),
+	}
+}
+
+func deliveryToProto(d webhook.Delivery) *stencilv1beta1.WebhookDelivery {
+	return &stencilv1beta1.WebhookDelivery{
+		Id:         d.ID,
+		WebhookId:  d.SubscriptionID,
+		EventType:  d.EventType,
+		Payload:    string(d.Payload),
+		Attempt:    d.Attempt,
+		StatusCode: d.StatusCode,
+		Success:    d.Success,
+		Error:      d.Error,
+		CreatedAt:  timestamppb.New(d.CreatedAt),
+	}
+}
+
+func (a *API) CreateWebhook(ctx context.Context, in *stencilv1beta1.CreateWebhookRequest) (*stencilv1beta1.CreateWebhookResponse, error) {
+	sub, err := a.webhook.Create(ctx, webhook.Subscription{
+		NamespaceID: in.GetNamespaceId(),
+		URL:         in.GetUrl(),
+		Secret:      in.GetSecret(),
+		Events:      in.GetEvents(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &stencilv1beta1.CreateWebhookResponse{Webhook: webhookToProto(sub)}, nil
+}
+
+func (a *API) ListWebhooks(ctx context.Context, in *stencilv1beta1.ListWebhooksRequest) (*stencilv1beta1.ListWebhooksResponse, error) {
+	subs, err := a.webhook.List(ctx, in.GetNamespaceId())
+	if err != nil {
+		return nil, err
+	}
+	var webhooks []*stencilv1beta1.Webhook
+	for _, sub := range subs {
+		webhooks = append(webhooks, webhookToProto(sub))
+	}
+	return &stencilv1beta1.ListWebhooksResponse{Webhooks: webhooks}, nil
+}
+
+func (a *API) DeleteWebhook(ctx context.Context, in *stencilv1beta1.DeleteWebhookRequest) (*stencilv1beta1.DeleteWebhookResponse, error) {
+	err := a.webhook.Delete(ctx, in.GetNamespaceId(), in.GetId())
+	message := "success"
+	if err != nil {
+		message = "failed"
+	}
+	return &stencilv1beta1.DeleteWebhookResponse{Message: message}, err
+}
+
+// ListWebhookDeliveries returns recent delivery attempts of the webhook, latest first
+func (a *API) ListWebhookDeliveries(ctx context.Context, in *stencilv1beta1.ListWebhookDeliveriesRequest) (*stencilv1beta1.ListWebhookDeliveriesResponse, error) {
+	deliveries, err := a.webhook.ListDeliveries(ctx, in.GetNamespaceId(), in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	var res []*stencilv1beta1.WebhookDelivery
+	for _, d := range deliveries {
+		res = append(res, deliveryToProto(d))
+	}
+	return &stencilv1beta1.ListWebhookDeliveriesResponse{Deliveries: res}, nil
+}
@@ -0,0 +1,68 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/raystack/stencil/core/webhook"
+	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	ctx := context.Background()
+	req := &stencilv1beta1.CreateWebhookRequest{NamespaceId: "ns", Url: "http://localhost/hook", Secret: "secret", Events: []string{"CREATE"}}
+	sub := webhook.Subscription{NamespaceID: "ns", URL: "http://localhost/hook", Secret: "secret", Events: []string{"CREATE"}}
+	t.Run("should return error if create fails", func(t *testing.T) {
+		_, _, _, webhookSvc, _, api := setupWithWebhook()
+		webhookSvc.On("Create", mock.Anything, sub).Return(webhook.Subscription{}, webhook.ErrInvalidURL)
+		_, err := api.CreateWebhook(ctx, req)
+		assert.ErrorIs(t, err, webhook.ErrInvalidURL)
+	})
+	t.Run("should not return secret in response", func(t *testing.T) {
+		_, _, _, webhookSvc, _, api := setupWithWebhook()
+		created := sub
+		created.ID = 1
+		created.CreatedAt = time.Now()
+		webhookSvc.On("Create", mock.Anything, sub).Return(created, nil)
+		res, err := api.CreateWebhook(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), res.Webhook.Id)
+		assert.Equal(t, "http://localhost/hook", res.Webhook.Url)
+		assert.Equal(t, []string{"CREATE"}, res.Webhook.Events)
+		webhookSvc.AssertExpectations(t)
+	})
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should return failed message on error", func(t *testing.T) {
+		_, _, _, webhookSvc, _, api := setupWithWebhook()
+		webhookSvc.On("Delete", mock.Anything, "ns", int64(1)).Return(errors.New("delete error"))
+		res, err := api.DeleteWebhook(ctx, &stencilv1beta1.DeleteWebhookRequest{NamespaceId: "ns", Id: 1})
+		assert.EqualError(t, err, "delete error")
+		assert.Equal(t, "failed", res.Message)
+	})
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	t.Run("should return delivery log of the webhook", func(t *testing.T) {
+		_, _, _, webhookSvc, _, api := setupWithWebhook()
+		deliveries := []webhook.Delivery{
+			{ID: 2, SubscriptionID: 1, EventType: "CREATE", Payload: []byte(`{"event":"CREATE"}`), Attempt: 2, StatusCode: 200, Success: true},
+			{ID: 1, SubscriptionID: 1, EventType: "CREATE", Payload: []byte(`{"event":"CREATE"}`), Attempt: 1, StatusCode: 500, Error: "unexpected status code 500"},
+		}
+		webhookSvc.On("ListDeliveries", mock.Anything, "ns", int64(1)).Return(deliveries, nil)
+		res, err := api.ListWebhookDeliveries(ctx, &stencilv1beta1.ListWebhookDeliveriesRequest{NamespaceId: "ns", Id: 1})
+		assert.NoError(t, err)
+		assert.Len(t, res.Deliveries, 2)
+		assert.Equal(t, int64(1), res.Deliveries[0].WebhookId)
+		assert.Equal(t, `{"event":"CREATE"}`, res.Deliveries[0].Payload)
+		assert.True(t, res.Deliveries[0].Success)
+		assert.Equal(t, "unexpected status code 500", res.Deliveries[1].Error)
+	})
+}
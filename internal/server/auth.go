@@ -0,0 +1,23 @@
+package server
+
+import (
+	"github.com/raystack/stencil/config"
+	"github.com/raystack/stencil/core/auth"
+)
+
+func newAuthService(cfg config.AuthConfig, repo auth.Repository) (*auth.Service, error) {
+	authenticators := auth.Authenticators{auth.StaticTokens(cfg.Tokens)}
+	if cfg.JWT.JWKSFile != "" {
+		jwtAuthenticator, err := auth.NewJWTAuthenticator(auth.JWTConfig{
+			JWKSFile:       cfg.JWT.JWKSFile,
+			Issuer:         cfg.JWT.Issuer,
+			Audience:       cfg.JWT.Audience,
+			PrincipalClaim: cfg.JWT.PrincipalClaim,
+		})
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, jwtAuthenticator)
+	}
+	return auth.NewService(authenticators, repo, cfg.Admins), nil
+}
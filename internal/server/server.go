@@ -20,12 +20,16 @@ import (
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/raystack/stencil/core/audit"
 	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/retention"
 	"github.com/raystack/stencil/core/schema"
 	"github.com/raystack/stencil/core/schema/provider"
 	"github.com/raystack/stencil/core/search"
+	"github.com/raystack/stencil/core/webhook"
 	"github.com/raystack/stencil/internal/api"
 	"github.com/raystack/stencil/pkg/logger"
+	"github.com/raystack/stencil/pkg/requestid"
 	"github.com/raystack/stencil/pkg/validator"
 	stencilv1beta1 "github.com/raystack/stencil/proto/raystack/stencil/v1beta1"
 	"golang.org/x/net/http2"
@@ -57,20 +61,55 @@ func Start(cfg config.Config) {
 	searchRepository := postgres.NewSearchRepository(db)
 	searchService := search.NewService(searchRepository)
 
-	api := api.NewAPI(namespaceService, schemaService, searchService)
+	webhookRepository := postgres.NewWebhookRepository(db)
+	webhookService := webhook.NewService(webhookRepository, schemaService, webhook.Config{
+		MaxAttempts: cfg.Webhook.MaxAttempts,
+		Backoff:     cfg.Webhook.Backoff,
+		MaxBackoff:  cfg.Webhook.MaxBackoff,
+		Timeout:     cfg.Webhook.Timeout,
+		SecretKey:   cfg.Webhook.SecretKey,
+	})
+	schemaService.AddEventHandler(webhookService.Notify)
+	deliveryCtx, stopDeliverer := context.WithCancel(ctx)
+	go webhookService.RunDeliverer(deliveryCtx, cfg.Webhook.PollInterval)
+
+	var authService api.AuthService
+	if cfg.Auth.Enabled {
+		authService, err = newAuthService(cfg.Auth, postgres.NewRoleBindingRepository(db))
+		if err != nil {
+			log.Fatalln("Failed to initialise auth:", err)
+		}
+	}
+
+	auditService := audit.NewService(postgres.NewAuditRepository(db))
+
+	retentionService := retention.NewService(postgres.NewRetentionRepository(db), cfg.Retention.Period)
+	purgeCtx, stopPurger := context.WithCancel(ctx)
+	go retentionService.RunPurger(purgeCtx, cfg.Retention.PurgeInterval)
+
+	api := api.NewAPI(namespaceService, schemaService, searchService, webhookService, authService, auditService, retentionService)
 
 	port := fmt.Sprintf(":%s", cfg.Port)
 	nr := getNewRelic(&cfg)
-	gatewayMux := runtime.NewServeMux()
+	gatewayMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(requestid.HeaderMatcher))
 
 	// init grpc server
 	opts := []grpc.ServerOption{
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			grpc_recovery.UnaryServerInterceptor(),
 			grpc_ctxtags.UnaryServerInterceptor(),
+			requestid.UnaryServerInterceptor(),
 			nrgrpc.UnaryServerInterceptor(nr),
 			grpc_zap.UnaryServerInterceptor(logger.Logger),
+			api.UnaryAuthInterceptor(),
 			validator.UnaryServerInterceptor())),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			grpc_recovery.StreamServerInterceptor(),
+			grpc_ctxtags.StreamServerInterceptor(),
+			requestid.StreamServerInterceptor(),
+			nrgrpc.StreamServerInterceptor(nr),
+			grpc_zap.StreamServerInterceptor(logger.Logger),
+			api.StreamAuthInterceptor())),
 		grpc.MaxRecvMsgSize(cfg.GRPC.MaxRecvMsgSizeInMB << 20),
 		grpc.MaxSendMsgSize(cfg.GRPC.MaxSendMsgSizeInMB << 20),
 	}
@@ -87,6 +126,7 @@ func Start(cfg config.Config) {
 		log.Fatalln("Failed to dial server:", err)
 	}
 	api.RegisterSchemaHandlers(gatewayMux, nr)
+	api.RegisterConfluentHandlers(gatewayMux, nr)
 
 	if err = stencilv1beta1.RegisterStencilServiceHandler(ctx, gatewayMux, conn); err != nil {
 		log.Fatalln("Failed to register stencil service handler:", err)
@@ -100,7 +140,9 @@ func Start(cfg config.Config) {
 	}
 	rtr.PathPrefix("/ui").Handler(http.StripPrefix("/ui", spaHandler))
 
-	runWithGracefulShutdown(&cfg, grpcHandlerFunc(s, gatewayMux, rtr), func() {
+	runWithGracefulShutdown(&cfg, grpcHandlerFunc(s, requestid.HTTPMiddleware(gatewayMux), rtr), func() {
+		stopPurger()
+		stopDeliverer()
 		conn.Close()
 		s.GracefulStop()
 		db.Close()
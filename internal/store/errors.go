@@ -14,6 +14,7 @@ const (
 	unknown
 	conflict
 	noRows
+	referenced
 )
 
 var (
@@ -23,6 +24,8 @@ var (
 	ConflictErr = StorageErr{kind: conflict}
 	//NoRowsErr can be used to represent not found/no result
 	NoRowsErr = StorageErr{kind: noRows}
+	//ReferencedErr is used when removing resource referenced by other resources, or restoring resource referring to removed resources
+	ReferencedErr = StorageErr{kind: referenced}
 )
 
 // StorageErr implements error interface. Used for storage layer. Consumers can check for this error type to inspect storage errors.
@@ -47,6 +50,9 @@ func (e StorageErr) GRPCStatus() *status.Status {
 	if e.kind == conflict {
 		return status.New(codes.AlreadyExists, fmt.Sprintf("%s %s", e.name, "resource already exists"))
 	}
+	if e.kind == referenced {
+		return status.New(codes.FailedPrecondition, fmt.Sprintf("%s: %s", e.name, e.Error()))
+	}
 	return status.New(codes.Unknown, e.Error())
 }
 
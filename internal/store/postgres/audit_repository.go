@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/raystack/stencil/core/audit"
+	"github.com/raystack/stencil/pkg/actor"
+	"github.com/raystack/stencil/pkg/requestid"
+)
+
+type AuditRepository struct {
+	db *DB
+}
+
+func NewAuditRepository(dbc *DB) *AuditRepository {
+	return &AuditRepository{
+		db: dbc,
+	}
+}
+
+func (r *AuditRepository) List(ctx context.Context, filter audit.Filter) ([]audit.Event, error) {
+	var events []audit.Event
+	err := pgxscan.Select(ctx, r.db, &events, auditListQuery, filter.NamespaceID, filter.SchemaName, filter.BeforeID, filter.PageSize)
+	return events, wrapError(err, "audit events")
+}
+
+// recordAudit appends audit event within the transaction of the mutation, so that
+// either both the mutation and its audit event are stored or neither is.
+// Event is also queued in outbox, to be relayed to webhooks even if server stops right after the mutation.
+func recordAudit(ctx context.Context, t pgx.Tx, op audit.Operation, namespaceID, schemaName string, version int32, before, after interface{}) error {
+	beforeJSON, err := toJSON(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := toJSON(after)
+	if err != nil {
+		return err
+	}
+	var id int64
+	if err := t.QueryRow(ctx, auditInsertQuery, actor.FromContext(ctx), requestid.FromContext(ctx), op, namespaceID, schemaName, version, beforeJSON, afterJSON).Scan(&id); err != nil {
+		return err
+	}
+	_, err = t.Exec(ctx, auditOutboxInsertQuery, id)
+	return err
+}
+
+func toJSON(v interface{}) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+const auditInsertQuery = `
+INSERT INTO audit_events (actor, request_id, operation, namespace_id, schema_name, version, before, after, created_at)
+    VALUES (NULLIF($1, ''), NULLIF($2, ''), $3, $4, NULLIF($5, ''), NULLIF($6, 0), $7, $8, now())
+RETURNING id
+`
+
+const auditOutboxInsertQuery = `
+INSERT INTO audit_event_outbox (audit_event_id) VALUES ($1)
+`
+
+const auditListQuery = `
+SELECT id, COALESCE(actor, '') as actor, COALESCE(request_id, '') as request_id, operation, namespace_id,
+	COALESCE(schema_name, '') as schema_name, COALESCE(version, 0) as version, before, after, created_at
+	FROM audit_events
+	WHERE ($1 = '' OR namespace_id=$1) AND ($2 = '' OR schema_name=$2) AND ($3 = 0 OR id < $3)
+	ORDER BY id DESC
+	LIMIT $4
+`
@@ -0,0 +1,87 @@
+package postgres_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/raystack/stencil/core/audit"
+	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/internal/store/postgres"
+	"github.com/raystack/stencil/pkg/actor"
+	"github.com/raystack/stencil/pkg/requestid"
+	"github.com/stretchr/testify/assert"
+)
+
+func getAuditStore(t *testing.T) (*postgres.AuditRepository, *postgres.DB) {
+	t.Helper()
+	connectionString := os.Getenv("TEST_DB_CONNECTIONSTRING")
+	if connectionString == "" {
+		t.Skip("Skipping test since DB info not available")
+		return nil, nil
+	}
+	err := postgres.Migrate(connectionString)
+	assert.Nil(t, err)
+	dbc := postgres.NewStore(connectionString)
+	return postgres.NewAuditRepository(dbc), dbc
+}
+
+func TestAudit(t *testing.T) {
+	tearDown(t)
+	db, dbc := getAuditStore(t)
+	namespaceStore := postgres.NewNamespaceRepository(dbc)
+	schemaStore := postgres.NewSchemaRepository(dbc)
+	ctx := requestid.WithRequestID(actor.WithActor(context.Background(), "user@example.com"), "req-1")
+	n := namespace.Namespace{ID: "testaudit", Format: "FORMAT_AVRO", Compatibility: "COMPATIBILITY_BACKWARD"}
+	_, err := namespaceStore.Create(ctx, n)
+	assert.Nil(t, err)
+	_, _, _, err = schemaStore.Create(ctx, n.ID, "sc", &schema.Metadata{Format: "FORMAT_AVRO", Compatibility: "COMPATIBILITY_BACKWARD"}, "uuid-1", &schema.SchemaFile{ID: "file-1", Data: []byte(`"string"`)})
+	assert.Nil(t, err)
+	_, err = schemaStore.UpdateMetadata(ctx, n.ID, "sc", &schema.Metadata{Compatibility: "COMPATIBILITY_FULL"})
+	assert.Nil(t, err)
+	t.Run("list: should list events of namespace latest first", func(t *testing.T) {
+		events, err := db.List(ctx, audit.Filter{NamespaceID: n.ID, PageSize: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(events))
+		assert.Equal(t, audit.OperationUpdateSchemaMetadata, events[0].Operation)
+		assert.JSONEq(t, `{"authority": "user@example.com", "format": "FORMAT_AVRO", "compatibility": "COMPATIBILITY_BACKWARD"}`, string(events[0].Before))
+		assert.JSONEq(t, `{"authority": "user@example.com", "format": "FORMAT_AVRO", "compatibility": "COMPATIBILITY_FULL"}`, string(events[0].After))
+		assert.Equal(t, audit.OperationCreateVersion, events[1].Operation)
+		assert.Equal(t, int32(1), events[1].Version)
+		assert.Nil(t, events[1].Before)
+		assert.Equal(t, audit.OperationCreateNamespace, events[2].Operation)
+		for _, e := range events {
+			assert.Equal(t, "user@example.com", e.Actor)
+			assert.Equal(t, "req-1", e.RequestID)
+		}
+	})
+	t.Run("list: should paginate events", func(t *testing.T) {
+		events, err := db.List(ctx, audit.Filter{NamespaceID: n.ID, PageSize: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(events))
+		older, err := db.List(ctx, audit.Filter{NamespaceID: n.ID, PageSize: 10, BeforeID: events[0].ID})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(older))
+	})
+	t.Run("list: should filter events by schema", func(t *testing.T) {
+		events, err := db.List(ctx, audit.Filter{NamespaceID: n.ID, SchemaName: "sc", PageSize: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(events))
+	})
+	t.Run("should not allow modifying audit events", func(t *testing.T) {
+		_, err := dbc.Exec(ctx, "UPDATE audit_events SET actor='someone'")
+		assert.Error(t, err)
+		_, err = dbc.Exec(ctx, "DELETE from audit_events")
+		assert.Error(t, err)
+	})
+	t.Run("should keep events of deleted namespace", func(t *testing.T) {
+		assert.Nil(t, schemaStore.Delete(ctx, n.ID, "sc"))
+		assert.Nil(t, namespaceStore.Delete(ctx, n.ID))
+		events, err := db.List(ctx, audit.Filter{NamespaceID: n.ID, PageSize: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, 5, len(events))
+		assert.Equal(t, audit.OperationDeleteNamespace, events[0].Operation)
+		assert.Nil(t, events[0].After)
+	})
+}
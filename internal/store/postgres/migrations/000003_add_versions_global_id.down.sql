@@ -0,0 +1,2 @@
+ALTER TABLE versions DROP CONSTRAINT IF EXISTS versions_global_id_unique;
+ALTER TABLE versions DROP COLUMN IF EXISTS global_id;
@@ -0,0 +1,2 @@
+ALTER TABLE versions ADD COLUMN IF NOT EXISTS global_id SERIAL;
+ALTER TABLE versions ADD CONSTRAINT versions_global_id_unique UNIQUE (global_id);
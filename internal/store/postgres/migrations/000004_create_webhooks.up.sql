@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions(
+	id BIGSERIAL PRIMARY KEY,
+	namespace_id VARCHAR NOT NULL,
+	url VARCHAR NOT NULL,
+	secret VARCHAR NOT NULL,
+	events VARCHAR[],
+	created_at TIMESTAMP,
+	updated_at TIMESTAMP,
+	CONSTRAINT fk_webhook_subscriptions_namespace_id FOREIGN KEY(namespace_id) REFERENCES namespaces(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries(
+	id BIGSERIAL PRIMARY KEY,
+	subscription_id BIGINT NOT NULL,
+	event_type VARCHAR,
+	payload JSONB,
+	attempt INTEGER,
+	status_code INTEGER,
+	success BOOLEAN,
+	error VARCHAR,
+	created_at TIMESTAMP,
+	CONSTRAINT fk_webhook_deliveries_subscription_id FOREIGN KEY(subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_subscription_id_idx ON webhook_deliveries(subscription_id, created_at);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS role_bindings(
+	namespace_id VARCHAR NOT NULL,
+	principal VARCHAR NOT NULL,
+	role VARCHAR NOT NULL,
+	created_at TIMESTAMP,
+	updated_at TIMESTAMP,
+	PRIMARY KEY (namespace_id, principal),
+	CONSTRAINT fk_role_bindings_namespace_id FOREIGN KEY(namespace_id) REFERENCES namespaces(id) ON DELETE CASCADE
+);
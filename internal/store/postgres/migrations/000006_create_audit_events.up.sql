@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS audit_events(
+	id BIGSERIAL PRIMARY KEY,
+	actor VARCHAR,
+	request_id VARCHAR,
+	operation VARCHAR NOT NULL,
+	namespace_id VARCHAR NOT NULL,
+	schema_name VARCHAR,
+	version INTEGER,
+	before JSONB,
+	after JSONB,
+	created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_namespace_idx ON audit_events(namespace_id, schema_name, id);
+
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only BEFORE UPDATE OR DELETE ON audit_events
+	FOR EACH ROW EXECUTE PROCEDURE audit_events_append_only();
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS versions_deleted_at_idx;
+DROP INDEX IF EXISTS schemas_deleted_at_idx;
+DROP INDEX IF EXISTS namespaces_deleted_at_idx;
+ALTER TABLE versions DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE schemas DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE namespaces DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,7 @@
+ALTER TABLE namespaces ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE schemas ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE versions ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS namespaces_deleted_at_idx ON namespaces(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS schemas_deleted_at_idx ON schemas(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS versions_deleted_at_idx ON versions(deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE versions DROP COLUMN IF EXISTS sunset_at;
+ALTER TABLE versions DROP COLUMN IF EXISTS state_reason;
+ALTER TABLE versions DROP COLUMN IF EXISTS state;
@@ -0,0 +1,3 @@
+ALTER TABLE versions ADD COLUMN IF NOT EXISTS state TEXT NOT NULL DEFAULT 'ACTIVE';
+ALTER TABLE versions ADD COLUMN IF NOT EXISTS state_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE versions ADD COLUMN IF NOT EXISTS sunset_at TIMESTAMP;
@@ -0,0 +1,2 @@
+ALTER TABLE versions DROP COLUMN IF EXISTS message;
+ALTER TABLE versions DROP COLUMN IF EXISTS created_by;
@@ -0,0 +1,2 @@
+ALTER TABLE versions ADD COLUMN IF NOT EXISTS created_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE versions ADD COLUMN IF NOT EXISTS message TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS version_references(
+	version_id VARCHAR NOT NULL,
+	ref_version_id VARCHAR NOT NULL,
+	CONSTRAINT fk_version_references_version_id FOREIGN KEY(version_id) REFERENCES versions(id) ON DELETE CASCADE,
+	CONSTRAINT fk_version_references_ref_version_id FOREIGN KEY(ref_version_id) REFERENCES versions(id) ON DELETE CASCADE,
+	CONSTRAINT version_references_pkey PRIMARY KEY (version_id, ref_version_id)
+);
+
+CREATE INDEX IF NOT EXISTS version_references_ref_version_id_idx ON version_references(ref_version_id);
@@ -0,0 +1,2 @@
+ALTER TABLE namespaces DROP COLUMN IF EXISTS lint_rules;
+ALTER TABLE namespaces DROP COLUMN IF EXISTS lint_rule_set;
@@ -0,0 +1,2 @@
+ALTER TABLE namespaces ADD COLUMN IF NOT EXISTS lint_rule_set TEXT NOT NULL DEFAULT '';
+ALTER TABLE namespaces ADD COLUMN IF NOT EXISTS lint_rules JSONB NOT NULL DEFAULT '{}';
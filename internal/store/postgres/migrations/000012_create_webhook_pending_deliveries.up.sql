@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS webhook_pending_deliveries(
+	id BIGSERIAL PRIMARY KEY,
+	subscription_id BIGINT NOT NULL,
+	event_type VARCHAR,
+	payload JSONB,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	next_attempt_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP,
+	CONSTRAINT fk_webhook_pending_deliveries_subscription_id FOREIGN KEY(subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhook_pending_deliveries_next_attempt_at_idx ON webhook_pending_deliveries(next_attempt_at);
@@ -0,0 +1,4 @@
+CREATE TABLE IF NOT EXISTS audit_event_outbox(
+	audit_event_id BIGINT PRIMARY KEY,
+	CONSTRAINT fk_audit_event_outbox_audit_event_id FOREIGN KEY(audit_event_id) REFERENCES audit_events(id)
+);
@@ -2,33 +2,80 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"github.com/raystack/stencil/core/audit"
 	"github.com/raystack/stencil/core/namespace"
 )
 
+const namespaceColumns = `id, format, compatibility, description, lint_rule_set, lint_rules, created_at, updated_at`
+
 const namespaceListQuery = `
-SELECT id, format, compatibility from namespaces
+SELECT id, format, compatibility from namespaces where deleted_at IS NULL
 `
 
 const namespaceGetQuery = `
-SELECT * from namespaces where id=$1
+SELECT ` + namespaceColumns + ` from namespaces where id=$1 AND deleted_at IS NULL
+`
+
+const namespaceGetForUpdateQuery = `
+SELECT ` + namespaceColumns + ` from namespaces where id=$1 AND deleted_at IS NULL FOR UPDATE
+`
+
+const namespaceGetDeletedForUpdateQuery = `
+SELECT deleted_at from namespaces where id=$1 AND deleted_at IS NOT NULL FOR UPDATE
 `
 
+// namespaceDeleteQuery marks namespace deleted along with its schemas and versions.
+// All of them get same deletion time, so that restore can tell what was deleted along with the namespace.
 const namespaceDeleteQuery = `
-DELETE from namespaces where id=$1
+WITH deleted_namespace AS (
+	UPDATE namespaces SET deleted_at=now() WHERE id=$1
+),
+deleted_schemas AS (
+	UPDATE schemas SET deleted_at=now() WHERE namespace_id=$1 AND deleted_at IS NULL
+)
+UPDATE versions SET deleted_at=now() WHERE deleted_at IS NULL AND schema_id IN (SELECT id from schemas WHERE namespace_id=$1)
+`
+
+const namespaceRestoreQuery = `
+WITH restored_schemas(id) AS (
+	UPDATE schemas SET deleted_at=NULL WHERE namespace_id=$1 AND deleted_at=$2 RETURNING id
+),
+restored_versions AS (
+	UPDATE versions SET deleted_at=NULL WHERE deleted_at=$2 AND schema_id IN (SELECT id from restored_schemas)
+)
+UPDATE namespaces SET deleted_at=NULL WHERE id=$1
+RETURNING ` + namespaceColumns + `
+`
+
+// listDeletedReferencesOfNamespaceQuery lists deleted versions referred by versions deleted along with the namespace,
+// leaving out versions which are restored together with it
+const listDeletedReferencesOfNamespaceQuery = `
+SELECT rsc.namespace_id as namespace_id, rsc.name as name, rvs.version as version from version_references as vr
+JOIN versions as vs ON vs.id=vr.version_id
+JOIN schemas as sc ON sc.id=vs.schema_id
+JOIN versions as rvs ON rvs.id=vr.ref_version_id
+JOIN schemas as rsc ON rsc.id=rvs.schema_id
+WHERE sc.namespace_id=$1 AND sc.deleted_at=$2 AND vs.deleted_at=$2
+AND (rvs.deleted_at IS NOT NULL OR rsc.deleted_at IS NOT NULL)
+AND NOT (rsc.namespace_id=$1 AND rsc.deleted_at=$2 AND rvs.deleted_at=$2)
+ORDER BY rsc.namespace_id, rsc.name, rvs.version
 `
 
 const namespaceUpdateQuery = `
-UPDATE namespaces SET format=$2,compatibility=$3,description=$4,updated_at=now()
+UPDATE namespaces SET format=$2,compatibility=$3,description=$4,lint_rule_set=$5,lint_rules=$6,updated_at=now()
 WHERE id = $1
-RETURNING *
+RETURNING ` + namespaceColumns + `
 `
 
 const namespaceInsertQuery = `
-INSERT INTO namespaces (id, format, compatibility, description, created_at, updated_at)
-    VALUES ($1, $2, $3, $4, now(), now())
-RETURNING *
+INSERT INTO namespaces (id, format, compatibility, description, lint_rule_set, lint_rules, created_at, updated_at)
+    VALUES ($1, $2, $3, $4, $5, $6, now(), now())
+RETURNING ` + namespaceColumns + `
 `
 
 type NamespaceRepository struct {
@@ -43,13 +90,27 @@ func NewNamespaceRepository(dbc *DB) *NamespaceRepository {
 
 func (r *NamespaceRepository) Create(ctx context.Context, ns namespace.Namespace) (namespace.Namespace, error) {
 	newNamespace := namespace.Namespace{}
-	err := pgxscan.Get(ctx, r.db, &newNamespace, namespaceInsertQuery, ns.ID, ns.Format, ns.Compatibility, ns.Description)
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		if err := pgxscan.Get(ctx, t, &newNamespace, namespaceInsertQuery, ns.ID, ns.Format, ns.Compatibility, ns.Description, ns.LintRuleSet, lintRules(ns)); err != nil {
+			return err
+		}
+		return recordAudit(ctx, t, audit.OperationCreateNamespace, ns.ID, "", 0, nil, toNamespaceState(newNamespace))
+	})
 	return newNamespace, wrapError(err, "%s", ns.ID)
 }
 
 func (r *NamespaceRepository) Update(ctx context.Context, ns namespace.Namespace) (namespace.Namespace, error) {
 	newNamespace := namespace.Namespace{}
-	err := pgxscan.Get(ctx, r.db, &newNamespace, namespaceUpdateQuery, ns.ID, ns.Format, ns.Compatibility, ns.Description)
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		before := namespace.Namespace{}
+		if err := pgxscan.Get(ctx, t, &before, namespaceGetForUpdateQuery, ns.ID); err != nil {
+			return err
+		}
+		if err := pgxscan.Get(ctx, t, &newNamespace, namespaceUpdateQuery, ns.ID, ns.Format, ns.Compatibility, ns.Description, ns.LintRuleSet, lintRules(ns)); err != nil {
+			return err
+		}
+		return recordAudit(ctx, t, audit.OperationUpdateNamespace, ns.ID, "", 0, toNamespaceState(before), toNamespaceState(newNamespace))
+	})
 	return newNamespace, wrapError(err, "%s", ns.ID)
 }
 
@@ -60,13 +121,68 @@ func (r *NamespaceRepository) Get(ctx context.Context, id string) (namespace.Nam
 }
 
 func (r *NamespaceRepository) Delete(ctx context.Context, id string) error {
-	_, err := r.db.Exec(ctx, namespaceDeleteQuery, id)
-	r.db.Exec(ctx, deleteOrphanedData)
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		before := namespace.Namespace{}
+		if err := pgxscan.Get(ctx, t, &before, namespaceGetForUpdateQuery, id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+		if err := checkNotReferenced(ctx, t, id, "", 0); err != nil {
+			return err
+		}
+		if _, err := t.Exec(ctx, namespaceDeleteQuery, id); err != nil {
+			return err
+		}
+		return recordAudit(ctx, t, audit.OperationDeleteNamespace, id, "", 0, toNamespaceState(before), nil)
+	})
 	return wrapError(err, "%s", id)
 }
 
+// Restore undoes deletion of namespace along with schemas and versions deleted with it
+func (r *NamespaceRepository) Restore(ctx context.Context, id string) (namespace.Namespace, error) {
+	restored := namespace.Namespace{}
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		var deletedAt time.Time
+		if err := t.QueryRow(ctx, namespaceGetDeletedForUpdateQuery, id).Scan(&deletedAt); err != nil {
+			return err
+		}
+		if err := checkReferencesNotDeleted(ctx, t, listDeletedReferencesOfNamespaceQuery, id, deletedAt); err != nil {
+			return err
+		}
+		if err := pgxscan.Get(ctx, t, &restored, namespaceRestoreQuery, id, deletedAt); err != nil {
+			return err
+		}
+		return recordAudit(ctx, t, audit.OperationRestoreNamespace, id, "", 0, nil, toNamespaceState(restored))
+	})
+	return restored, wrapError(err, "deleted namespace %s", id)
+}
+
 func (r *NamespaceRepository) List(ctx context.Context) ([]namespace.Namespace, error) {
 	var namespaces []namespace.Namespace
 	err := pgxscan.Select(ctx, r.db, &namespaces, namespaceListQuery)
 	return namespaces, wrapError(err, "")
 }
+
+// lintRules returns lint rule overrides of namespace, stored as empty object when there are none
+func lintRules(ns namespace.Namespace) map[string]string {
+	if ns.LintRules == nil {
+		return map[string]string{}
+	}
+	return ns.LintRules
+}
+
+// namespaceState is namespace as recorded in audit log
+type namespaceState struct {
+	ID            string            `json:"id"`
+	Format        string            `json:"format"`
+	Compatibility string            `json:"compatibility"`
+	Description   string            `json:"description"`
+	LintRuleSet   string            `json:"lint_rule_set,omitempty"`
+	LintRules     map[string]string `json:"lint_rules,omitempty"`
+}
+
+func toNamespaceState(ns namespace.Namespace) *namespaceState {
+	return &namespaceState{ID: ns.ID, Format: ns.Format, Compatibility: ns.Compatibility, Description: ns.Description, LintRuleSet: ns.LintRuleSet, LintRules: ns.LintRules}
+}
@@ -54,6 +54,15 @@ func TestNamespace(t *testing.T) {
 			assert.Nil(t, err)
 			assertNamespace(t, *n, ns)
 		})
+		t.Run("update: should update lint config of the namespace", func(t *testing.T) {
+			n.LintRuleSet = namespace.LintRuleSetBasic
+			n.LintRules = map[string]string{"JAVA_PACKAGE_DEFINED": namespace.LintSeverityWarning}
+			ns, err := db.Update(ctx, *n)
+			assert.Nil(t, err)
+			assertNamespace(t, *n, ns)
+			assert.Equal(t, n.LintRuleSet, ns.LintRuleSet)
+			assert.Equal(t, n.LintRules, ns.LintRules)
+		})
 		t.Run("update: should return error if namespace not found", func(t *testing.T) {
 			n.ID = "test2"
 			_, err := db.Update(ctx, *n)
@@ -73,6 +82,25 @@ func TestNamespace(t *testing.T) {
 			err := db.Delete(ctx, "test")
 			assert.Nil(t, err)
 		})
+		t.Run("get: should return not found error for deleted namespace", func(t *testing.T) {
+			_, err := db.Get(ctx, "test")
+			assert.ErrorIs(t, err, store.NoRowsErr)
+		})
+		t.Run("create: should return error if deleted namespace has same name", func(t *testing.T) {
+			_, err := db.Create(ctx, *n)
+			assert.ErrorIs(t, err, store.ConflictErr)
+		})
+		t.Run("restore: should restore deleted namespace", func(t *testing.T) {
+			ns, err := db.Restore(ctx, "test")
+			assert.Nil(t, err)
+			assertNamespace(t, *n, ns)
+			_, err = db.Get(ctx, "test")
+			assert.Nil(t, err)
+		})
+		t.Run("restore: should return not found error if namespace is not deleted", func(t *testing.T) {
+			_, err := db.Restore(ctx, "test")
+			assert.ErrorIs(t, err, store.NoRowsErr)
+		})
 	})
 }
 
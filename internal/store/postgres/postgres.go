@@ -67,6 +67,12 @@ func Migrate(connURL string) error {
 	return nil
 }
 
+// errReferenced is returned when deleting versions referenced by other versions
+var errReferenced = errors.New("still referenced")
+
+// errReferenceDeleted is returned when restoring versions referring to deleted versions
+var errReferenceDeleted = errors.New("refers to deleted versions")
+
 func wrapError(err error, format string, args ...interface{}) error {
 	if err == nil {
 		return err
@@ -75,6 +81,9 @@ func wrapError(err error, format string, args ...interface{}) error {
 	if errors.Is(err, pgx.ErrNoRows) {
 		return store.NoRowsErr.WithErr(err, fmt.Sprintf(format, args...))
 	}
+	if errors.Is(err, errReferenced) || errors.Is(err, errReferenceDeleted) {
+		return store.ReferencedErr.WithErr(err, fmt.Sprintf(format, args...))
+	}
 	if errors.As(err, &pgErr) {
 		if pgErr.Code == "23505" {
 			return store.ConflictErr.WithErr(err, fmt.Sprintf(format, args...))
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/raystack/stencil/core/audit"
+	"github.com/raystack/stencil/core/retention"
+)
+
+// listDeletedQuery lists deleted items except the ones deleted along with their parent,
+// which are recognised by having same deletion time as the parent
+const listDeletedQuery = `
+SELECT 'NAMESPACE' as kind, ns.id as namespace_id, '' as schema_name, 0 as version, ns.deleted_at as deleted_at from namespaces as ns
+WHERE ns.deleted_at IS NOT NULL AND ($1 = '' OR ns.id=$1)
+UNION ALL
+SELECT 'SCHEMA' as kind, sc.namespace_id as namespace_id, sc.name as schema_name, 0 as version, sc.deleted_at as deleted_at from schemas as sc
+JOIN
+namespaces as ns ON ns.id=sc.namespace_id
+WHERE sc.deleted_at IS NOT NULL AND sc.deleted_at IS DISTINCT FROM ns.deleted_at AND ($1 = '' OR sc.namespace_id=$1)
+UNION ALL
+SELECT 'VERSION' as kind, sc.namespace_id as namespace_id, sc.name as schema_name, vs.version as version, vs.deleted_at as deleted_at from versions as vs
+JOIN
+schemas as sc ON sc.id=vs.schema_id
+WHERE vs.deleted_at IS NOT NULL AND vs.deleted_at IS DISTINCT FROM sc.deleted_at AND ($1 = '' OR sc.namespace_id=$1)
+ORDER BY deleted_at DESC
+`
+
+const purgeVersionsQuery = `
+DELETE from versions as vs USING schemas as sc
+WHERE sc.id=vs.schema_id AND vs.deleted_at < now() - make_interval(secs => $1) AND vs.deleted_at IS DISTINCT FROM sc.deleted_at
+RETURNING sc.namespace_id as namespace_id, sc.name as schema_name, vs.version as version
+`
+
+const purgeSchemasQuery = `
+DELETE from schemas as sc USING namespaces as ns
+WHERE ns.id=sc.namespace_id AND sc.deleted_at < now() - make_interval(secs => $1) AND sc.deleted_at IS DISTINCT FROM ns.deleted_at
+RETURNING sc.namespace_id as namespace_id, sc.name as schema_name
+`
+
+const purgeNamespacesQuery = `
+DELETE from namespaces WHERE deleted_at < now() - make_interval(secs => $1) RETURNING id as namespace_id
+`
+
+type RetentionRepository struct {
+	db *DB
+}
+
+func NewRetentionRepository(dbc *DB) *RetentionRepository {
+	return &RetentionRepository{
+		db: dbc,
+	}
+}
+
+func (r *RetentionRepository) ListDeleted(ctx context.Context, namespaceID string) ([]retention.Item, error) {
+	var items []retention.Item
+	err := pgxscan.Select(ctx, r.db, &items, listDeletedQuery, namespaceID)
+	return items, wrapError(err, "deleted items")
+}
+
+// Purge deletes expired versions, schemas and namespaces, rows deleted along with them are removed by cascade
+func (r *RetentionRepository) Purge(ctx context.Context, olderThan time.Duration) (int, error) {
+	secs := olderThan.Seconds()
+	var count int
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		var versions, schemas, namespaces []retention.Item
+		if err := pgxscan.Select(ctx, t, &versions, purgeVersionsQuery, secs); err != nil {
+			return err
+		}
+		if err := pgxscan.Select(ctx, t, &schemas, purgeSchemasQuery, secs); err != nil {
+			return err
+		}
+		if err := pgxscan.Select(ctx, t, &namespaces, purgeNamespacesQuery, secs); err != nil {
+			return err
+		}
+		for _, v := range versions {
+			if err := recordAudit(ctx, t, audit.OperationPurgeVersion, v.NamespaceID, v.SchemaName, v.Version, nil, nil); err != nil {
+				return err
+			}
+		}
+		for _, s := range schemas {
+			if err := recordAudit(ctx, t, audit.OperationPurgeSchema, s.NamespaceID, s.SchemaName, 0, nil, nil); err != nil {
+				return err
+			}
+		}
+		for _, n := range namespaces {
+			if err := recordAudit(ctx, t, audit.OperationPurgeNamespace, n.NamespaceID, "", 0, nil, nil); err != nil {
+				return err
+			}
+		}
+		if _, err := t.Exec(ctx, deleteOrphanedData); err != nil {
+			return err
+		}
+		count = len(versions) + len(schemas) + len(namespaces)
+		return nil
+	})
+	return count, wrapError(err, "purge")
+}
@@ -0,0 +1,85 @@
+package postgres_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/retention"
+	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/internal/store"
+	"github.com/raystack/stencil/internal/store/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func getRetentionStore(t *testing.T) (*postgres.RetentionRepository, *postgres.DB) {
+	t.Helper()
+	connectionString := os.Getenv("TEST_DB_CONNECTIONSTRING")
+	if connectionString == "" {
+		t.Skip("Skipping test since DB info not available")
+		return nil, nil
+	}
+	err := postgres.Migrate(connectionString)
+	assert.Nil(t, err)
+	dbc := postgres.NewStore(connectionString)
+	return postgres.NewRetentionRepository(dbc), dbc
+}
+
+func TestRetention(t *testing.T) {
+	tearDown(t)
+	db, dbc := getRetentionStore(t)
+	namespaceStore := postgres.NewNamespaceRepository(dbc)
+	schemaStore := postgres.NewSchemaRepository(dbc)
+	ctx := context.Background()
+	n := namespace.Namespace{ID: "testretention", Format: "FORMAT_AVRO", Compatibility: "COMPATIBILITY_BACKWARD"}
+	_, err := namespaceStore.Create(ctx, n)
+	assert.Nil(t, err)
+	meta := &schema.Metadata{Format: "FORMAT_AVRO"}
+	_, _, _, err = schemaStore.Create(ctx, n.ID, "sc", meta, "uuid-1", &schema.SchemaFile{ID: "file-1", Data: []byte(`"string"`)})
+	assert.Nil(t, err)
+	_, _, _, err = schemaStore.Create(ctx, n.ID, "sc", meta, "uuid-2", &schema.SchemaFile{ID: "file-2", Data: []byte(`"int"`)})
+	assert.Nil(t, err)
+
+	t.Run("listDeleted: should list deleted version", func(t *testing.T) {
+		err := schemaStore.DeleteVersion(ctx, n.ID, "sc", 2)
+		assert.Nil(t, err)
+		items, err := db.ListDeleted(ctx, n.ID)
+		assert.Nil(t, err)
+		if assert.Equal(t, 1, len(items)) {
+			assert.Equal(t, retention.KindVersion, items[0].Kind)
+			assert.Equal(t, "sc", items[0].SchemaName)
+			assert.Equal(t, int32(2), items[0].Version)
+			assert.False(t, items[0].DeletedAt.IsZero())
+		}
+	})
+	t.Run("listDeleted: should not list versions deleted along with schema", func(t *testing.T) {
+		err := schemaStore.Delete(ctx, n.ID, "sc")
+		assert.Nil(t, err)
+		items, err := db.ListDeleted(ctx, n.ID)
+		assert.Nil(t, err)
+		if assert.Equal(t, 2, len(items)) {
+			assert.Equal(t, retention.KindSchema, items[0].Kind)
+			assert.Equal(t, retention.KindVersion, items[1].Kind)
+		}
+	})
+	t.Run("purge: should keep items within retention period", func(t *testing.T) {
+		count, err := db.Purge(ctx, time.Hour)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, count)
+	})
+	t.Run("purge: should permanently delete expired items", func(t *testing.T) {
+		count, err := db.Purge(ctx, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, count)
+		items, err := db.ListDeleted(ctx, n.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(items))
+		_, err = schemaStore.GetVersionRef(ctx, n.ID, "sc", 1)
+		assert.ErrorIs(t, err, store.NoRowsErr)
+		err = schemaStore.Restore(ctx, n.ID, "sc")
+		assert.ErrorIs(t, err, store.NoRowsErr)
+	})
+	tearDown(t)
+}
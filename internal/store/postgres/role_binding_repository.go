@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/raystack/stencil/core/auth"
+)
+
+type RoleBindingRepository struct {
+	db *DB
+}
+
+func NewRoleBindingRepository(dbc *DB) *RoleBindingRepository {
+	return &RoleBindingRepository{
+		db: dbc,
+	}
+}
+
+func (r *RoleBindingRepository) Upsert(ctx context.Context, binding auth.RoleBinding) (auth.RoleBinding, error) {
+	newBinding := auth.RoleBinding{}
+	err := pgxscan.Get(ctx, r.db, &newBinding, roleBindingUpsertQuery, binding.NamespaceID, binding.Principal, binding.Role)
+	return newBinding, wrapError(err, "%s", binding.NamespaceID)
+}
+
+func (r *RoleBindingRepository) Get(ctx context.Context, namespaceID, principal string) (auth.RoleBinding, error) {
+	binding := auth.RoleBinding{}
+	err := pgxscan.Get(ctx, r.db, &binding, roleBindingGetQuery, namespaceID, principal)
+	return binding, wrapError(err, "role binding %s", principal)
+}
+
+func (r *RoleBindingRepository) List(ctx context.Context, namespaceID string) ([]auth.RoleBinding, error) {
+	var bindings []auth.RoleBinding
+	err := pgxscan.Select(ctx, r.db, &bindings, roleBindingListQuery, namespaceID)
+	return bindings, wrapError(err, "%s", namespaceID)
+}
+
+func (r *RoleBindingRepository) ListByPrincipal(ctx context.Context, principal string) ([]auth.RoleBinding, error) {
+	var bindings []auth.RoleBinding
+	err := pgxscan.Select(ctx, r.db, &bindings, roleBindingListByPrincipalQuery, principal)
+	return bindings, wrapError(err, "role bindings of %s", principal)
+}
+
+func (r *RoleBindingRepository) Delete(ctx context.Context, namespaceID, principal string) error {
+	res, err := r.db.Exec(ctx, roleBindingDeleteQuery, namespaceID, principal)
+	if err == nil && res.RowsAffected() == 0 {
+		return wrapError(pgx.ErrNoRows, "role binding %s", principal)
+	}
+	return wrapError(err, "role binding %s", principal)
+}
+
+const roleBindingUpsertQuery = `
+INSERT INTO role_bindings (namespace_id, principal, role, created_at, updated_at)
+    VALUES ($1, $2, $3, now(), now())
+ON CONFLICT (namespace_id, principal) DO UPDATE SET role=EXCLUDED.role, updated_at=now()
+RETURNING *
+`
+
+const roleBindingGetQuery = `
+SELECT * from role_bindings where namespace_id=$1 AND principal=$2
+`
+
+const roleBindingListQuery = `
+SELECT * from role_bindings where namespace_id=$1 ORDER BY principal
+`
+
+const roleBindingListByPrincipalQuery = `
+SELECT * from role_bindings where principal=$1 ORDER BY namespace_id
+`
+
+const roleBindingDeleteQuery = `
+DELETE from role_bindings where namespace_id=$1 AND principal=$2
+`
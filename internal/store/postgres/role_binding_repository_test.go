@@ -0,0 +1,75 @@
+package postgres_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/raystack/stencil/core/auth"
+	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/internal/store"
+	"github.com/raystack/stencil/internal/store/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func getRoleBindingStore(t *testing.T) *postgres.RoleBindingRepository {
+	t.Helper()
+	connectionString := os.Getenv("TEST_DB_CONNECTIONSTRING")
+	if connectionString == "" {
+		t.Skip("Skipping test since DB info not available")
+		return nil
+	}
+	err := postgres.Migrate(connectionString)
+	assert.Nil(t, err)
+	dbc := postgres.NewStore(connectionString)
+	return postgres.NewRoleBindingRepository(dbc)
+}
+
+func TestRoleBinding(t *testing.T) {
+	tearDown(t)
+	db := getRoleBindingStore(t)
+	namespaceStore := getNamespaceStore(t)
+	ctx := context.Background()
+	n := &namespace.Namespace{ID: "testrole", Format: "protobuf", Compatibility: "FULL", Description: "testDesc"}
+	_, err := namespaceStore.Create(ctx, *n)
+	assert.Nil(t, err)
+	t.Run("upsert: should create role binding", func(t *testing.T) {
+		binding, err := db.Upsert(ctx, auth.RoleBinding{NamespaceID: n.ID, Principal: "user@example.com", Role: auth.RoleReader})
+		assert.Nil(t, err)
+		assert.Equal(t, auth.RoleReader, binding.Role)
+	})
+	t.Run("upsert: should update role of existing binding", func(t *testing.T) {
+		binding, err := db.Upsert(ctx, auth.RoleBinding{NamespaceID: n.ID, Principal: "user@example.com", Role: auth.RoleWriter})
+		assert.Nil(t, err)
+		assert.Equal(t, auth.RoleWriter, binding.Role)
+	})
+	t.Run("get: should return role binding of principal", func(t *testing.T) {
+		binding, err := db.Get(ctx, n.ID, "user@example.com")
+		assert.Nil(t, err)
+		assert.Equal(t, auth.RoleWriter, binding.Role)
+	})
+	t.Run("list: should list role bindings of namespace", func(t *testing.T) {
+		bindings, err := db.List(ctx, n.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(bindings))
+	})
+	t.Run("listByPrincipal: should list role bindings of principal", func(t *testing.T) {
+		bindings, err := db.ListByPrincipal(ctx, "user@example.com")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(bindings))
+		assert.Equal(t, n.ID, bindings[0].NamespaceID)
+		bindings, err = db.ListByPrincipal(ctx, "other@example.com")
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(bindings))
+	})
+	t.Run("delete: should delete role binding", func(t *testing.T) {
+		err := db.Delete(ctx, n.ID, "user@example.com")
+		assert.Nil(t, err)
+		_, err = db.Get(ctx, n.ID, "user@example.com")
+		assert.ErrorIs(t, err, store.NoRowsErr)
+	})
+	t.Run("delete: should return not found if binding doesn't exist", func(t *testing.T) {
+		err := db.Delete(ctx, n.ID, "user@example.com")
+		assert.ErrorIs(t, err, store.NoRowsErr)
+	})
+}
@@ -2,10 +2,14 @@ package postgres
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/georgysavva/scany/pgxscan"
 	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
+	"github.com/raystack/stencil/core/audit"
 	"github.com/raystack/stencil/core/schema"
 )
 
@@ -24,10 +28,24 @@ type searchData struct {
 	Fields []string
 }
 
-func (r *SchemaRepository) Create(ctx context.Context, namespace string, schemaName string, metadata *schema.Metadata, versionID string, file *schema.SchemaFile) (int32, error) {
-	var version int32
+func (r *SchemaRepository) Create(ctx context.Context, namespace string, schemaName string, metadata *schema.Metadata, versionID string, file *schema.SchemaFile) (int32, int32, bool, error) {
+	var version, globalID int32
+	var created bool
 	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
-		vErr := t.QueryRow(ctx, getSchemaVersionByID, versionID).Scan(&version)
+		var deleted bool
+		vErr := t.QueryRow(ctx, getSchemaVersionByID, versionID).Scan(&version, &globalID, &deleted)
+		if vErr == nil && deleted {
+			// uploading data of deleted version brings it back instead of creating a new version
+			if err := checkReferencesNotDeleted(ctx, t, listDeletedReferencesQuery, versionID); err != nil {
+				return err
+			}
+			if _, err := t.Exec(ctx, restoreVersionByIDQuery, versionID); err != nil {
+				return err
+			}
+			created = true
+			after := &versionState{VersionID: versionID, GlobalID: globalID, Format: metadata.Format, Compatibility: metadata.Compatibility}
+			return recordAudit(ctx, t, audit.OperationRestoreVersion, namespace, schemaName, version, nil, after)
+		}
 		if vErr == nil {
 			return nil
 		}
@@ -35,16 +53,27 @@ func (r *SchemaRepository) Create(ctx context.Context, namespace string, schemaN
 			return vErr
 		}
 		var schemaID int32
-		if err := t.QueryRow(ctx, schemaInsertQuery, schemaName, namespace, metadata.Format, metadata.Compatibility).Scan(&schemaID); err != nil {
+		if err := t.QueryRow(ctx, schemaInsertQuery, schemaName, namespace, metadata.Format, metadata.Compatibility, metadata.Authority).Scan(&schemaID); err != nil {
 			return err
 		}
 		if err := t.QueryRow(ctx, versionInsertQuery, schemaID, versionID, file.ID,
-			&searchData{Types: file.Types, Fields: file.Fields}, file.Data).Scan(&version); err != nil {
+			&searchData{Types: file.Types, Fields: file.Fields}, file.Data, metadata.Authority, metadata.Message).Scan(&version, &globalID); err != nil {
 			return err
 		}
-		return nil
+		for _, ref := range metadata.References {
+			tag, err := t.Exec(ctx, insertReferenceQuery, versionID, ref.NamespaceID, ref.Name, ref.Version)
+			if err != nil {
+				return err
+			}
+			if tag.RowsAffected() == 0 {
+				return pgx.ErrNoRows
+			}
+		}
+		created = true
+		after := &versionState{VersionID: versionID, GlobalID: globalID, Format: metadata.Format, Compatibility: metadata.Compatibility}
+		return recordAudit(ctx, t, audit.OperationCreateVersion, namespace, schemaName, version, nil, after)
 	})
-	return version, wrapError(err, "create schema failed for %s under%s", schemaName, namespace)
+	return version, globalID, created, wrapError(err, "create schema failed for %s under%s", schemaName, namespace)
 }
 
 func (r *SchemaRepository) Get(ctx context.Context, namespaceId, schemaName string, versionNumber int32) ([]byte, error) {
@@ -65,6 +94,37 @@ func (r *SchemaRepository) GetLatestVersion(ctx context.Context, namespaceId, sc
 	return version, nil
 }
 
+func (r *SchemaRepository) GetNextVersion(ctx context.Context, namespaceId, schemaName string) (int32, error) {
+	var version int32
+	err := r.db.QueryRow(ctx, getNextVersionQuery, namespaceId, schemaName).Scan(&version)
+	return version, wrapError(err, "next version for %s - %s", namespaceId, schemaName)
+}
+
+func (r *SchemaRepository) GetVersionByID(ctx context.Context, versionID string) (schema.VersionRef, error) {
+	var ref schema.VersionRef
+	err := pgxscan.Get(ctx, r.db, &ref, getVersionRefByIDQuery, versionID)
+	return ref, wrapError(err, "version for %s", versionID)
+}
+
+func (r *SchemaRepository) GetGlobalID(ctx context.Context, namespace, schemaName string, version int32) (int32, error) {
+	var globalID int32
+	err := r.db.QueryRow(ctx, getGlobalIDQuery, namespace, schemaName, version).Scan(&globalID)
+	return globalID, wrapError(err, "global id for %s - %s", namespace, schemaName)
+}
+
+// GetVersionRef returns reference of the version, including versions that are deleted but not purged yet
+func (r *SchemaRepository) GetVersionRef(ctx context.Context, namespace, schemaName string, version int32) (schema.VersionRef, error) {
+	var ref schema.VersionRef
+	err := pgxscan.Get(ctx, r.db, &ref, getVersionRefQuery, namespace, schemaName, version)
+	return ref, wrapError(err, "version %d of %s - %s", version, namespace, schemaName)
+}
+
+func (r *SchemaRepository) GetByGlobalID(ctx context.Context, globalID int32) (schema.VersionRef, error) {
+	var ref schema.VersionRef
+	err := pgxscan.Get(ctx, r.db, &ref, getVersionByGlobalIDQuery, globalID)
+	return ref, wrapError(err, "version for global id %d", globalID)
+}
+
 func (r *SchemaRepository) GetMetadata(ctx context.Context, namespace, sc string) (*schema.Metadata, error) {
 	var meta schema.Metadata
 	err := pgxscan.Get(ctx, r.db, &meta, getSchemaMetaQuery, namespace, sc)
@@ -73,7 +133,16 @@ func (r *SchemaRepository) GetMetadata(ctx context.Context, namespace, sc string
 
 func (r *SchemaRepository) UpdateMetadata(ctx context.Context, namespace, sc string, in *schema.Metadata) (*schema.Metadata, error) {
 	var meta schema.Metadata
-	err := pgxscan.Get(ctx, r.db, &meta, updateSchemaMetaQuery, namespace, sc, in.Compatibility)
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		var before schema.Metadata
+		if err := pgxscan.Get(ctx, t, &before, getSchemaMetaForUpdateQuery, namespace, sc); err != nil {
+			return err
+		}
+		if err := pgxscan.Get(ctx, t, &meta, updateSchemaMetaQuery, namespace, sc, in.Compatibility); err != nil {
+			return err
+		}
+		return recordAudit(ctx, t, audit.OperationUpdateSchemaMetadata, namespace, sc, 0, toMetadataState(&before), toMetadataState(&meta))
+	})
 	return &meta, wrapError(err, "meta")
 }
 
@@ -84,43 +153,254 @@ func (r *SchemaRepository) List(ctx context.Context, namespaceID string) ([]sche
 }
 
 func (r *SchemaRepository) Delete(ctx context.Context, ns string, sc string) error {
-	_, err := r.db.Exec(ctx, deleteSchemaQuery, ns, sc)
-	// Idempotent operation to clean orphaned data.
-	r.db.Exec(ctx, deleteOrphanedData)
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		var before schema.Metadata
+		if err := pgxscan.Get(ctx, t, &before, getSchemaMetaForUpdateQuery, ns, sc); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+		if err := checkNotReferenced(ctx, t, ns, sc, 0); err != nil {
+			return err
+		}
+		if _, err := t.Exec(ctx, deleteSchemaQuery, ns, sc); err != nil {
+			return err
+		}
+		return recordAudit(ctx, t, audit.OperationDeleteSchema, ns, sc, 0, toMetadataState(&before), nil)
+	})
 	return wrapError(err, "delete schema")
 }
 
+// Restore undoes deletion of schema along with versions deleted with it
+func (r *SchemaRepository) Restore(ctx context.Context, ns string, sc string) error {
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		var schemaID int64
+		var deletedAt time.Time
+		if err := t.QueryRow(ctx, getDeletedSchemaForUpdateQuery, ns, sc).Scan(&schemaID, &deletedAt); err != nil {
+			return err
+		}
+		if err := checkReferencesNotDeleted(ctx, t, listDeletedReferencesOfSchemaQuery, schemaID, deletedAt); err != nil {
+			return err
+		}
+		var meta schema.Metadata
+		if err := pgxscan.Get(ctx, t, &meta, restoreSchemaQuery, schemaID, deletedAt); err != nil {
+			return err
+		}
+		return recordAudit(ctx, t, audit.OperationRestoreSchema, ns, sc, 0, nil, toMetadataState(&meta))
+	})
+	return wrapError(err, "deleted schema %s - %s", ns, sc)
+}
+
 func (r *SchemaRepository) ListVersions(ctx context.Context, ns string, sc string) ([]int32, error) {
 	var versions []int32
 	err := pgxscan.Select(ctx, r.db, &versions, listVersionsQuery, ns, sc)
 	return versions, wrapError(err, "versions")
 }
 
+// ListVersionDetails returns versions of the schema newer than afterVersion in ascending order.
+// All remaining versions are returned if limit is not set.
+func (r *SchemaRepository) ListVersionDetails(ctx context.Context, ns string, sc string, afterVersion int32, limit int) ([]schema.Version, error) {
+	var versions []schema.Version
+	var limitArg interface{}
+	if limit > 0 {
+		limitArg = limit
+	}
+	err := pgxscan.Select(ctx, r.db, &versions, listVersionDetailsQuery, ns, sc, afterVersion, limitArg)
+	return versions, wrapError(err, "versions")
+}
+
+// ListReferences returns schema versions referenced by the version
+func (r *SchemaRepository) ListReferences(ctx context.Context, ns string, sc string, version int32) ([]schema.Reference, error) {
+	var refs []schema.Reference
+	err := pgxscan.Select(ctx, r.db, &refs, listReferencesQuery, ns, sc, version)
+	return refs, wrapError(err, "references of version %d of %s - %s", version, ns, sc)
+}
+
+// ListReferrers returns versions, which are not deleted, referring to the version
+func (r *SchemaRepository) ListReferrers(ctx context.Context, ns string, sc string, version int32) ([]schema.Reference, error) {
+	var refs []schema.Reference
+	err := pgxscan.Select(ctx, r.db, &refs, listReferrersQuery, ns, sc, version)
+	return refs, wrapError(err, "referrers of version %d of %s - %s", version, ns, sc)
+}
+
+// checkNotReferenced fails if versions about to be deleted are referenced by versions which are not deleted.
+// Empty schema name covers every schema of the namespace and zero version covers every version of the schema.
+func checkNotReferenced(ctx context.Context, t pgx.Tx, ns, sc string, version int32) error {
+	var referrers []schema.Reference
+	if err := pgxscan.Select(ctx, t, &referrers, listReferrersQuery, ns, sc, version); err != nil {
+		return err
+	}
+	if len(referrers) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(referrers))
+	for _, ref := range referrers {
+		names = append(names, ref.String())
+	}
+	return fmt.Errorf("%w by %s", errReferenced, strings.Join(names, ", "))
+}
+
+// checkReferencesNotDeleted fails if versions about to be restored refer to versions which are deleted
+func checkReferencesNotDeleted(ctx context.Context, t pgx.Tx, query string, args ...interface{}) error {
+	var refs []schema.Reference
+	if err := pgxscan.Select(ctx, t, &refs, query, args...); err != nil {
+		return err
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		names = append(names, ref.String())
+	}
+	return fmt.Errorf("%w %s", errReferenceDeleted, strings.Join(names, ", "))
+}
+
+// UpdateVersionState sets lifecycle state of the version
+func (r *SchemaRepository) UpdateVersionState(ctx context.Context, ns string, sc string, version int32, state schema.VersionState) (schema.Version, error) {
+	var updated schema.Version
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		var before schema.Version
+		if err := pgxscan.Get(ctx, t, &before, getVersionLifecycleForUpdateQuery, ns, sc, version); err != nil {
+			return err
+		}
+		updated = before
+		if err := pgxscan.Get(ctx, t, &updated.VersionState, updateVersionStateQuery, ns, sc, version, state.State, state.Reason, state.SunsetAt); err != nil {
+			return err
+		}
+		return recordAudit(ctx, t, audit.OperationUpdateVersionState, ns, sc, version, toLifecycleState(before.VersionState), toLifecycleState(updated.VersionState))
+	})
+	return updated, wrapError(err, "version %d of %s - %s", version, ns, sc)
+}
+
 func (r *SchemaRepository) DeleteVersion(ctx context.Context, ns string, sc string, version int32) error {
-	_, err := r.db.Exec(ctx, deleteVersionQuery, ns, sc, version)
-	// Idempotent operation to clean orphaned data.
-	r.db.Exec(ctx, deleteOrphanedData)
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		before := versionState{}
+		if err := pgxscan.Get(ctx, t, &before, getVersionStateQuery, ns, sc, version); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+		if err := checkNotReferenced(ctx, t, ns, sc, version); err != nil {
+			return err
+		}
+		if _, err := t.Exec(ctx, deleteVersionQuery, before.VersionID); err != nil {
+			return err
+		}
+		return recordAudit(ctx, t, audit.OperationDeleteVersion, ns, sc, version, &before, nil)
+	})
 	return wrapError(err, "delete version")
 }
 
+// RestoreVersion undoes deletion of the version, schema of the version must not be deleted
+func (r *SchemaRepository) RestoreVersion(ctx context.Context, ns string, sc string, version int32) error {
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		after := versionState{}
+		if err := pgxscan.Get(ctx, t, &after, getDeletedVersionStateQuery, ns, sc, version); err != nil {
+			return err
+		}
+		if err := checkReferencesNotDeleted(ctx, t, listDeletedReferencesQuery, after.VersionID); err != nil {
+			return err
+		}
+		if _, err := t.Exec(ctx, restoreVersionByIDQuery, after.VersionID); err != nil {
+			return err
+		}
+		return recordAudit(ctx, t, audit.OperationRestoreVersion, ns, sc, version, nil, &after)
+	})
+	return wrapError(err, "deleted version %d of %s - %s", version, ns, sc)
+}
+
+// metadataState is schema metadata as recorded in audit log
+type metadataState struct {
+	Authority     string `json:"authority,omitempty"`
+	Format        string `json:"format"`
+	Compatibility string `json:"compatibility"`
+}
+
+func toMetadataState(meta *schema.Metadata) *metadataState {
+	return &metadataState{Authority: meta.Authority, Format: meta.Format, Compatibility: meta.Compatibility}
+}
+
+// versionState is schema version as recorded in audit log
+type versionState struct {
+	VersionID     string `json:"version_id" db:"version_id"`
+	GlobalID      int32  `json:"global_id" db:"global_id"`
+	Format        string `json:"format" db:"format"`
+	Compatibility string `json:"compatibility" db:"compatibility"`
+}
+
+// lifecycleState is lifecycle state of schema version as recorded in audit log
+type lifecycleState struct {
+	State    string     `json:"state"`
+	Reason   string     `json:"reason,omitempty"`
+	SunsetAt *time.Time `json:"sunset_at,omitempty"`
+}
+
+func toLifecycleState(state schema.VersionState) *lifecycleState {
+	return &lifecycleState{State: state.State, Reason: state.Reason, SunsetAt: state.SunsetAt}
+}
+
+const versionStateColumns = `vs.state as state, vs.state_reason as reason, vs.sunset_at as sunset_at`
+
+// versionDetailColumns needs versions_schema_files joined as vsf and schema_files as sf
+const versionDetailColumns = `vs.version as version, vs.id as id, vsf.schema_file_id as schema_file_id, vs.created_at as created_at,
+vs.created_by as creator, vs.message as message, octet_length(sf.data) as size, ` + versionStateColumns
+
 const schemaInsertQuery = `
-INSERT INTO schemas (name, namespace_id, format, compatibility, created_at, updated_at)
-    VALUES ($1, $2, $3, $4, now(), now())
-ON CONFLICT ON CONSTRAINT schema_name_namespace_unique_idx DO UPDATE SET updated_at=now() RETURNING id
+INSERT INTO schemas (name, namespace_id, format, compatibility, authority, created_at, updated_at)
+    VALUES ($1, $2, $3, $4, NULLIF($5, ''), now(), now())
+ON CONFLICT ON CONSTRAINT schema_name_namespace_unique_idx DO UPDATE SET authority=COALESCE(schemas.authority, EXCLUDED.authority), deleted_at=NULL, updated_at=now() RETURNING id
 `
 
 const getSchemaVersionByID = `
-SELECT vs.version from versions as vs WHERE vs.id=$1
+SELECT vs.version, vs.global_id, vs.deleted_at IS NOT NULL from versions as vs WHERE vs.id=$1
+`
+
+const restoreVersionByIDQuery = `
+WITH restored_version(schema_id) AS (
+	UPDATE versions SET deleted_at=NULL WHERE id=$1 RETURNING schema_id
+)
+UPDATE schemas SET deleted_at=NULL WHERE id=(SELECT schema_id from restored_version) AND deleted_at IS NOT NULL
+`
+
+const getVersionRefByIDQuery = `
+SELECT sc.namespace_id as namespace_id, sc.name as name, vs.version as version, vs.global_id as global_id, vs.deleted_at IS NOT NULL as deleted, ` + versionStateColumns + ` from versions as vs
+JOIN
+schemas as sc ON sc.id=vs.schema_id
+WHERE vs.id=$1
+`
+
+const getGlobalIDQuery = `
+SELECT vs.global_id from versions as vs
+JOIN
+schemas as sc ON sc.id=vs.schema_id
+WHERE sc.namespace_id=$1 AND sc.name=$2 AND vs.version=$3
+`
+
+const getVersionRefQuery = `
+SELECT sc.namespace_id as namespace_id, sc.name as name, vs.version as version, vs.global_id as global_id, vs.deleted_at IS NOT NULL as deleted, ` + versionStateColumns + ` from versions as vs
+JOIN
+schemas as sc ON sc.id=vs.schema_id
+WHERE sc.namespace_id=$1 AND sc.name=$2 AND vs.version=$3
+`
+
+const getVersionByGlobalIDQuery = `
+SELECT sc.namespace_id as namespace_id, sc.name as name, vs.version as version, vs.global_id as global_id, vs.deleted_at IS NOT NULL as deleted, ` + versionStateColumns + ` from versions as vs
+JOIN
+schemas as sc ON sc.id=vs.schema_id
+WHERE vs.global_id=$1
 `
 
 const versionInsertQuery = `
 WITH max_version(value) as (
 	SELECT COALESCE((SELECT MAX(vs.version) from versions as vs WHERE vs.schema_id=$1), 0)
 ),
-insert_version(value) as (
-	INSERT INTO versions (version, schema_id, id, created_at)
-	VALUES ((select max_version.value + 1 from max_version), $1, $2, now())
-	RETURNING version
+insert_version(value, global_id) as (
+	INSERT INTO versions (version, schema_id, id, created_at, created_by, message)
+	VALUES ((select max_version.value + 1 from max_version), $1, $2, now(), $6, $7)
+	RETURNING version, global_id
 ),
 file_insert as (
 	INSERT INTO schema_files (id, search_data, data, created_at, updated_at)
@@ -129,7 +409,7 @@ file_insert as (
 map_insert as (
 	INSERT INTO versions_schema_files (version_id, schema_file_id) VALUES ($2, $3)
 )
-SELECT value from insert_version
+SELECT value, global_id from insert_version
 `
 
 const getLatestVersionIDFromSchemaNameQuery = `
@@ -141,11 +421,19 @@ WITH schema_id(id) as (
 	ns.id=$1 AND sc.name=$2
 ),
 max_version(value) as (
-	SELECT COALESCE((SELECT MAX(vs.version) from versions as vs WHERE vs.schema_id=(select id from schema_id)), 0)
+	SELECT COALESCE((SELECT MAX(vs.version) from versions as vs WHERE vs.schema_id=(select id from schema_id) AND vs.deleted_at IS NULL), 0)
 )
 select value from max_version
 `
 
+// getNextVersionQuery takes max over all versions including deleted ones, same as versionInsertQuery
+const getNextVersionQuery = `
+SELECT COALESCE((SELECT MAX(vs.version) from versions as vs
+	JOIN
+	schemas as sc ON sc.id=vs.schema_id
+	WHERE sc.namespace_id=$1 AND sc.name=$2), 0) + 1
+`
+
 const getVersionIDFromSchemaNameQuery = `
 WITH schema_id(id) as (
 	SELECT sc.id as id from schemas as sc
@@ -170,32 +458,153 @@ v.id=$1
 const getSchemaMetaQuery = `
 SELECT COALESCE(sc.authority, '') as authority,  COALESCE(sc.format, '') as format, COALESCE(sc.compatibility, '') as compatibility from schemas as sc WHERE sc.namespace_id=$1 AND sc.name=$2
 `
+const getSchemaMetaForUpdateQuery = `
+SELECT COALESCE(sc.authority, '') as authority,  COALESCE(sc.format, '') as format, COALESCE(sc.compatibility, '') as compatibility from schemas as sc WHERE sc.namespace_id=$1 AND sc.name=$2 AND sc.deleted_at IS NULL FOR UPDATE
+`
+
+const getDeletedSchemaForUpdateQuery = `
+SELECT sc.id, sc.deleted_at from schemas as sc
+JOIN
+namespaces as ns ON ns.id=sc.namespace_id
+WHERE sc.namespace_id=$1 AND sc.name=$2 AND sc.deleted_at IS NOT NULL AND ns.deleted_at IS NULL
+FOR UPDATE OF sc
+`
+
+const restoreSchemaQuery = `
+WITH restored_versions AS (
+	UPDATE versions SET deleted_at=NULL WHERE schema_id=$1 AND deleted_at=$2
+)
+UPDATE schemas SET deleted_at=NULL WHERE id=$1 RETURNING COALESCE(authority, '') as authority,  COALESCE(format, '') as format, COALESCE(compatibility, '') as compatibility
+`
+
+const getVersionStateQuery = `
+SELECT vs.id as version_id, vs.global_id as global_id, COALESCE(sc.format, '') as format, COALESCE(sc.compatibility, '') as compatibility from versions as vs
+JOIN
+schemas as sc ON sc.id=vs.schema_id
+WHERE sc.namespace_id=$1 AND sc.name=$2 AND vs.version=$3 AND vs.deleted_at IS NULL
+FOR UPDATE OF vs
+`
+
+const getDeletedVersionStateQuery = `
+SELECT vs.id as version_id, vs.global_id as global_id, COALESCE(sc.format, '') as format, COALESCE(sc.compatibility, '') as compatibility from versions as vs
+JOIN
+schemas as sc ON sc.id=vs.schema_id
+WHERE sc.namespace_id=$1 AND sc.name=$2 AND vs.version=$3 AND vs.deleted_at IS NOT NULL AND sc.deleted_at IS NULL
+FOR UPDATE OF vs
+`
+
 const updateSchemaMetaQuery = `
-UPDATE schemas SET compatibility=$3, updated_at=now() WHERE namespace_id=$1 AND name=$2 RETURNING COALESCE(authority, '') as authority,  COALESCE(format, '') as format, COALESCE(compatibility, '') as compatibility
+UPDATE schemas SET compatibility=$3, updated_at=now() WHERE namespace_id=$1 AND name=$2 AND deleted_at IS NULL RETURNING COALESCE(authority, '') as authority,  COALESCE(format, '') as format, COALESCE(compatibility, '') as compatibility
 `
 
 const schemaListQuery = `
-SELECT name, format, compatibility, COALESCE(authority, '') as authority from schemas where namespace_id=$1
+SELECT name, format, compatibility, COALESCE(authority, '') as authority from schemas where namespace_id=$1 AND deleted_at IS NULL
 `
 const listVersionsQuery = `
 SELECT vs.version from versions as vs
 JOIN
 schemas as sc ON sc.id=vs.schema_id
-WHERE sc.namespace_id=$1 AND sc.name=$2
+WHERE sc.namespace_id=$1 AND sc.name=$2 AND vs.deleted_at IS NULL
 `
 
+const listVersionDetailsQuery = `
+SELECT ` + versionDetailColumns + ` from versions as vs
+JOIN
+schemas as sc ON sc.id=vs.schema_id
+JOIN
+versions_schema_files as vsf ON vsf.version_id=vs.id
+JOIN
+schema_files as sf ON sf.id=vsf.schema_file_id
+WHERE sc.namespace_id=$1 AND sc.name=$2 AND vs.version > $3 AND vs.deleted_at IS NULL
+ORDER BY vs.version
+LIMIT $4
+`
+
+const getVersionLifecycleForUpdateQuery = `
+SELECT ` + versionDetailColumns + ` from versions as vs
+JOIN
+schemas as sc ON sc.id=vs.schema_id
+JOIN
+versions_schema_files as vsf ON vsf.version_id=vs.id
+JOIN
+schema_files as sf ON sf.id=vsf.schema_file_id
+WHERE sc.namespace_id=$1 AND sc.name=$2 AND vs.version=$3 AND vs.deleted_at IS NULL AND sc.deleted_at IS NULL
+FOR UPDATE OF vs
+`
+
+const updateVersionStateQuery = `
+UPDATE versions as vs SET state=$4, state_reason=$5, sunset_at=$6
+FROM schemas as sc
+WHERE sc.id=vs.schema_id AND sc.namespace_id=$1 AND sc.name=$2 AND vs.version=$3
+RETURNING ` + versionStateColumns + `
+`
+
+// deleteSchemaQuery marks schema deleted along with its versions.
+// Versions get same deletion time as the schema, so that restore can tell what was deleted along with the schema.
 const deleteSchemaQuery = `
-DELETE from schemas where namespace_id=$1 AND name=$2
+WITH deleted_schema(id) AS (
+	UPDATE schemas SET deleted_at=now() WHERE namespace_id=$1 AND name=$2 AND deleted_at IS NULL RETURNING id
+)
+UPDATE versions SET deleted_at=now() WHERE schema_id=(SELECT id from deleted_schema) AND deleted_at IS NULL
+`
+
+const insertReferenceQuery = `
+INSERT INTO version_references (version_id, ref_version_id)
+SELECT $1, vs.id from versions as vs
+JOIN
+schemas as sc ON sc.id=vs.schema_id
+WHERE sc.namespace_id=$2 AND sc.name=$3 AND vs.version=$4 AND vs.deleted_at IS NULL
+`
+
+const listReferencesQuery = `
+SELECT rsc.namespace_id as namespace_id, rsc.name as name, rvs.version as version from version_references as vr
+JOIN versions as vs ON vs.id=vr.version_id
+JOIN schemas as sc ON sc.id=vs.schema_id
+JOIN versions as rvs ON rvs.id=vr.ref_version_id
+JOIN schemas as rsc ON rsc.id=rvs.schema_id
+WHERE sc.namespace_id=$1 AND sc.name=$2 AND vs.version=$3
+ORDER BY rsc.namespace_id, rsc.name, rvs.version
+`
+
+// listDeletedReferencesQuery lists deleted versions, or versions of deleted schemas, referred by the version
+const listDeletedReferencesQuery = `
+SELECT rsc.namespace_id as namespace_id, rsc.name as name, rvs.version as version from version_references as vr
+JOIN versions as rvs ON rvs.id=vr.ref_version_id
+JOIN schemas as rsc ON rsc.id=rvs.schema_id
+WHERE vr.version_id=$1 AND (rvs.deleted_at IS NOT NULL OR rsc.deleted_at IS NOT NULL)
+ORDER BY rsc.namespace_id, rsc.name, rvs.version
+`
+
+// listDeletedReferencesOfSchemaQuery lists deleted versions referred by versions deleted along with the schema,
+// leaving out versions of the schema which are restored together with it
+const listDeletedReferencesOfSchemaQuery = `
+SELECT rsc.namespace_id as namespace_id, rsc.name as name, rvs.version as version from version_references as vr
+JOIN versions as vs ON vs.id=vr.version_id
+JOIN versions as rvs ON rvs.id=vr.ref_version_id
+JOIN schemas as rsc ON rsc.id=rvs.schema_id
+WHERE vs.schema_id=$1 AND vs.deleted_at=$2 AND CASE
+	WHEN rvs.schema_id=$1 THEN rvs.deleted_at IS NOT NULL AND rvs.deleted_at<>$2
+	ELSE rvs.deleted_at IS NOT NULL OR rsc.deleted_at IS NOT NULL
+END
+ORDER BY rsc.namespace_id, rsc.name, rvs.version
+`
+
+// listReferrersQuery lists versions which are not deleted, referring to versions of the namespace.
+// Referred versions can be narrowed down to a schema and version, referrers from the namespace itself
+// are left out when whole namespace is covered.
+const listReferrersQuery = `
+SELECT sc.namespace_id as namespace_id, sc.name as name, vs.version as version from version_references as vr
+JOIN versions as rvs ON rvs.id=vr.ref_version_id
+JOIN schemas as rsc ON rsc.id=rvs.schema_id
+JOIN versions as vs ON vs.id=vr.version_id
+JOIN schemas as sc ON sc.id=vs.schema_id
+WHERE rsc.namespace_id=$1 AND ($2 = '' OR rsc.name=$2) AND ($3 = 0 OR rvs.version=$3) AND vs.deleted_at IS NULL
+AND NOT ($2 = '' AND sc.namespace_id=$1)
+ORDER BY sc.namespace_id, sc.name, vs.version
 `
 
 const deleteVersionQuery = `
-WITH version(id) as (
-	SELECT vs.id as id from versions as vs
-	JOIN
-	schemas as sc ON sc.id=vs.schema_id
-	WHERE sc.namespace_id=$1 AND sc.name=$2 AND vs.version=$3
-)
-DELETE from versions where id=(select id from version)
+UPDATE versions SET deleted_at=now() WHERE id=$1
 `
 
 const deleteOrphanedData = `
@@ -4,9 +4,11 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/raystack/stencil/core/namespace"
 	"github.com/raystack/stencil/core/schema"
+	"github.com/raystack/stencil/internal/store"
 	"github.com/raystack/stencil/internal/store/postgres"
 	"github.com/stretchr/testify/assert"
 )
@@ -38,19 +40,22 @@ func TestSchema(t *testing.T) {
 			Format: "avro",
 		}
 		t.Run("create: should create schema", func(t *testing.T) {
-			versionNumber, err := db.Create(ctx, n.ID, "sName", meta, "uuid-1", &schema.SchemaFile{ID: "t1", Data: []byte("testdata")})
+			versionNumber, globalID, created, err := db.Create(ctx, n.ID, "sName", meta, "uuid-1", &schema.SchemaFile{ID: "t1", Data: []byte("testdata")})
 			assert.Nil(t, err)
 			assert.Equal(t, int32(1), versionNumber)
+			assert.NotZero(t, globalID)
+			assert.True(t, created)
 		})
 		t.Run("create: should increment version number on new schema", func(t *testing.T) {
-			versionNumber, err := db.Create(ctx, n.ID, "sName", meta, "uuid-2", &schema.SchemaFile{ID: "t2", Data: []byte("testdata-2")})
+			versionNumber, _, _, err := db.Create(ctx, n.ID, "sName", &schema.Metadata{Format: "avro", Message: "second version"}, "uuid-2", &schema.SchemaFile{ID: "t2", Data: []byte("testdata-2")})
 			assert.Nil(t, err)
 			assert.Equal(t, int32(2), versionNumber)
 		})
 		t.Run("create: should return same version number if schema is same", func(t *testing.T) {
-			versionNumber, err := db.Create(ctx, n.ID, "sName", meta, "uuid-1", &schema.SchemaFile{ID: "t1", Data: []byte("testdata")})
+			versionNumber, _, created, err := db.Create(ctx, n.ID, "sName", meta, "uuid-1", &schema.SchemaFile{ID: "t1", Data: []byte("testdata")})
 			assert.Nil(t, err)
 			assert.Equal(t, int32(1), versionNumber)
+			assert.False(t, created)
 		})
 		t.Run("list_schemas: should return schema", func(t *testing.T) {
 			schemaList, err := db.List(ctx, "testschema")
@@ -82,6 +87,66 @@ func TestSchema(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, int32(2), s)
 		})
+		t.Run("getVersionByID: should return version number for version ID", func(t *testing.T) {
+			ref, err := db.GetVersionByID(ctx, "uuid-2")
+			assert.Nil(t, err)
+			assert.Equal(t, n.ID, ref.NamespaceID)
+			assert.Equal(t, "sName", ref.Name)
+			assert.Equal(t, int32(2), ref.Version)
+		})
+		t.Run("getVersionByID: should return not found error if version ID not present", func(t *testing.T) {
+			_, err := db.GetVersionByID(ctx, "uuid-unknown")
+			assert.ErrorIs(t, err, store.NoRowsErr)
+		})
+		t.Run("getGlobalID: should return global ID of version", func(t *testing.T) {
+			globalID, err := db.GetGlobalID(ctx, n.ID, "sName", 2)
+			assert.Nil(t, err)
+			ref, err := db.GetByGlobalID(ctx, globalID)
+			assert.Nil(t, err)
+			expected := schema.VersionRef{NamespaceID: n.ID, Name: "sName", Version: 2, GlobalID: globalID, VersionState: schema.VersionState{State: schema.VersionStateActive}}
+			assert.Equal(t, expected, ref)
+		})
+		t.Run("getByGlobalID: should return not found error for unknown global ID", func(t *testing.T) {
+			_, err := db.GetByGlobalID(ctx, -1)
+			assert.ErrorIs(t, err, store.NoRowsErr)
+		})
+		t.Run("updateVersionState: should deprecate version", func(t *testing.T) {
+			sunset := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+			state := schema.VersionState{State: schema.VersionStateDeprecated, Reason: "use v2", SunsetAt: &sunset}
+			v, err := db.UpdateVersionState(ctx, n.ID, "sName", 1, state)
+			assert.Nil(t, err)
+			assert.Equal(t, int32(1), v.Version)
+			assert.Equal(t, "uuid-1", v.ID)
+			assert.Equal(t, state, v.VersionState)
+			ref, err := db.GetVersionRef(ctx, n.ID, "sName", 1)
+			assert.Nil(t, err)
+			assert.Equal(t, state, ref.VersionState)
+		})
+		t.Run("updateVersionState: should return not found error for unknown version", func(t *testing.T) {
+			_, err := db.UpdateVersionState(ctx, n.ID, "sName", 10, schema.VersionState{State: schema.VersionStateDisabled})
+			assert.ErrorIs(t, err, store.NoRowsErr)
+		})
+		t.Run("listVersionDetails: should return versions along with their state", func(t *testing.T) {
+			versions, err := db.ListVersionDetails(ctx, n.ID, "sName", 0, 0)
+			assert.Nil(t, err)
+			if assert.Equal(t, 2, len(versions)) {
+				assert.Equal(t, schema.VersionStateDeprecated, versions[0].State)
+				assert.Equal(t, schema.VersionStateActive, versions[1].State)
+			}
+		})
+		t.Run("listVersionDetails: should return version details", func(t *testing.T) {
+			versions, err := db.ListVersionDetails(ctx, n.ID, "sName", 1, 1)
+			assert.Nil(t, err)
+			if assert.Equal(t, 1, len(versions)) {
+				v := versions[0]
+				assert.Equal(t, int32(2), v.Version)
+				assert.Equal(t, "uuid-2", v.ID)
+				assert.Equal(t, "t2", v.SchemaFileID)
+				assert.Equal(t, "second version", v.Message)
+				assert.Equal(t, int64(len("testdata-2")), v.Size)
+				assert.False(t, v.CreatedAt.IsZero())
+			}
+		})
 		t.Run("deleteVersion: should delete specified version schema", func(t *testing.T) {
 			err := db.DeleteVersion(ctx, n.ID, "sName", int32(2))
 			assert.Nil(t, err)
@@ -89,6 +154,41 @@ func TestSchema(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, []int32{1}, schemaList)
 		})
+		t.Run("getVersionRef: should mark deleted version", func(t *testing.T) {
+			ref, err := db.GetVersionRef(ctx, n.ID, "sName", 2)
+			assert.Nil(t, err)
+			assert.True(t, ref.Deleted)
+			ref, err = db.GetVersionByID(ctx, "uuid-2")
+			assert.Nil(t, err)
+			assert.True(t, ref.Deleted)
+			data, err := db.Get(ctx, n.ID, "sName", 2)
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("testdata-2"), data)
+		})
+		t.Run("getNextVersion: should count deleted latest version", func(t *testing.T) {
+			latest, err := db.GetLatestVersion(ctx, n.ID, "sName")
+			assert.Nil(t, err)
+			assert.Equal(t, int32(1), latest)
+			next, err := db.GetNextVersion(ctx, n.ID, "sName")
+			assert.Nil(t, err)
+			assert.Equal(t, int32(3), next)
+		})
+		t.Run("getNextVersion: should return first version for unknown schema", func(t *testing.T) {
+			next, err := db.GetNextVersion(ctx, n.ID, "unknown")
+			assert.Nil(t, err)
+			assert.Equal(t, int32(1), next)
+		})
+		t.Run("restoreVersion: should restore deleted version", func(t *testing.T) {
+			err := db.RestoreVersion(ctx, n.ID, "sName", 2)
+			assert.Nil(t, err)
+			versions, err := db.ListVersions(ctx, n.ID, "sName")
+			assert.Nil(t, err)
+			assert.Equal(t, []int32{1, 2}, versions)
+		})
+		t.Run("restoreVersion: should return not found error if version is not deleted", func(t *testing.T) {
+			err := db.RestoreVersion(ctx, n.ID, "sName", 2)
+			assert.ErrorIs(t, err, store.NoRowsErr)
+		})
 
 		t.Run("deleteSchema: should delete specified schema", func(t *testing.T) {
 			err := db.Delete(ctx, n.ID, "sName")
@@ -97,6 +197,61 @@ func TestSchema(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, 0, len(schemaList))
 		})
+		t.Run("restore: should restore schema along with its versions", func(t *testing.T) {
+			err := db.Restore(ctx, n.ID, "sName")
+			assert.Nil(t, err)
+			schemaList, err := db.List(ctx, n.ID)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(schemaList))
+			versions, err := db.ListVersions(ctx, n.ID, "sName")
+			assert.Nil(t, err)
+			assert.Equal(t, []int32{1, 2}, versions)
+		})
+		t.Run("create: should store references of version", func(t *testing.T) {
+			refMeta := &schema.Metadata{Format: "avro", References: []schema.Reference{{NamespaceID: n.ID, Name: "sName", Version: 2}}}
+			versionNumber, _, _, err := db.Create(ctx, n.ID, "refName", refMeta, "uuid-ref-1", &schema.SchemaFile{ID: "t3", Data: []byte("testdata-3")})
+			assert.Nil(t, err)
+			assert.Equal(t, int32(1), versionNumber)
+		})
+		t.Run("create: should return not found error if referenced version not present", func(t *testing.T) {
+			refMeta := &schema.Metadata{Format: "avro", References: []schema.Reference{{NamespaceID: n.ID, Name: "sName", Version: 9}}}
+			_, _, _, err := db.Create(ctx, n.ID, "refName", refMeta, "uuid-ref-2", &schema.SchemaFile{ID: "t4", Data: []byte("testdata-4")})
+			assert.ErrorIs(t, err, store.NoRowsErr)
+		})
+		t.Run("listReferences: should return references and referrers of version", func(t *testing.T) {
+			refs, err := db.ListReferences(ctx, n.ID, "refName", 1)
+			assert.Nil(t, err)
+			assert.Equal(t, []schema.Reference{{NamespaceID: n.ID, Name: "sName", Version: 2}}, refs)
+			referrers, err := db.ListReferrers(ctx, n.ID, "sName", 2)
+			assert.Nil(t, err)
+			assert.Equal(t, []schema.Reference{{NamespaceID: n.ID, Name: "refName", Version: 1}}, referrers)
+		})
+		t.Run("deleteVersion: should not delete referenced version", func(t *testing.T) {
+			err := db.DeleteVersion(ctx, n.ID, "sName", 2)
+			assert.ErrorIs(t, err, store.ReferencedErr)
+			err = db.Delete(ctx, n.ID, "sName")
+			assert.ErrorIs(t, err, store.ReferencedErr)
+		})
+		t.Run("deleteVersion: should delete version once referrers are deleted", func(t *testing.T) {
+			err := db.DeleteVersion(ctx, n.ID, "refName", 1)
+			assert.Nil(t, err)
+			err = db.DeleteVersion(ctx, n.ID, "sName", 2)
+			assert.Nil(t, err)
+		})
+		t.Run("restoreVersion: should not restore version referring to deleted version", func(t *testing.T) {
+			err := db.RestoreVersion(ctx, n.ID, "refName", 1)
+			assert.ErrorIs(t, err, store.ReferencedErr)
+			assert.Contains(t, err.Error(), n.ID+"/sName@2")
+			refMeta := &schema.Metadata{Format: "avro", References: []schema.Reference{{NamespaceID: n.ID, Name: "sName", Version: 2}}}
+			_, _, _, err = db.Create(ctx, n.ID, "refName", refMeta, "uuid-ref-1", &schema.SchemaFile{ID: "t3", Data: []byte("testdata-3")})
+			assert.ErrorIs(t, err, store.ReferencedErr)
+		})
+		t.Run("restoreVersion: should restore version once referred version is restored", func(t *testing.T) {
+			err := db.RestoreVersion(ctx, n.ID, "sName", 2)
+			assert.Nil(t, err)
+			err = db.RestoreVersion(ctx, n.ID, "refName", 1)
+			assert.Nil(t, err)
+		})
 	})
 	tearDown(t)
 }
@@ -25,6 +25,7 @@ ON     s.namespace_id = ns.id
 WHERE  ns.id = COALESCE(NULLIF ($1, ''), ns.id)
 AND    s.name=COALESCE(NULLIF ($2, ''), s.name)
 AND    v.version=COALESCE(NULLIF ($3, 0), v.version)
+AND    v.deleted_at IS NULL
 AND    (
               sf.search_data -> 'Fields' @? ('$[*] ? (@ like_regex "' || $4 || '" flag "i")')::jsonpath
        OR     sf.search_data -> 'Types' @? ('$[*] ? (@ like_regex "' || $4 || '" flag "i")')::jsonpath);
@@ -42,6 +43,7 @@ WITH latest_version AS(
 	ON       s.namespace_id = ns.id
 	WHERE    ns.id = COALESCE(NULLIF ($1, ''), ns.id)
 	AND      s.name = COALESCE(NULLIF ($2, ''), s.name)
+	AND      v.deleted_at IS NULL
 	GROUP BY (ns.id, s.id))
 SELECT jsonb_path_query_array(sf.search_data -> 'Fields', ('$[*] ? (@ like_regex "' || $3 || '" flag "i")')::jsonpath) 						       AS "fields",
        jsonb_path_query_array(sf.search_data -> 'Types', ('$[*] ? (@ like_regex "' || $3 || '" flag "i")')::jsonpath)  						       AS "types",
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/raystack/stencil/core/audit"
+	"github.com/raystack/stencil/core/webhook"
+)
+
+type WebhookRepository struct {
+	db *DB
+}
+
+func NewWebhookRepository(dbc *DB) *WebhookRepository {
+	return &WebhookRepository{
+		db: dbc,
+	}
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, sub webhook.Subscription) (webhook.Subscription, error) {
+	newSub := webhook.Subscription{}
+	err := pgxscan.Get(ctx, r.db, &newSub, webhookInsertQuery, sub.NamespaceID, sub.URL, sub.Secret, sub.Events)
+	return newSub, wrapError(err, "%s", sub.NamespaceID)
+}
+
+func (r *WebhookRepository) List(ctx context.Context, namespaceID string) ([]webhook.Subscription, error) {
+	var subs []webhook.Subscription
+	err := pgxscan.Select(ctx, r.db, &subs, webhookListQuery, namespaceID)
+	return subs, wrapError(err, "%s", namespaceID)
+}
+
+func (r *WebhookRepository) Delete(ctx context.Context, namespaceID string, id int64) error {
+	res, err := r.db.Exec(ctx, webhookDeleteQuery, namespaceID, id)
+	if err == nil && res.RowsAffected() == 0 {
+		return wrapError(pgx.ErrNoRows, "webhook %d", id)
+	}
+	return wrapError(err, "webhook %d", id)
+}
+
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, namespaceID string, subscriptionID int64) ([]webhook.Delivery, error) {
+	var deliveries []webhook.Delivery
+	err := pgxscan.Select(ctx, r.db, &deliveries, webhookDeliveryListQuery, namespaceID, subscriptionID)
+	return deliveries, wrapError(err, "webhook %d", subscriptionID)
+}
+
+func (r *WebhookRepository) RelayEvents(ctx context.Context, limit int, relay func(audit.Event) ([]webhook.PendingDelivery, error)) (int, error) {
+	var relayed int
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		var events []audit.Event
+		if err := pgxscan.Select(ctx, t, &events, auditOutboxClaimQuery, limit); err != nil {
+			return err
+		}
+		for _, e := range events {
+			deliveries, err := relay(e)
+			if err != nil {
+				return err
+			}
+			for _, d := range deliveries {
+				if _, err := t.Exec(ctx, webhookPendingInsertQuery, d.SubscriptionID, d.EventType, d.Payload); err != nil {
+					return err
+				}
+			}
+			if _, err := t.Exec(ctx, auditOutboxDeleteQuery, e.ID); err != nil {
+				return err
+			}
+		}
+		relayed = len(events)
+		return nil
+	})
+	return relayed, wrapError(err, "audit event outbox")
+}
+
+func (r *WebhookRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]webhook.PendingDelivery, error) {
+	var pending []webhook.PendingDelivery
+	err := pgxscan.Select(ctx, r.db, &pending, webhookPendingClaimQuery, limit, lease.Milliseconds())
+	return pending, wrapError(err, "webhook deliveries")
+}
+
+func (r *WebhookRepository) RecordAttempt(ctx context.Context, pendingID int64, d webhook.Delivery, retryAfter time.Duration) error {
+	err := r.db.BeginFunc(ctx, func(t pgx.Tx) error {
+		if _, err := t.Exec(ctx, webhookDeliveryInsertQuery, d.SubscriptionID, d.EventType, d.Payload, d.Attempt, d.StatusCode, d.Success, d.Error); err != nil {
+			return err
+		}
+		if retryAfter > 0 {
+			_, err := t.Exec(ctx, webhookPendingRetryQuery, pendingID, d.Attempt, retryAfter.Milliseconds())
+			return err
+		}
+		_, err := t.Exec(ctx, webhookPendingDeleteQuery, pendingID)
+		return err
+	})
+	return wrapError(err, "webhook %d", d.SubscriptionID)
+}
+
+const webhookInsertQuery = `
+INSERT INTO webhook_subscriptions (namespace_id, url, secret, events, created_at, updated_at)
+    VALUES ($1, $2, $3, $4, now(), now())
+RETURNING *
+`
+
+const webhookListQuery = `
+SELECT * from webhook_subscriptions where namespace_id=$1 ORDER BY id
+`
+
+const webhookDeleteQuery = `
+DELETE from webhook_subscriptions where namespace_id=$1 AND id=$2
+`
+
+const webhookDeliveryInsertQuery = `
+INSERT INTO webhook_deliveries (subscription_id, event_type, payload, attempt, status_code, success, error, created_at)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, now())
+`
+
+const webhookDeliveryListQuery = `
+SELECT d.* from webhook_deliveries d
+	JOIN webhook_subscriptions ws ON ws.id = d.subscription_id
+	WHERE ws.namespace_id=$1 AND d.subscription_id=$2
+	ORDER BY d.created_at DESC, d.id DESC
+	LIMIT 100
+`
+
+const webhookPendingInsertQuery = `
+INSERT INTO webhook_pending_deliveries (subscription_id, event_type, payload, next_attempt_at, created_at)
+    VALUES ($1, $2, $3, now(), now())
+`
+
+// auditOutboxClaimQuery locks oldest queued audit events till end of transaction, events locked by other instances are skipped
+const auditOutboxClaimQuery = `
+SELECT a.id, COALESCE(a.actor, '') as actor, COALESCE(a.request_id, '') as request_id, a.operation, a.namespace_id,
+	COALESCE(a.schema_name, '') as schema_name, COALESCE(a.version, 0) as version, a.before, a.after, a.created_at
+	FROM audit_event_outbox o
+	JOIN audit_events a ON a.id = o.audit_event_id
+	ORDER BY o.audit_event_id
+	LIMIT $1
+	FOR UPDATE OF o SKIP LOCKED
+`
+
+const auditOutboxDeleteQuery = `
+DELETE from audit_event_outbox WHERE audit_event_id=$1
+`
+
+// webhookPendingClaimQuery moves next attempt of due deliveries past lease, rows locked by other instances are skipped
+const webhookPendingClaimQuery = `
+UPDATE webhook_pending_deliveries p SET next_attempt_at = now() + $2 * interval '1 millisecond'
+	FROM webhook_subscriptions ws
+	WHERE ws.id = p.subscription_id AND p.id IN (
+		SELECT id from webhook_pending_deliveries WHERE next_attempt_at <= now()
+		ORDER BY next_attempt_at LIMIT $1 FOR UPDATE SKIP LOCKED)
+RETURNING p.*, ws.url, ws.secret
+`
+
+const webhookPendingRetryQuery = `
+UPDATE webhook_pending_deliveries SET attempts=$2, next_attempt_at = now() + $3 * interval '1 millisecond' WHERE id=$1
+`
+
+const webhookPendingDeleteQuery = `
+DELETE from webhook_pending_deliveries WHERE id=$1
+`
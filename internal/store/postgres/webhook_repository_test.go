@@ -0,0 +1,118 @@
+package postgres_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/raystack/stencil/core/audit"
+	"github.com/raystack/stencil/core/namespace"
+	"github.com/raystack/stencil/core/webhook"
+	"github.com/raystack/stencil/internal/store"
+	"github.com/raystack/stencil/internal/store/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func getWebhookStore(t *testing.T) *postgres.WebhookRepository {
+	t.Helper()
+	connectionString := os.Getenv("TEST_DB_CONNECTIONSTRING")
+	if connectionString == "" {
+		t.Skip("Skipping test since DB info not available")
+		return nil
+	}
+	err := postgres.Migrate(connectionString)
+	assert.Nil(t, err)
+	dbc := postgres.NewStore(connectionString)
+	return postgres.NewWebhookRepository(dbc)
+}
+
+func TestWebhook(t *testing.T) {
+	tearDown(t)
+	db := getWebhookStore(t)
+	namespaceStore := getNamespaceStore(t)
+	ctx := context.Background()
+	n := &namespace.Namespace{ID: "testwebhook", Format: "protobuf", Compatibility: "FULL", Description: "testDesc"}
+	_, err := namespaceStore.Create(ctx, *n)
+	assert.Nil(t, err)
+	var sub webhook.Subscription
+	t.Run("create: should create subscription", func(t *testing.T) {
+		sub, err = db.Create(ctx, webhook.Subscription{NamespaceID: n.ID, URL: "http://localhost/hook", Secret: "secret", Events: []string{"CREATE"}})
+		assert.Nil(t, err)
+		assert.NotZero(t, sub.ID)
+		assert.Equal(t, []string{"CREATE"}, sub.Events)
+	})
+	t.Run("list: should list subscriptions of namespace", func(t *testing.T) {
+		subs, err := db.List(ctx, n.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, []webhook.Subscription{sub}, subs)
+	})
+	t.Run("relayEvents: should queue deliveries of audited events and remove them from outbox", func(t *testing.T) {
+		var relayed []audit.Event
+		count, err := db.RelayEvents(ctx, 10, func(e audit.Event) ([]webhook.PendingDelivery, error) {
+			relayed = append(relayed, e)
+			return []webhook.PendingDelivery{{SubscriptionID: sub.ID, EventType: "CREATE", Payload: []byte(`{"event": "CREATE"}`)}}, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, 1, len(relayed))
+		assert.Equal(t, audit.OperationCreateNamespace, relayed[0].Operation)
+		assert.Equal(t, n.ID, relayed[0].NamespaceID)
+		count, err = db.RelayEvents(ctx, 10, func(e audit.Event) ([]webhook.PendingDelivery, error) {
+			return nil, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, count)
+	})
+	var pending []webhook.PendingDelivery
+	t.Run("claimDeliveries: should claim due deliveries along with subscription", func(t *testing.T) {
+		var err error
+		pending, err = db.ClaimDeliveries(ctx, 10, time.Minute)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(pending))
+		assert.Equal(t, sub.ID, pending[0].SubscriptionID)
+		assert.Equal(t, int32(0), pending[0].Attempts)
+		assert.Equal(t, sub.URL, pending[0].URL)
+		assert.Equal(t, sub.Secret, pending[0].Secret)
+		assert.JSONEq(t, `{"event": "CREATE"}`, string(pending[0].Payload))
+		claimed, err := db.ClaimDeliveries(ctx, 10, time.Minute)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(claimed))
+	})
+	t.Run("recordAttempt: should record delivery and retry pending delivery", func(t *testing.T) {
+		err := db.RecordAttempt(ctx, pending[0].ID, webhook.Delivery{SubscriptionID: sub.ID, EventType: "CREATE", Payload: pending[0].Payload, Attempt: 1, StatusCode: 500, Error: "unexpected status code 500"}, time.Millisecond)
+		assert.Nil(t, err)
+		time.Sleep(10 * time.Millisecond)
+		pending, err = db.ClaimDeliveries(ctx, 10, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(pending))
+		assert.Equal(t, int32(1), pending[0].Attempts)
+	})
+	t.Run("recordAttempt: should record delivery and remove completed delivery", func(t *testing.T) {
+		err := db.RecordAttempt(ctx, pending[0].ID, webhook.Delivery{SubscriptionID: sub.ID, EventType: "CREATE", Payload: pending[0].Payload, Attempt: 2, StatusCode: 200, Success: true}, 0)
+		assert.Nil(t, err)
+		remaining, err := db.ClaimDeliveries(ctx, 10, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(remaining))
+	})
+	t.Run("listDeliveries: should list latest deliveries first", func(t *testing.T) {
+		deliveries, err := db.ListDeliveries(ctx, n.ID, sub.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(deliveries))
+		assert.Equal(t, int32(2), deliveries[0].Attempt)
+		assert.True(t, deliveries[0].Success)
+		assert.JSONEq(t, `{"event": "CREATE"}`, string(deliveries[0].Payload))
+	})
+	t.Run("delete: should return not found if subscription not in namespace", func(t *testing.T) {
+		err := db.Delete(ctx, "unknown", sub.ID)
+		assert.ErrorIs(t, err, store.NoRowsErr)
+	})
+	t.Run("delete: should delete subscription", func(t *testing.T) {
+		err := db.Delete(ctx, n.ID, sub.ID)
+		assert.Nil(t, err)
+		subs, err := db.List(ctx, n.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(subs))
+	})
+	tearDown(t)
+}
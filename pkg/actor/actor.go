@@ -0,0 +1,16 @@
+package actor
+
+import "context"
+
+type actorKey struct{}
+
+// WithActor returns context carrying identity of the caller making the change
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// FromContext returns identity of the caller, empty if not known
+func FromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
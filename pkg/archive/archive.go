@@ -0,0 +1,168 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// MaxSize is the limit on total size of extracted files
+const MaxSize = 64 << 20
+
+var (
+	zipMagic  = []byte("PK\x03\x04")
+	gzipMagic = []byte{0x1f, 0x8b}
+	tarMagic  = []byte("ustar")
+)
+
+// ErrTooLarge is returned when extracted files exceed MaxSize
+var ErrTooLarge = fmt.Errorf("archive: extracted files exceed %d bytes", MaxSize)
+
+// IsArchive reports whether data is a zip, tar or gzipped tar archive
+func IsArchive(data []byte) bool {
+	return bytes.HasPrefix(data, zipMagic) || bytes.HasPrefix(data, gzipMagic) || isTar(data)
+}
+
+func isTar(data []byte) bool {
+	return len(data) > 262 && bytes.Equal(data[257:262], tarMagic)
+}
+
+// Extract returns contents of regular files in zip, tar or gzipped tar archive keyed by their slash separated path
+func Extract(data []byte) (map[string][]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, zipMagic):
+		return extractZip(data)
+	case bytes.HasPrefix(data, gzipMagic):
+		gr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("archive: %w", err)
+		}
+		defer gr.Close()
+		return extractTar(gr)
+	case isTar(data):
+		return extractTar(bytes.NewReader(data))
+	}
+	return nil, errors.New("archive: unknown archive format, expected zip, tar or tar.gz")
+}
+
+func extractZip(data []byte) (map[string][]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("archive: %w", err)
+	}
+	files := map[string][]byte{}
+	var total int64
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		name, err := cleanName(f.Name)
+		if err != nil {
+			return nil, err
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("archive: %s: %w", f.Name, err)
+		}
+		content, err := readLimited(rc, &total)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		files[name] = content
+	}
+	return files, nil
+}
+
+func extractTar(r io.Reader) (map[string][]byte, error) {
+	tr := tar.NewReader(r)
+	files := map[string][]byte{}
+	var total int64
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		name, err := cleanName(hdr.Name)
+		if err != nil {
+			return nil, err
+		}
+		content, err := readLimited(tr, &total)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = content
+	}
+}
+
+// readLimited reads r fully, adding read bytes to total and failing once total exceeds MaxSize
+func readLimited(r io.Reader, total *int64) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(r, MaxSize-*total+1))
+	if err != nil {
+		return nil, fmt.Errorf("archive: %w", err)
+	}
+	*total += int64(len(content))
+	if *total > MaxSize {
+		return nil, ErrTooLarge
+	}
+	return content, nil
+}
+
+func cleanName(name string) (string, error) {
+	cleaned := path.Clean(strings.TrimPrefix(name, "./"))
+	if path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("archive: invalid file path %q", name)
+	}
+	return cleaned, nil
+}
+
+// Pack returns gzipped tar archive of files under dir having given extension, with paths relative to dir
+func Pack(dir string, ext string) ([]byte, error) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(p) != ext {
+			return err
+		}
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		hdr := &tar.Header{Name: filepath.ToSlash(rel), Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		_, err = tw.Write(content)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
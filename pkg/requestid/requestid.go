@@ -0,0 +1,84 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Header carries request ID on HTTP requests and responses
+const Header = "X-Request-Id"
+
+var metadataKey = strings.ToLower(Header)
+
+type requestIDKey struct{}
+
+// WithRequestID returns context carrying request ID
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// FromContext returns request ID, empty if not known
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// fromIncoming returns context carrying request ID sent by caller, new ID is generated if caller didn't send one
+func fromIncoming(ctx context.Context) context.Context {
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(metadataKey); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	if id == "" {
+		id = uuid.NewString()
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(metadataKey, id))
+	return WithRequestID(ctx, id)
+}
+
+// UnaryServerInterceptor sets request ID on context of every call
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(fromIncoming(ctx), req)
+	}
+}
+
+// StreamServerInterceptor sets request ID on context of every stream
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = fromIncoming(ss.Context())
+		return handler(srv, wrapped)
+	}
+}
+
+// HTTPMiddleware sets request ID on request header, context and response header,
+// so that it is forwarded to gRPC handlers by the gateway along with HeaderMatcher
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(Header)
+		if id == "" {
+			id = uuid.NewString()
+			r.Header.Set(Header, id)
+		}
+		w.Header().Set(Header, id)
+		next.ServeHTTP(w, r.WithContext(WithRequestID(r.Context(), id)))
+	})
+}
+
+// HeaderMatcher forwards request ID header to gRPC metadata in addition to headers forwarded by default
+func HeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, Header) {
+		return metadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
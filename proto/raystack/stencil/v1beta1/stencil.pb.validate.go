@@ -123,6 +123,35 @@ func (m *Namespace) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetLint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NamespaceValidationError{
+					field:  "Lint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NamespaceValidationError{
+					field:  "Lint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NamespaceValidationError{
+				field:  "Lint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return NamespaceMultiError(errors)
 	}
@@ -199,6 +228,214 @@ var _ interface {
 	ErrorName() string
 } = NamespaceValidationError{}
 
+// Validate checks the field values on LintConfig with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LintConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LintConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LintConfigMultiError, or
+// nil if none found.
+func (m *LintConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LintConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RuleSet
+
+	// no validation rules for Rules
+
+	if len(errors) > 0 {
+		return LintConfigMultiError(errors)
+	}
+	return nil
+}
+
+// LintConfigMultiError is an error wrapping multiple validation errors
+// returned by LintConfig.ValidateAll() if the designated constraints aren't met.
+type LintConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LintConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LintConfigMultiError) AllErrors() []error { return m }
+
+// LintConfigValidationError is the validation error returned by
+// LintConfig.Validate if the designated constraints aren't met.
+type LintConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LintConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LintConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LintConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LintConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LintConfigValidationError) ErrorName() string { return "LintConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LintConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLintConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LintConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LintConfigValidationError{}
+
+// Validate checks the field values on LintIssue with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LintIssue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LintIssue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LintIssueMultiError, or nil
+// if none found.
+func (m *LintIssue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LintIssue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Rule
+
+	// no validation rules for Severity
+
+	// no validation rules for Path
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return LintIssueMultiError(errors)
+	}
+	return nil
+}
+
+// LintIssueMultiError is an error wrapping multiple validation errors returned
+// by LintIssue.ValidateAll() if the designated constraints aren't met.
+type LintIssueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LintIssueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LintIssueMultiError) AllErrors() []error { return m }
+
+// LintIssueValidationError is the validation error returned by
+// LintIssue.Validate if the designated constraints aren't met.
+type LintIssueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LintIssueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LintIssueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LintIssueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LintIssueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LintIssueValidationError) ErrorName() string { return "LintIssueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LintIssueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLintIssue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LintIssueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LintIssueValidationError{}
+
 // Validate checks the field values on Schema with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -861,6 +1098,35 @@ func (m *CreateNamespaceRequest) validate(all bool) error {
 
 	// no validation rules for Description
 
+	if all {
+		switch v := interface{}(m.GetLint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateNamespaceRequestValidationError{
+					field:  "Lint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateNamespaceRequestValidationError{
+					field:  "Lint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateNamespaceRequestValidationError{
+				field:  "Lint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateNamespaceRequestMultiError(errors)
 	}
@@ -1100,6 +1366,35 @@ func (m *UpdateNamespaceRequest) validate(all bool) error {
 
 	// no validation rules for Description
 
+	if all {
+		switch v := interface{}(m.GetLint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateNamespaceRequestValidationError{
+					field:  "Lint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateNamespaceRequestValidationError{
+					field:  "Lint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateNamespaceRequestValidationError{
+				field:  "Lint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UpdateNamespaceRequestMultiError(errors)
 	}
@@ -1779,6 +2074,8 @@ func (m *GetLatestSchemaRequest) validate(all bool) error {
 
 	// no validation rules for SchemaId
 
+	// no validation rules for Assemble
+
 	if len(errors) > 0 {
 		return GetLatestSchemaRequestMultiError(errors)
 	}
@@ -1993,6 +2290,46 @@ func (m *CreateSchemaRequest) validate(all bool) error {
 
 	// no validation rules for Compatibility
 
+	// no validation rules for DryRun
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetReferences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateSchemaRequestValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateSchemaRequestValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateSchemaRequestValidationError{
+					field:  fmt.Sprintf("References[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ImportRoot
+
 	if len(errors) > 0 {
 		return CreateSchemaRequestMultiError(errors)
 	}
@@ -2100,8 +2437,48 @@ func (m *CreateSchemaResponse) validate(all bool) error {
 
 	// no validation rules for Location
 
-	if len(errors) > 0 {
-		return CreateSchemaResponseMultiError(errors)
+	// no validation rules for Duplicate
+
+	// no validation rules for GlobalId
+
+	for idx, item := range m.GetLintIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateSchemaResponseValidationError{
+						field:  fmt.Sprintf("LintIssues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateSchemaResponseValidationError{
+						field:  fmt.Sprintf("LintIssues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateSchemaResponseValidationError{
+					field:  fmt.Sprintf("LintIssues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Restorable
+
+	if len(errors) > 0 {
+		return CreateSchemaResponseMultiError(errors)
 	}
 	return nil
 }
@@ -2209,6 +2586,42 @@ func (m *CheckCompatibilityRequest) validate(all bool) error {
 
 	// no validation rules for Compatibility
 
+	for idx, item := range m.GetReferences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckCompatibilityRequestValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckCompatibilityRequestValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckCompatibilityRequestValidationError{
+					field:  fmt.Sprintf("References[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ImportRoot
+
 	if len(errors) > 0 {
 		return CheckCompatibilityRequestMultiError(errors)
 	}
@@ -2288,6 +2701,119 @@ var _ interface {
 	ErrorName() string
 } = CheckCompatibilityRequestValidationError{}
 
+// Validate checks the field values on CompatibilityViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompatibilityViolation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompatibilityViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompatibilityViolationMultiError, or nil if none found.
+func (m *CompatibilityViolation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompatibilityViolation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Path
+
+	// no validation rules for Message
+
+	// no validation rules for OldValue
+
+	// no validation rules for NewValue
+
+	// no validation rules for Compatibility
+
+	if len(errors) > 0 {
+		return CompatibilityViolationMultiError(errors)
+	}
+	return nil
+}
+
+// CompatibilityViolationMultiError is an error wrapping multiple validation
+// errors returned by CompatibilityViolation.ValidateAll() if the designated
+// constraints aren't met.
+type CompatibilityViolationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompatibilityViolationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompatibilityViolationMultiError) AllErrors() []error { return m }
+
+// CompatibilityViolationValidationError is the validation error returned by
+// CompatibilityViolation.Validate if the designated constraints aren't met.
+type CompatibilityViolationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompatibilityViolationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompatibilityViolationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompatibilityViolationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompatibilityViolationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompatibilityViolationValidationError) ErrorName() string {
+	return "CompatibilityViolationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompatibilityViolationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompatibilityViolation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompatibilityViolationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompatibilityViolationValidationError{}
+
 // Validate checks the field values on CheckCompatibilityResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2310,6 +2836,76 @@ func (m *CheckCompatibilityResponse) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Compatible
+
+	for idx, item := range m.GetViolations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckCompatibilityResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckCompatibilityResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckCompatibilityResponseValidationError{
+					field:  fmt.Sprintf("Violations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetLintIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckCompatibilityResponseValidationError{
+						field:  fmt.Sprintf("LintIssues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckCompatibilityResponseValidationError{
+						field:  fmt.Sprintf("LintIssues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckCompatibilityResponseValidationError{
+					field:  fmt.Sprintf("LintIssues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CheckCompatibilityResponseMultiError(errors)
 	}
@@ -2389,22 +2985,22 @@ var _ interface {
 	ErrorName() string
 } = CheckCompatibilityResponseValidationError{}
 
-// Validate checks the field values on GetSchemaMetadataRequest with the rules
+// Validate checks the field values on DiffSchemaVersionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSchemaMetadataRequest) Validate() error {
+func (m *DiffSchemaVersionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSchemaMetadataRequest with the
+// ValidateAll checks the field values on DiffSchemaVersionsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSchemaMetadataRequestMultiError, or nil if none found.
-func (m *GetSchemaMetadataRequest) ValidateAll() error {
+// DiffSchemaVersionsRequestMultiError, or nil if none found.
+func (m *DiffSchemaVersionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSchemaMetadataRequest) validate(all bool) error {
+func (m *DiffSchemaVersionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2415,19 +3011,25 @@ func (m *GetSchemaMetadataRequest) validate(all bool) error {
 
 	// no validation rules for SchemaId
 
+	// no validation rules for FromVersion
+
+	// no validation rules for ToVersion
+
+	// no validation rules for Compatibility
+
 	if len(errors) > 0 {
-		return GetSchemaMetadataRequestMultiError(errors)
+		return DiffSchemaVersionsRequestMultiError(errors)
 	}
 	return nil
 }
 
-// GetSchemaMetadataRequestMultiError is an error wrapping multiple validation
-// errors returned by GetSchemaMetadataRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetSchemaMetadataRequestMultiError []error
+// DiffSchemaVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by DiffSchemaVersionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DiffSchemaVersionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSchemaMetadataRequestMultiError) Error() string {
+func (m DiffSchemaVersionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2436,11 +3038,11 @@ func (m GetSchemaMetadataRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSchemaMetadataRequestMultiError) AllErrors() []error { return m }
+func (m DiffSchemaVersionsRequestMultiError) AllErrors() []error { return m }
 
-// GetSchemaMetadataRequestValidationError is the validation error returned by
-// GetSchemaMetadataRequest.Validate if the designated constraints aren't met.
-type GetSchemaMetadataRequestValidationError struct {
+// DiffSchemaVersionsRequestValidationError is the validation error returned by
+// DiffSchemaVersionsRequest.Validate if the designated constraints aren't met.
+type DiffSchemaVersionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2448,24 +3050,24 @@ type GetSchemaMetadataRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSchemaMetadataRequestValidationError) Field() string { return e.field }
+func (e DiffSchemaVersionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSchemaMetadataRequestValidationError) Reason() string { return e.reason }
+func (e DiffSchemaVersionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSchemaMetadataRequestValidationError) Cause() error { return e.cause }
+func (e DiffSchemaVersionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSchemaMetadataRequestValidationError) Key() bool { return e.key }
+func (e DiffSchemaVersionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSchemaMetadataRequestValidationError) ErrorName() string {
-	return "GetSchemaMetadataRequestValidationError"
+func (e DiffSchemaVersionsRequestValidationError) ErrorName() string {
+	return "DiffSchemaVersionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSchemaMetadataRequestValidationError) Error() string {
+func (e DiffSchemaVersionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2477,14 +3079,14 @@ func (e GetSchemaMetadataRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSchemaMetadataRequest.%s: %s%s",
+		"invalid %sDiffSchemaVersionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSchemaMetadataRequestValidationError{}
+var _ error = DiffSchemaVersionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2492,109 +3094,193 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSchemaMetadataRequestValidationError{}
+} = DiffSchemaVersionsRequestValidationError{}
 
-// Validate checks the field values on GetSchemaMetadataResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSchemaMetadataResponse) Validate() error {
+// Validate checks the field values on SchemaChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSchemaMetadataResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetSchemaMetadataResponseMultiError, or nil if none found.
-func (m *GetSchemaMetadataResponse) ValidateAll() error {
+// ValidateAll checks the field values on SchemaChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaChangeMultiError, or
+// nil if none found.
+func (m *SchemaChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSchemaMetadataResponse) validate(all bool) error {
+func (m *SchemaChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Format
+	// no validation rules for Kind
 
-	// no validation rules for Compatibility
+	// no validation rules for Path
 
-	// no validation rules for Authority
+	// no validation rules for Message
 
-	// no validation rules for Name
+	// no validation rules for OldValue
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetSchemaMetadataResponseValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetSchemaMetadataResponseValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetSchemaMetadataResponseValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for NewValue
+
+	// no validation rules for Breaking
+
+	if len(errors) > 0 {
+		return SchemaChangeMultiError(errors)
 	}
+	return nil
+}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetSchemaMetadataResponseValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+// SchemaChangeMultiError is an error wrapping multiple validation errors
+// returned by SchemaChange.ValidateAll() if the designated constraints aren't met.
+type SchemaChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaChangeMultiError) AllErrors() []error { return m }
+
+// SchemaChangeValidationError is the validation error returned by
+// SchemaChange.Validate if the designated constraints aren't met.
+type SchemaChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaChangeValidationError) ErrorName() string { return "SchemaChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaChangeValidationError{}
+
+// Validate checks the field values on DiffSchemaVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffSchemaVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffSchemaVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffSchemaVersionsResponseMultiError, or nil if none found.
+func (m *DiffSchemaVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffSchemaVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromVersion
+
+	// no validation rules for ToVersion
+
+	// no validation rules for Compatibility
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffSchemaVersionsResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffSchemaVersionsResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetSchemaMetadataResponseValidationError{
-					field:  "UpdatedAt",
+				return DiffSchemaVersionsResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetSchemaMetadataResponseValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GetSchemaMetadataResponseMultiError(errors)
+		return DiffSchemaVersionsResponseMultiError(errors)
 	}
 	return nil
 }
 
-// GetSchemaMetadataResponseMultiError is an error wrapping multiple validation
-// errors returned by GetSchemaMetadataResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetSchemaMetadataResponseMultiError []error
+// DiffSchemaVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by DiffSchemaVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DiffSchemaVersionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSchemaMetadataResponseMultiError) Error() string {
+func (m DiffSchemaVersionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2603,11 +3289,11 @@ func (m GetSchemaMetadataResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSchemaMetadataResponseMultiError) AllErrors() []error { return m }
+func (m DiffSchemaVersionsResponseMultiError) AllErrors() []error { return m }
 
-// GetSchemaMetadataResponseValidationError is the validation error returned by
-// GetSchemaMetadataResponse.Validate if the designated constraints aren't met.
-type GetSchemaMetadataResponseValidationError struct {
+// DiffSchemaVersionsResponseValidationError is the validation error returned
+// by DiffSchemaVersionsResponse.Validate if the designated constraints aren't met.
+type DiffSchemaVersionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2615,24 +3301,24 @@ type GetSchemaMetadataResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSchemaMetadataResponseValidationError) Field() string { return e.field }
+func (e DiffSchemaVersionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSchemaMetadataResponseValidationError) Reason() string { return e.reason }
+func (e DiffSchemaVersionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSchemaMetadataResponseValidationError) Cause() error { return e.cause }
+func (e DiffSchemaVersionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSchemaMetadataResponseValidationError) Key() bool { return e.key }
+func (e DiffSchemaVersionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSchemaMetadataResponseValidationError) ErrorName() string {
-	return "GetSchemaMetadataResponseValidationError"
+func (e DiffSchemaVersionsResponseValidationError) ErrorName() string {
+	return "DiffSchemaVersionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSchemaMetadataResponseValidationError) Error() string {
+func (e DiffSchemaVersionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2644,14 +3330,14 @@ func (e GetSchemaMetadataResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSchemaMetadataResponse.%s: %s%s",
+		"invalid %sDiffSchemaVersionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSchemaMetadataResponseValidationError{}
+var _ error = DiffSchemaVersionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2659,24 +3345,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSchemaMetadataResponseValidationError{}
+} = DiffSchemaVersionsResponseValidationError{}
 
-// Validate checks the field values on UpdateSchemaMetadataRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetSchemaMetadataRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateSchemaMetadataRequest) Validate() error {
+func (m *GetSchemaMetadataRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateSchemaMetadataRequest with the
+// ValidateAll checks the field values on GetSchemaMetadataRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateSchemaMetadataRequestMultiError, or nil if none found.
-func (m *UpdateSchemaMetadataRequest) ValidateAll() error {
+// GetSchemaMetadataRequestMultiError, or nil if none found.
+func (m *GetSchemaMetadataRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateSchemaMetadataRequest) validate(all bool) error {
+func (m *GetSchemaMetadataRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2687,21 +3373,19 @@ func (m *UpdateSchemaMetadataRequest) validate(all bool) error {
 
 	// no validation rules for SchemaId
 
-	// no validation rules for Compatibility
-
 	if len(errors) > 0 {
-		return UpdateSchemaMetadataRequestMultiError(errors)
+		return GetSchemaMetadataRequestMultiError(errors)
 	}
 	return nil
 }
 
-// UpdateSchemaMetadataRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateSchemaMetadataRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateSchemaMetadataRequestMultiError []error
+// GetSchemaMetadataRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSchemaMetadataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSchemaMetadataRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateSchemaMetadataRequestMultiError) Error() string {
+func (m GetSchemaMetadataRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2710,12 +3394,11 @@ func (m UpdateSchemaMetadataRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateSchemaMetadataRequestMultiError) AllErrors() []error { return m }
+func (m GetSchemaMetadataRequestMultiError) AllErrors() []error { return m }
 
-// UpdateSchemaMetadataRequestValidationError is the validation error returned
-// by UpdateSchemaMetadataRequest.Validate if the designated constraints
-// aren't met.
-type UpdateSchemaMetadataRequestValidationError struct {
+// GetSchemaMetadataRequestValidationError is the validation error returned by
+// GetSchemaMetadataRequest.Validate if the designated constraints aren't met.
+type GetSchemaMetadataRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2723,24 +3406,24 @@ type UpdateSchemaMetadataRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateSchemaMetadataRequestValidationError) Field() string { return e.field }
+func (e GetSchemaMetadataRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateSchemaMetadataRequestValidationError) Reason() string { return e.reason }
+func (e GetSchemaMetadataRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateSchemaMetadataRequestValidationError) Cause() error { return e.cause }
+func (e GetSchemaMetadataRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateSchemaMetadataRequestValidationError) Key() bool { return e.key }
+func (e GetSchemaMetadataRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateSchemaMetadataRequestValidationError) ErrorName() string {
-	return "UpdateSchemaMetadataRequestValidationError"
+func (e GetSchemaMetadataRequestValidationError) ErrorName() string {
+	return "GetSchemaMetadataRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateSchemaMetadataRequestValidationError) Error() string {
+func (e GetSchemaMetadataRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2752,14 +3435,14 @@ func (e UpdateSchemaMetadataRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateSchemaMetadataRequest.%s: %s%s",
+		"invalid %sGetSchemaMetadataRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateSchemaMetadataRequestValidationError{}
+var _ error = GetSchemaMetadataRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2767,49 +3450,4965 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateSchemaMetadataRequestValidationError{}
+} = GetSchemaMetadataRequestValidationError{}
 
-// Validate checks the field values on UpdateSchemaMetadataResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetSchemaMetadataResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateSchemaMetadataResponse) Validate() error {
+func (m *GetSchemaMetadataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSchemaMetadataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSchemaMetadataResponseMultiError, or nil if none found.
+func (m *GetSchemaMetadataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSchemaMetadataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	// no validation rules for Compatibility
+
+	// no validation rules for Authority
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSchemaMetadataResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSchemaMetadataResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSchemaMetadataResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSchemaMetadataResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSchemaMetadataResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSchemaMetadataResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSchemaMetadataResponseMultiError(errors)
+	}
+	return nil
+}
+
+// GetSchemaMetadataResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSchemaMetadataResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetSchemaMetadataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSchemaMetadataResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSchemaMetadataResponseMultiError) AllErrors() []error { return m }
+
+// GetSchemaMetadataResponseValidationError is the validation error returned by
+// GetSchemaMetadataResponse.Validate if the designated constraints aren't met.
+type GetSchemaMetadataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSchemaMetadataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSchemaMetadataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSchemaMetadataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSchemaMetadataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSchemaMetadataResponseValidationError) ErrorName() string {
+	return "GetSchemaMetadataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSchemaMetadataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSchemaMetadataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSchemaMetadataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSchemaMetadataResponseValidationError{}
+
+// Validate checks the field values on UpdateSchemaMetadataRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSchemaMetadataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSchemaMetadataRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSchemaMetadataRequestMultiError, or nil if none found.
+func (m *UpdateSchemaMetadataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSchemaMetadataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	// no validation rules for Compatibility
+
+	if len(errors) > 0 {
+		return UpdateSchemaMetadataRequestMultiError(errors)
+	}
+	return nil
+}
+
+// UpdateSchemaMetadataRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateSchemaMetadataRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateSchemaMetadataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSchemaMetadataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSchemaMetadataRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSchemaMetadataRequestValidationError is the validation error returned
+// by UpdateSchemaMetadataRequest.Validate if the designated constraints
+// aren't met.
+type UpdateSchemaMetadataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSchemaMetadataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSchemaMetadataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSchemaMetadataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSchemaMetadataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSchemaMetadataRequestValidationError) ErrorName() string {
+	return "UpdateSchemaMetadataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSchemaMetadataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSchemaMetadataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSchemaMetadataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSchemaMetadataRequestValidationError{}
+
+// Validate checks the field values on UpdateSchemaMetadataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSchemaMetadataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSchemaMetadataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSchemaMetadataResponseMultiError, or nil if none found.
+func (m *UpdateSchemaMetadataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSchemaMetadataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	// no validation rules for Compatibility
+
+	// no validation rules for Authority
+
+	if len(errors) > 0 {
+		return UpdateSchemaMetadataResponseMultiError(errors)
+	}
+	return nil
+}
+
+// UpdateSchemaMetadataResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateSchemaMetadataResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateSchemaMetadataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSchemaMetadataResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSchemaMetadataResponseMultiError) AllErrors() []error { return m }
+
+// UpdateSchemaMetadataResponseValidationError is the validation error returned
+// by UpdateSchemaMetadataResponse.Validate if the designated constraints
+// aren't met.
+type UpdateSchemaMetadataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSchemaMetadataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSchemaMetadataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSchemaMetadataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSchemaMetadataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSchemaMetadataResponseValidationError) ErrorName() string {
+	return "UpdateSchemaMetadataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSchemaMetadataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSchemaMetadataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSchemaMetadataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSchemaMetadataResponseValidationError{}
+
+// Validate checks the field values on DeleteSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSchemaRequestMultiError, or nil if none found.
+func (m *DeleteSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	if len(errors) > 0 {
+		return DeleteSchemaRequestMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteSchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSchemaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSchemaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSchemaRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSchemaRequestValidationError is the validation error returned by
+// DeleteSchemaRequest.Validate if the designated constraints aren't met.
+type DeleteSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSchemaRequestValidationError) ErrorName() string {
+	return "DeleteSchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSchemaRequestValidationError{}
+
+// Validate checks the field values on DeleteSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSchemaResponseMultiError, or nil if none found.
+func (m *DeleteSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteSchemaResponseMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteSchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSchemaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSchemaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSchemaResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSchemaResponseValidationError is the validation error returned by
+// DeleteSchemaResponse.Validate if the designated constraints aren't met.
+type DeleteSchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSchemaResponseValidationError) ErrorName() string {
+	return "DeleteSchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSchemaResponseValidationError{}
+
+// Validate checks the field values on ListVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVersionsRequestMultiError, or nil if none found.
+func (m *ListVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListVersionsRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListVersionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListVersionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListVersionsRequestMultiError) AllErrors() []error { return m }
+
+// ListVersionsRequestValidationError is the validation error returned by
+// ListVersionsRequest.Validate if the designated constraints aren't met.
+type ListVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListVersionsRequestValidationError) ErrorName() string {
+	return "ListVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListVersionsRequestValidationError{}
+
+// Validate checks the field values on ListVersionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListVersionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVersionsResponseMultiError, or nil if none found.
+func (m *ListVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersionDetails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListVersionsResponseValidationError{
+						field:  fmt.Sprintf("VersionDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListVersionsResponseValidationError{
+						field:  fmt.Sprintf("VersionDetails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListVersionsResponseValidationError{
+					field:  fmt.Sprintf("VersionDetails[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListVersionsResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ListVersionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListVersionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListVersionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListVersionsResponseMultiError) AllErrors() []error { return m }
+
+// ListVersionsResponseValidationError is the validation error returned by
+// ListVersionsResponse.Validate if the designated constraints aren't met.
+type ListVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListVersionsResponseValidationError) ErrorName() string {
+	return "ListVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListVersionsResponseValidationError{}
+
+// Validate checks the field values on GetSchemaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSchemaRequestMultiError, or nil if none found.
+func (m *GetSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	// no validation rules for VersionId
+
+	// no validation rules for Assemble
+
+	if len(errors) > 0 {
+		return GetSchemaRequestMultiError(errors)
+	}
+	return nil
+}
+
+// GetSchemaRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSchemaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSchemaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSchemaRequestMultiError) AllErrors() []error { return m }
+
+// GetSchemaRequestValidationError is the validation error returned by
+// GetSchemaRequest.Validate if the designated constraints aren't met.
+type GetSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSchemaRequestValidationError) ErrorName() string { return "GetSchemaRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSchemaRequestValidationError{}
+
+// Validate checks the field values on GetSchemaResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSchemaResponseMultiError, or nil if none found.
+func (m *GetSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return GetSchemaResponseMultiError(errors)
+	}
+	return nil
+}
+
+// GetSchemaResponseMultiError is an error wrapping multiple validation errors
+// returned by GetSchemaResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetSchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSchemaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSchemaResponseMultiError) AllErrors() []error { return m }
+
+// GetSchemaResponseValidationError is the validation error returned by
+// GetSchemaResponse.Validate if the designated constraints aren't met.
+type GetSchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSchemaResponseValidationError) ErrorName() string {
+	return "GetSchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSchemaResponseValidationError{}
+
+// Validate checks the field values on GetSchemaByGlobalIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSchemaByGlobalIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSchemaByGlobalIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSchemaByGlobalIDRequestMultiError, or nil if none found.
+func (m *GetSchemaByGlobalIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSchemaByGlobalIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GlobalId
+
+	if len(errors) > 0 {
+		return GetSchemaByGlobalIDRequestMultiError(errors)
+	}
+	return nil
+}
+
+// GetSchemaByGlobalIDRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSchemaByGlobalIDRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetSchemaByGlobalIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSchemaByGlobalIDRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSchemaByGlobalIDRequestMultiError) AllErrors() []error { return m }
+
+// GetSchemaByGlobalIDRequestValidationError is the validation error returned
+// by GetSchemaByGlobalIDRequest.Validate if the designated constraints aren't met.
+type GetSchemaByGlobalIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSchemaByGlobalIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSchemaByGlobalIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSchemaByGlobalIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSchemaByGlobalIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSchemaByGlobalIDRequestValidationError) ErrorName() string {
+	return "GetSchemaByGlobalIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSchemaByGlobalIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSchemaByGlobalIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSchemaByGlobalIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSchemaByGlobalIDRequestValidationError{}
+
+// Validate checks the field values on GetSchemaByGlobalIDResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSchemaByGlobalIDResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSchemaByGlobalIDResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSchemaByGlobalIDResponseMultiError, or nil if none found.
+func (m *GetSchemaByGlobalIDResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSchemaByGlobalIDResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	// no validation rules for Version
+
+	// no validation rules for GlobalId
+
+	// no validation rules for Format
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return GetSchemaByGlobalIDResponseMultiError(errors)
+	}
+	return nil
+}
+
+// GetSchemaByGlobalIDResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSchemaByGlobalIDResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetSchemaByGlobalIDResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSchemaByGlobalIDResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSchemaByGlobalIDResponseMultiError) AllErrors() []error { return m }
+
+// GetSchemaByGlobalIDResponseValidationError is the validation error returned
+// by GetSchemaByGlobalIDResponse.Validate if the designated constraints
+// aren't met.
+type GetSchemaByGlobalIDResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSchemaByGlobalIDResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSchemaByGlobalIDResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSchemaByGlobalIDResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSchemaByGlobalIDResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSchemaByGlobalIDResponseValidationError) ErrorName() string {
+	return "GetSchemaByGlobalIDResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSchemaByGlobalIDResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSchemaByGlobalIDResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSchemaByGlobalIDResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSchemaByGlobalIDResponseValidationError{}
+
+// Validate checks the field values on DeleteVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteVersionRequestMultiError, or nil if none found.
+func (m *DeleteVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	// no validation rules for VersionId
+
+	if len(errors) > 0 {
+		return DeleteVersionRequestMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteVersionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteVersionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteVersionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteVersionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteVersionRequestValidationError is the validation error returned by
+// DeleteVersionRequest.Validate if the designated constraints aren't met.
+type DeleteVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteVersionRequestValidationError) ErrorName() string {
+	return "DeleteVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteVersionRequestValidationError{}
+
+// Validate checks the field values on DeleteVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteVersionResponseMultiError, or nil if none found.
+func (m *DeleteVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteVersionResponseMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteVersionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteVersionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteVersionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteVersionResponseValidationError is the validation error returned by
+// DeleteVersionResponse.Validate if the designated constraints aren't met.
+type DeleteVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteVersionResponseValidationError) ErrorName() string {
+	return "DeleteVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteVersionResponseValidationError{}
+
+// Validate checks the field values on WatchSchemasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchSchemasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchSchemasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchSchemasRequestMultiError, or nil if none found.
+func (m *WatchSchemasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchSchemasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	if len(errors) > 0 {
+		return WatchSchemasRequestMultiError(errors)
+	}
+	return nil
+}
+
+// WatchSchemasRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchSchemasRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchSchemasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchSchemasRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchSchemasRequestMultiError) AllErrors() []error { return m }
+
+// WatchSchemasRequestValidationError is the validation error returned by
+// WatchSchemasRequest.Validate if the designated constraints aren't met.
+type WatchSchemasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchSchemasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchSchemasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchSchemasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchSchemasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchSchemasRequestValidationError) ErrorName() string {
+	return "WatchSchemasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchSchemasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchSchemasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchSchemasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchSchemasRequestValidationError{}
+
+// Validate checks the field values on WatchSchemasResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchSchemasResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchSchemasResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchSchemasResponseMultiError, or nil if none found.
+func (m *WatchSchemasResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchSchemasResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return WatchSchemasResponseMultiError(errors)
+	}
+	return nil
+}
+
+// WatchSchemasResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchSchemasResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchSchemasResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchSchemasResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchSchemasResponseMultiError) AllErrors() []error { return m }
+
+// WatchSchemasResponseValidationError is the validation error returned by
+// WatchSchemasResponse.Validate if the designated constraints aren't met.
+type WatchSchemasResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchSchemasResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchSchemasResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchSchemasResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchSchemasResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchSchemasResponseValidationError) ErrorName() string {
+	return "WatchSchemasResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchSchemasResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchSchemasResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchSchemasResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchSchemasResponseValidationError{}
+
+// Validate checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Webhook) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in WebhookMultiError, or nil if none found.
+func (m *Webhook) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Webhook) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for Url
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookMultiError(errors)
+	}
+	return nil
+}
+
+// WebhookMultiError is an error wrapping multiple validation errors returned
+// by Webhook.ValidateAll() if the designated constraints aren't met.
+type WebhookMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookMultiError) AllErrors() []error { return m }
+
+// WebhookValidationError is the validation error returned by Webhook.Validate
+// if the designated constraints aren't met.
+type WebhookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookValidationError) ErrorName() string { return "WebhookValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookValidationError{}
+
+// Validate checks the field values on WebhookDelivery with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebhookDelivery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookDelivery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookDeliveryMultiError, or nil if none found.
+func (m *WebhookDelivery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookDelivery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for WebhookId
+
+	// no validation rules for EventType
+
+	// no validation rules for Payload
+
+	// no validation rules for Attempt
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Success
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookDeliveryMultiError(errors)
+	}
+	return nil
+}
+
+// WebhookDeliveryMultiError is an error wrapping multiple validation errors
+// returned by WebhookDelivery.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookDeliveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookDeliveryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookDeliveryMultiError) AllErrors() []error { return m }
+
+// WebhookDeliveryValidationError is the validation error returned by
+// WebhookDelivery.Validate if the designated constraints aren't met.
+type WebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookDeliveryValidationError) ErrorName() string { return "WebhookDeliveryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookDeliveryValidationError{}
+
+// Validate checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookRequestMultiError, or nil if none found.
+func (m *CreateWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for Url
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return CreateWebhookRequestMultiError(errors)
+	}
+	return nil
+}
+
+// CreateWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookRequestMultiError) AllErrors() []error { return m }
+
+// CreateWebhookRequestValidationError is the validation error returned by
+// CreateWebhookRequest.Validate if the designated constraints aren't met.
+type CreateWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookRequestValidationError) ErrorName() string {
+	return "CreateWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookRequestValidationError{}
+
+// Validate checks the field values on CreateWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookResponseMultiError, or nil if none found.
+func (m *CreateWebhookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWebhookResponseValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateWebhookResponseValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWebhookResponseValidationError{
+				field:  "Webhook",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateWebhookResponseMultiError(errors)
+	}
+	return nil
+}
+
+// CreateWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookResponseMultiError) AllErrors() []error { return m }
+
+// CreateWebhookResponseValidationError is the validation error returned by
+// CreateWebhookResponse.Validate if the designated constraints aren't met.
+type CreateWebhookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookResponseValidationError) ErrorName() string {
+	return "CreateWebhookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookResponseValidationError{}
+
+// Validate checks the field values on ListWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhooksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhooksRequestMultiError, or nil if none found.
+func (m *ListWebhooksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhooksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	if len(errors) > 0 {
+		return ListWebhooksRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListWebhooksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWebhooksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhooksRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhooksRequestValidationError is the validation error returned by
+// ListWebhooksRequest.Validate if the designated constraints aren't met.
+type ListWebhooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhooksRequestValidationError) ErrorName() string {
+	return "ListWebhooksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhooksRequestValidationError{}
+
+// Validate checks the field values on ListWebhooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhooksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhooksResponseMultiError, or nil if none found.
+func (m *ListWebhooksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhooksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWebhooks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhooksResponseValidationError{
+						field:  fmt.Sprintf("Webhooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhooksResponseValidationError{
+						field:  fmt.Sprintf("Webhooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhooksResponseValidationError{
+					field:  fmt.Sprintf("Webhooks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWebhooksResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ListWebhooksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListWebhooksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhooksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhooksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhooksResponseMultiError) AllErrors() []error { return m }
+
+// ListWebhooksResponseValidationError is the validation error returned by
+// ListWebhooksResponse.Validate if the designated constraints aren't met.
+type ListWebhooksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhooksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhooksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhooksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhooksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhooksResponseValidationError) ErrorName() string {
+	return "ListWebhooksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhooksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhooksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhooksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhooksResponseValidationError{}
+
+// Validate checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookRequestMultiError, or nil if none found.
+func (m *DeleteWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteWebhookRequestMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookRequestValidationError is the validation error returned by
+// DeleteWebhookRequest.Validate if the designated constraints aren't met.
+type DeleteWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookRequestValidationError) ErrorName() string {
+	return "DeleteWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookRequestValidationError{}
+
+// Validate checks the field values on DeleteWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookResponseMultiError, or nil if none found.
+func (m *DeleteWebhookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteWebhookResponseMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookResponseMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookResponseValidationError is the validation error returned by
+// DeleteWebhookResponse.Validate if the designated constraints aren't met.
+type DeleteWebhookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookResponseValidationError) ErrorName() string {
+	return "DeleteWebhookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookResponseValidationError{}
+
+// Validate checks the field values on ListWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookDeliveriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveriesRequestMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookDeliveriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ListWebhookDeliveriesRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListWebhookDeliveriesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListWebhookDeliveriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookDeliveriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookDeliveriesRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhookDeliveriesRequestValidationError is the validation error returned
+// by ListWebhookDeliveriesRequest.Validate if the designated constraints
+// aren't met.
+type ListWebhookDeliveriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookDeliveriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookDeliveriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookDeliveriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookDeliveriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookDeliveriesRequestValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookDeliveriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookDeliveriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookDeliveriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookDeliveriesRequestValidationError{}
+
+// Validate checks the field values on ListWebhookDeliveriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookDeliveriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookDeliveriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveriesResponseMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookDeliveriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeliveries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookDeliveriesResponseValidationError{
+					field:  fmt.Sprintf("Deliveries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookDeliveriesResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ListWebhookDeliveriesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListWebhookDeliveriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookDeliveriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookDeliveriesResponseMultiError) AllErrors() []error { return m }
+
+// ListWebhookDeliveriesResponseValidationError is the validation error
+// returned by ListWebhookDeliveriesResponse.Validate if the designated
+// constraints aren't met.
+type ListWebhookDeliveriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookDeliveriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookDeliveriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookDeliveriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookDeliveriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookDeliveriesResponseValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookDeliveriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookDeliveriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookDeliveriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookDeliveriesResponseValidationError{}
+
+// Validate checks the field values on RoleBinding with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleBinding) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleBinding with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleBindingMultiError, or
+// nil if none found.
+func (m *RoleBinding) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleBinding) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for Principal
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleBindingValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleBindingValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleBindingValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleBindingValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleBindingValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleBindingValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RoleBindingMultiError(errors)
+	}
+	return nil
+}
+
+// RoleBindingMultiError is an error wrapping multiple validation errors
+// returned by RoleBinding.ValidateAll() if the designated constraints aren't met.
+type RoleBindingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleBindingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleBindingMultiError) AllErrors() []error { return m }
+
+// RoleBindingValidationError is the validation error returned by
+// RoleBinding.Validate if the designated constraints aren't met.
+type RoleBindingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleBindingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleBindingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleBindingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleBindingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleBindingValidationError) ErrorName() string { return "RoleBindingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleBindingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleBinding.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleBindingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleBindingValidationError{}
+
+// Validate checks the field values on ListRoleBindingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRoleBindingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoleBindingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoleBindingsRequestMultiError, or nil if none found.
+func (m *ListRoleBindingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoleBindingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	if len(errors) > 0 {
+		return ListRoleBindingsRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListRoleBindingsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRoleBindingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRoleBindingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoleBindingsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoleBindingsRequestMultiError) AllErrors() []error { return m }
+
+// ListRoleBindingsRequestValidationError is the validation error returned by
+// ListRoleBindingsRequest.Validate if the designated constraints aren't met.
+type ListRoleBindingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoleBindingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoleBindingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoleBindingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoleBindingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoleBindingsRequestValidationError) ErrorName() string {
+	return "ListRoleBindingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRoleBindingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoleBindingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoleBindingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoleBindingsRequestValidationError{}
+
+// Validate checks the field values on ListRoleBindingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRoleBindingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoleBindingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoleBindingsResponseMultiError, or nil if none found.
+func (m *ListRoleBindingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoleBindingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoleBindings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRoleBindingsResponseValidationError{
+						field:  fmt.Sprintf("RoleBindings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRoleBindingsResponseValidationError{
+						field:  fmt.Sprintf("RoleBindings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRoleBindingsResponseValidationError{
+					field:  fmt.Sprintf("RoleBindings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRoleBindingsResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ListRoleBindingsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRoleBindingsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListRoleBindingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoleBindingsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoleBindingsResponseMultiError) AllErrors() []error { return m }
+
+// ListRoleBindingsResponseValidationError is the validation error returned by
+// ListRoleBindingsResponse.Validate if the designated constraints aren't met.
+type ListRoleBindingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoleBindingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoleBindingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoleBindingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoleBindingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoleBindingsResponseValidationError) ErrorName() string {
+	return "ListRoleBindingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRoleBindingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoleBindingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoleBindingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoleBindingsResponseValidationError{}
+
+// Validate checks the field values on GrantRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GrantRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GrantRoleRequestMultiError, or nil if none found.
+func (m *GrantRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GrantRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for Principal
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return GrantRoleRequestMultiError(errors)
+	}
+	return nil
+}
+
+// GrantRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by GrantRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GrantRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantRoleRequestMultiError) AllErrors() []error { return m }
+
+// GrantRoleRequestValidationError is the validation error returned by
+// GrantRoleRequest.Validate if the designated constraints aren't met.
+type GrantRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantRoleRequestValidationError) ErrorName() string { return "GrantRoleRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GrantRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantRoleRequestValidationError{}
+
+// Validate checks the field values on GrantRoleResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GrantRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GrantRoleResponseMultiError, or nil if none found.
+func (m *GrantRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GrantRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRoleBinding()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GrantRoleResponseValidationError{
+					field:  "RoleBinding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GrantRoleResponseValidationError{
+					field:  "RoleBinding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRoleBinding()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GrantRoleResponseValidationError{
+				field:  "RoleBinding",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GrantRoleResponseMultiError(errors)
+	}
+	return nil
+}
+
+// GrantRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by GrantRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GrantRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantRoleResponseMultiError) AllErrors() []error { return m }
+
+// GrantRoleResponseValidationError is the validation error returned by
+// GrantRoleResponse.Validate if the designated constraints aren't met.
+type GrantRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantRoleResponseValidationError) ErrorName() string {
+	return "GrantRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GrantRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantRoleResponseValidationError{}
+
+// Validate checks the field values on RevokeRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RevokeRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeRoleRequestMultiError, or nil if none found.
+func (m *RevokeRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for Principal
+
+	if len(errors) > 0 {
+		return RevokeRoleRequestMultiError(errors)
+	}
+	return nil
+}
+
+// RevokeRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by RevokeRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeRoleRequestMultiError) AllErrors() []error { return m }
+
+// RevokeRoleRequestValidationError is the validation error returned by
+// RevokeRoleRequest.Validate if the designated constraints aren't met.
+type RevokeRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeRoleRequestValidationError) ErrorName() string {
+	return "RevokeRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeRoleRequestValidationError{}
+
+// Validate checks the field values on RevokeRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeRoleResponseMultiError, or nil if none found.
+func (m *RevokeRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return RevokeRoleResponseMultiError(errors)
+	}
+	return nil
+}
+
+// RevokeRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by RevokeRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeRoleResponseMultiError) AllErrors() []error { return m }
+
+// RevokeRoleResponseValidationError is the validation error returned by
+// RevokeRoleResponse.Validate if the designated constraints aren't met.
+type RevokeRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeRoleResponseValidationError) ErrorName() string {
+	return "RevokeRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeRoleResponseValidationError{}
+
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Actor
+
+	// no validation rules for RequestId
+
+	// no validation rules for Operation
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuditEventMultiError(errors)
+	}
+	return nil
+}
+
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEventMultiError) AllErrors() []error { return m }
+
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEventValidationError{}
+
+// Validate checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListAuditEventsRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsRequestValidationError{}
+
+// Validate checks the field values on ListAuditEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListAuditEventsResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsResponseValidationError{}
+
+// Validate checks the field values on RestoreNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreNamespaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreNamespaceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreNamespaceRequestMultiError, or nil if none found.
+func (m *RestoreNamespaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreNamespaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RestoreNamespaceRequestMultiError(errors)
+	}
+	return nil
+}
+
+// RestoreNamespaceRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreNamespaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreNamespaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreNamespaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreNamespaceRequestMultiError) AllErrors() []error { return m }
+
+// RestoreNamespaceRequestValidationError is the validation error returned by
+// RestoreNamespaceRequest.Validate if the designated constraints aren't met.
+type RestoreNamespaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreNamespaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreNamespaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreNamespaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreNamespaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreNamespaceRequestValidationError) ErrorName() string {
+	return "RestoreNamespaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreNamespaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreNamespaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreNamespaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreNamespaceRequestValidationError{}
+
+// Validate checks the field values on RestoreNamespaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreNamespaceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreNamespaceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreNamespaceResponseMultiError, or nil if none found.
+func (m *RestoreNamespaceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreNamespaceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNamespace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreNamespaceResponseValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreNamespaceResponseValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreNamespaceResponseValidationError{
+				field:  "Namespace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreNamespaceResponseMultiError(errors)
+	}
+	return nil
+}
+
+// RestoreNamespaceResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreNamespaceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreNamespaceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreNamespaceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreNamespaceResponseMultiError) AllErrors() []error { return m }
+
+// RestoreNamespaceResponseValidationError is the validation error returned by
+// RestoreNamespaceResponse.Validate if the designated constraints aren't met.
+type RestoreNamespaceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreNamespaceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreNamespaceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreNamespaceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreNamespaceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreNamespaceResponseValidationError) ErrorName() string {
+	return "RestoreNamespaceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreNamespaceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreNamespaceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreNamespaceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreNamespaceResponseValidationError{}
+
+// Validate checks the field values on RestoreSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreSchemaRequestMultiError, or nil if none found.
+func (m *RestoreSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	if len(errors) > 0 {
+		return RestoreSchemaRequestMultiError(errors)
+	}
+	return nil
+}
+
+// RestoreSchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreSchemaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreSchemaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreSchemaRequestMultiError) AllErrors() []error { return m }
+
+// RestoreSchemaRequestValidationError is the validation error returned by
+// RestoreSchemaRequest.Validate if the designated constraints aren't met.
+type RestoreSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreSchemaRequestValidationError) ErrorName() string {
+	return "RestoreSchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreSchemaRequestValidationError{}
+
+// Validate checks the field values on RestoreSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreSchemaResponseMultiError, or nil if none found.
+func (m *RestoreSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return RestoreSchemaResponseMultiError(errors)
+	}
+	return nil
+}
+
+// RestoreSchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreSchemaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreSchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreSchemaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreSchemaResponseMultiError) AllErrors() []error { return m }
+
+// RestoreSchemaResponseValidationError is the validation error returned by
+// RestoreSchemaResponse.Validate if the designated constraints aren't met.
+type RestoreSchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreSchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreSchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreSchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreSchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreSchemaResponseValidationError) ErrorName() string {
+	return "RestoreSchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreSchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreSchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreSchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreSchemaResponseValidationError{}
+
+// Validate checks the field values on RestoreVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreVersionRequestMultiError, or nil if none found.
+func (m *RestoreVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	// no validation rules for VersionId
+
+	if len(errors) > 0 {
+		return RestoreVersionRequestMultiError(errors)
+	}
+	return nil
+}
+
+// RestoreVersionRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreVersionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreVersionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreVersionRequestMultiError) AllErrors() []error { return m }
+
+// RestoreVersionRequestValidationError is the validation error returned by
+// RestoreVersionRequest.Validate if the designated constraints aren't met.
+type RestoreVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreVersionRequestValidationError) ErrorName() string {
+	return "RestoreVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreVersionRequestValidationError{}
+
+// Validate checks the field values on RestoreVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreVersionResponseMultiError, or nil if none found.
+func (m *RestoreVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return RestoreVersionResponseMultiError(errors)
+	}
+	return nil
+}
+
+// RestoreVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreVersionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreVersionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreVersionResponseMultiError) AllErrors() []error { return m }
+
+// RestoreVersionResponseValidationError is the validation error returned by
+// RestoreVersionResponse.Validate if the designated constraints aren't met.
+type RestoreVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreVersionResponseValidationError) ErrorName() string {
+	return "RestoreVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreVersionResponseValidationError{}
+
+// Validate checks the field values on DeletedItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeletedItem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateSchemaMetadataResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateSchemaMetadataResponseMultiError, or nil if none found.
-func (m *UpdateSchemaMetadataResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeletedItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeletedItemMultiError, or
+// nil if none found.
+func (m *DeletedItem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateSchemaMetadataResponse) validate(all bool) error {
+func (m *DeletedItem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Format
+	// no validation rules for Kind
 
-	// no validation rules for Compatibility
+	// no validation rules for NamespaceId
 
-	// no validation rules for Authority
+	// no validation rules for SchemaId
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeletedItemValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeletedItemValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeletedItemValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPurgeAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeletedItemValidationError{
+					field:  "PurgeAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeletedItemValidationError{
+					field:  "PurgeAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPurgeAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeletedItemValidationError{
+				field:  "PurgeAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return UpdateSchemaMetadataResponseMultiError(errors)
+		return DeletedItemMultiError(errors)
 	}
 	return nil
 }
 
-// UpdateSchemaMetadataResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateSchemaMetadataResponse.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateSchemaMetadataResponseMultiError []error
+// DeletedItemMultiError is an error wrapping multiple validation errors
+// returned by DeletedItem.ValidateAll() if the designated constraints aren't met.
+type DeletedItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateSchemaMetadataResponseMultiError) Error() string {
+func (m DeletedItemMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2818,12 +8417,11 @@ func (m UpdateSchemaMetadataResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateSchemaMetadataResponseMultiError) AllErrors() []error { return m }
+func (m DeletedItemMultiError) AllErrors() []error { return m }
 
-// UpdateSchemaMetadataResponseValidationError is the validation error returned
-// by UpdateSchemaMetadataResponse.Validate if the designated constraints
-// aren't met.
-type UpdateSchemaMetadataResponseValidationError struct {
+// DeletedItemValidationError is the validation error returned by
+// DeletedItem.Validate if the designated constraints aren't met.
+type DeletedItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2831,24 +8429,22 @@ type UpdateSchemaMetadataResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateSchemaMetadataResponseValidationError) Field() string { return e.field }
+func (e DeletedItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateSchemaMetadataResponseValidationError) Reason() string { return e.reason }
+func (e DeletedItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateSchemaMetadataResponseValidationError) Cause() error { return e.cause }
+func (e DeletedItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateSchemaMetadataResponseValidationError) Key() bool { return e.key }
+func (e DeletedItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateSchemaMetadataResponseValidationError) ErrorName() string {
-	return "UpdateSchemaMetadataResponseValidationError"
-}
+func (e DeletedItemValidationError) ErrorName() string { return "DeletedItemValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateSchemaMetadataResponseValidationError) Error() string {
+func (e DeletedItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2860,14 +8456,14 @@ func (e UpdateSchemaMetadataResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateSchemaMetadataResponse.%s: %s%s",
+		"invalid %sDeletedItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateSchemaMetadataResponseValidationError{}
+var _ error = DeletedItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -2875,24 +8471,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateSchemaMetadataResponseValidationError{}
+} = DeletedItemValidationError{}
 
-// Validate checks the field values on DeleteSchemaRequest with the rules
+// Validate checks the field values on ListDeletedItemsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteSchemaRequest) Validate() error {
+func (m *ListDeletedItemsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteSchemaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListDeletedItemsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteSchemaRequestMultiError, or nil if none found.
-func (m *DeleteSchemaRequest) ValidateAll() error {
+// ListDeletedItemsRequestMultiError, or nil if none found.
+func (m *ListDeletedItemsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteSchemaRequest) validate(all bool) error {
+func (m *ListDeletedItemsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2901,21 +8497,19 @@ func (m *DeleteSchemaRequest) validate(all bool) error {
 
 	// no validation rules for NamespaceId
 
-	// no validation rules for SchemaId
-
 	if len(errors) > 0 {
-		return DeleteSchemaRequestMultiError(errors)
+		return ListDeletedItemsRequestMultiError(errors)
 	}
 	return nil
 }
 
-// DeleteSchemaRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteSchemaRequest.ValidateAll() if the designated
+// ListDeletedItemsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedItemsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteSchemaRequestMultiError []error
+type ListDeletedItemsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteSchemaRequestMultiError) Error() string {
+func (m ListDeletedItemsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2924,11 +8518,11 @@ func (m DeleteSchemaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteSchemaRequestMultiError) AllErrors() []error { return m }
+func (m ListDeletedItemsRequestMultiError) AllErrors() []error { return m }
 
-// DeleteSchemaRequestValidationError is the validation error returned by
-// DeleteSchemaRequest.Validate if the designated constraints aren't met.
-type DeleteSchemaRequestValidationError struct {
+// ListDeletedItemsRequestValidationError is the validation error returned by
+// ListDeletedItemsRequest.Validate if the designated constraints aren't met.
+type ListDeletedItemsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2936,24 +8530,24 @@ type DeleteSchemaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteSchemaRequestValidationError) Field() string { return e.field }
+func (e ListDeletedItemsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteSchemaRequestValidationError) Reason() string { return e.reason }
+func (e ListDeletedItemsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteSchemaRequestValidationError) Cause() error { return e.cause }
+func (e ListDeletedItemsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteSchemaRequestValidationError) Key() bool { return e.key }
+func (e ListDeletedItemsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteSchemaRequestValidationError) ErrorName() string {
-	return "DeleteSchemaRequestValidationError"
+func (e ListDeletedItemsRequestValidationError) ErrorName() string {
+	return "ListDeletedItemsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteSchemaRequestValidationError) Error() string {
+func (e ListDeletedItemsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2965,14 +8559,14 @@ func (e DeleteSchemaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteSchemaRequest.%s: %s%s",
+		"invalid %sListDeletedItemsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteSchemaRequestValidationError{}
+var _ error = ListDeletedItemsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2980,45 +8574,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteSchemaRequestValidationError{}
+} = ListDeletedItemsRequestValidationError{}
 
-// Validate checks the field values on DeleteSchemaResponse with the rules
+// Validate checks the field values on ListDeletedItemsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteSchemaResponse) Validate() error {
+func (m *ListDeletedItemsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteSchemaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListDeletedItemsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteSchemaResponseMultiError, or nil if none found.
-func (m *DeleteSchemaResponse) ValidateAll() error {
+// ListDeletedItemsResponseMultiError, or nil if none found.
+func (m *ListDeletedItemsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteSchemaResponse) validate(all bool) error {
+func (m *ListDeletedItemsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeletedItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeletedItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeletedItemsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteSchemaResponseMultiError(errors)
+		return ListDeletedItemsResponseMultiError(errors)
 	}
 	return nil
 }
 
-// DeleteSchemaResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteSchemaResponse.ValidateAll() if the designated
+// ListDeletedItemsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedItemsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteSchemaResponseMultiError []error
+type ListDeletedItemsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteSchemaResponseMultiError) Error() string {
+func (m ListDeletedItemsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3027,11 +8653,11 @@ func (m DeleteSchemaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteSchemaResponseMultiError) AllErrors() []error { return m }
+func (m ListDeletedItemsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteSchemaResponseValidationError is the validation error returned by
-// DeleteSchemaResponse.Validate if the designated constraints aren't met.
-type DeleteSchemaResponseValidationError struct {
+// ListDeletedItemsResponseValidationError is the validation error returned by
+// ListDeletedItemsResponse.Validate if the designated constraints aren't met.
+type ListDeletedItemsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3039,24 +8665,24 @@ type DeleteSchemaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteSchemaResponseValidationError) Field() string { return e.field }
+func (e ListDeletedItemsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteSchemaResponseValidationError) Reason() string { return e.reason }
+func (e ListDeletedItemsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteSchemaResponseValidationError) Cause() error { return e.cause }
+func (e ListDeletedItemsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteSchemaResponseValidationError) Key() bool { return e.key }
+func (e ListDeletedItemsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteSchemaResponseValidationError) ErrorName() string {
-	return "DeleteSchemaResponseValidationError"
+func (e ListDeletedItemsResponseValidationError) ErrorName() string {
+	return "ListDeletedItemsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteSchemaResponseValidationError) Error() string {
+func (e ListDeletedItemsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3068,14 +8694,14 @@ func (e DeleteSchemaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteSchemaResponse.%s: %s%s",
+		"invalid %sListDeletedItemsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteSchemaResponseValidationError{}
+var _ error = ListDeletedItemsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3083,47 +8709,117 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteSchemaResponseValidationError{}
+} = ListDeletedItemsResponseValidationError{}
 
-// Validate checks the field values on ListVersionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListVersionsRequest) Validate() error {
+// Validate checks the field values on SchemaVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaVersion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListVersionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListVersionsRequestMultiError, or nil if none found.
-func (m *ListVersionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on SchemaVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaVersionMultiError, or
+// nil if none found.
+func (m *SchemaVersion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListVersionsRequest) validate(all bool) error {
+func (m *SchemaVersion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for NamespaceId
+	// no validation rules for Version
 
-	// no validation rules for SchemaId
+	// no validation rules for State
+
+	// no validation rules for StateReason
+
+	if all {
+		switch v := interface{}(m.GetSunsetAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaVersionValidationError{
+					field:  "SunsetAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaVersionValidationError{
+					field:  "SunsetAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSunsetAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaVersionValidationError{
+				field:  "SunsetAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for SchemaFileId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaVersionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Creator
+
+	// no validation rules for Message
+
+	// no validation rules for Size
 
 	if len(errors) > 0 {
-		return ListVersionsRequestMultiError(errors)
+		return SchemaVersionMultiError(errors)
 	}
 	return nil
 }
 
-// ListVersionsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListVersionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListVersionsRequestMultiError []error
+// SchemaVersionMultiError is an error wrapping multiple validation errors
+// returned by SchemaVersion.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaVersionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListVersionsRequestMultiError) Error() string {
+func (m SchemaVersionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3132,11 +8828,11 @@ func (m ListVersionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListVersionsRequestMultiError) AllErrors() []error { return m }
+func (m SchemaVersionMultiError) AllErrors() []error { return m }
 
-// ListVersionsRequestValidationError is the validation error returned by
-// ListVersionsRequest.Validate if the designated constraints aren't met.
-type ListVersionsRequestValidationError struct {
+// SchemaVersionValidationError is the validation error returned by
+// SchemaVersion.Validate if the designated constraints aren't met.
+type SchemaVersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3144,24 +8840,22 @@ type ListVersionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListVersionsRequestValidationError) Field() string { return e.field }
+func (e SchemaVersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListVersionsRequestValidationError) Reason() string { return e.reason }
+func (e SchemaVersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListVersionsRequestValidationError) Cause() error { return e.cause }
+func (e SchemaVersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListVersionsRequestValidationError) Key() bool { return e.key }
+func (e SchemaVersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListVersionsRequestValidationError) ErrorName() string {
-	return "ListVersionsRequestValidationError"
-}
+func (e SchemaVersionValidationError) ErrorName() string { return "SchemaVersionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListVersionsRequestValidationError) Error() string {
+func (e SchemaVersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3173,14 +8867,14 @@ func (e ListVersionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListVersionsRequest.%s: %s%s",
+		"invalid %sSchemaVersion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListVersionsRequestValidationError{}
+var _ error = SchemaVersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -3188,43 +8882,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListVersionsRequestValidationError{}
+} = SchemaVersionValidationError{}
 
-// Validate checks the field values on ListVersionsResponse with the rules
+// Validate checks the field values on UpdateVersionStateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListVersionsResponse) Validate() error {
+func (m *UpdateVersionStateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListVersionsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateVersionStateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListVersionsResponseMultiError, or nil if none found.
-func (m *ListVersionsResponse) ValidateAll() error {
+// UpdateVersionStateRequestMultiError, or nil if none found.
+func (m *UpdateVersionStateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListVersionsResponse) validate(all bool) error {
+func (m *UpdateVersionStateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	// no validation rules for VersionId
+
+	// no validation rules for State
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetSunsetAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateVersionStateRequestValidationError{
+					field:  "SunsetAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateVersionStateRequestValidationError{
+					field:  "SunsetAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSunsetAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateVersionStateRequestValidationError{
+				field:  "SunsetAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ListVersionsResponseMultiError(errors)
+		return UpdateVersionStateRequestMultiError(errors)
 	}
 	return nil
 }
 
-// ListVersionsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListVersionsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListVersionsResponseMultiError []error
+// UpdateVersionStateRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateVersionStateRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateVersionStateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListVersionsResponseMultiError) Error() string {
+func (m UpdateVersionStateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3233,11 +8966,11 @@ func (m ListVersionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListVersionsResponseMultiError) AllErrors() []error { return m }
+func (m UpdateVersionStateRequestMultiError) AllErrors() []error { return m }
 
-// ListVersionsResponseValidationError is the validation error returned by
-// ListVersionsResponse.Validate if the designated constraints aren't met.
-type ListVersionsResponseValidationError struct {
+// UpdateVersionStateRequestValidationError is the validation error returned by
+// UpdateVersionStateRequest.Validate if the designated constraints aren't met.
+type UpdateVersionStateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3245,24 +8978,24 @@ type ListVersionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListVersionsResponseValidationError) Field() string { return e.field }
+func (e UpdateVersionStateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListVersionsResponseValidationError) Reason() string { return e.reason }
+func (e UpdateVersionStateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListVersionsResponseValidationError) Cause() error { return e.cause }
+func (e UpdateVersionStateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListVersionsResponseValidationError) Key() bool { return e.key }
+func (e UpdateVersionStateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListVersionsResponseValidationError) ErrorName() string {
-	return "ListVersionsResponseValidationError"
+func (e UpdateVersionStateRequestValidationError) ErrorName() string {
+	return "UpdateVersionStateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListVersionsResponseValidationError) Error() string {
+func (e UpdateVersionStateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3274,14 +9007,14 @@ func (e ListVersionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListVersionsResponse.%s: %s%s",
+		"invalid %sUpdateVersionStateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListVersionsResponseValidationError{}
+var _ error = UpdateVersionStateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3289,49 +9022,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListVersionsResponseValidationError{}
+} = UpdateVersionStateRequestValidationError{}
 
-// Validate checks the field values on GetSchemaRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetSchemaRequest) Validate() error {
+// Validate checks the field values on UpdateVersionStateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateVersionStateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSchemaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateVersionStateResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSchemaRequestMultiError, or nil if none found.
-func (m *GetSchemaRequest) ValidateAll() error {
+// UpdateVersionStateResponseMultiError, or nil if none found.
+func (m *UpdateVersionStateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSchemaRequest) validate(all bool) error {
+func (m *UpdateVersionStateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for NamespaceId
-
-	// no validation rules for SchemaId
-
-	// no validation rules for VersionId
+	if all {
+		switch v := interface{}(m.GetVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateVersionStateResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateVersionStateResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateVersionStateResponseValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetSchemaRequestMultiError(errors)
+		return UpdateVersionStateResponseMultiError(errors)
 	}
 	return nil
 }
 
-// GetSchemaRequestMultiError is an error wrapping multiple validation errors
-// returned by GetSchemaRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetSchemaRequestMultiError []error
+// UpdateVersionStateResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateVersionStateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateVersionStateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSchemaRequestMultiError) Error() string {
+func (m UpdateVersionStateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3340,11 +9096,11 @@ func (m GetSchemaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSchemaRequestMultiError) AllErrors() []error { return m }
+func (m UpdateVersionStateResponseMultiError) AllErrors() []error { return m }
 
-// GetSchemaRequestValidationError is the validation error returned by
-// GetSchemaRequest.Validate if the designated constraints aren't met.
-type GetSchemaRequestValidationError struct {
+// UpdateVersionStateResponseValidationError is the validation error returned
+// by UpdateVersionStateResponse.Validate if the designated constraints aren't met.
+type UpdateVersionStateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3352,22 +9108,24 @@ type GetSchemaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSchemaRequestValidationError) Field() string { return e.field }
+func (e UpdateVersionStateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSchemaRequestValidationError) Reason() string { return e.reason }
+func (e UpdateVersionStateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSchemaRequestValidationError) Cause() error { return e.cause }
+func (e UpdateVersionStateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSchemaRequestValidationError) Key() bool { return e.key }
+func (e UpdateVersionStateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSchemaRequestValidationError) ErrorName() string { return "GetSchemaRequestValidationError" }
+func (e UpdateVersionStateResponseValidationError) ErrorName() string {
+	return "UpdateVersionStateResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetSchemaRequestValidationError) Error() string {
+func (e UpdateVersionStateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3379,14 +9137,14 @@ func (e GetSchemaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSchemaRequest.%s: %s%s",
+		"invalid %sUpdateVersionStateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSchemaRequestValidationError{}
+var _ error = UpdateVersionStateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3394,45 +9152,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSchemaRequestValidationError{}
+} = UpdateVersionStateResponseValidationError{}
 
-// Validate checks the field values on GetSchemaResponse with the rules defined
+// Validate checks the field values on SchemaReference with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetSchemaResponse) Validate() error {
+func (m *SchemaReference) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSchemaResponse with the rules
+// ValidateAll checks the field values on SchemaReference with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSchemaResponseMultiError, or nil if none found.
-func (m *GetSchemaResponse) ValidateAll() error {
+// SchemaReferenceMultiError, or nil if none found.
+func (m *SchemaReference) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSchemaResponse) validate(all bool) error {
+func (m *SchemaReference) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
+	// no validation rules for NamespaceId
+
+	// no validation rules for SchemaId
+
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return GetSchemaResponseMultiError(errors)
+		return SchemaReferenceMultiError(errors)
 	}
 	return nil
 }
 
-// GetSchemaResponseMultiError is an error wrapping multiple validation errors
-// returned by GetSchemaResponse.ValidateAll() if the designated constraints
+// SchemaReferenceMultiError is an error wrapping multiple validation errors
+// returned by SchemaReference.ValidateAll() if the designated constraints
 // aren't met.
-type GetSchemaResponseMultiError []error
+type SchemaReferenceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSchemaResponseMultiError) Error() string {
+func (m SchemaReferenceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3441,11 +9203,11 @@ func (m GetSchemaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSchemaResponseMultiError) AllErrors() []error { return m }
+func (m SchemaReferenceMultiError) AllErrors() []error { return m }
 
-// GetSchemaResponseValidationError is the validation error returned by
-// GetSchemaResponse.Validate if the designated constraints aren't met.
-type GetSchemaResponseValidationError struct {
+// SchemaReferenceValidationError is the validation error returned by
+// SchemaReference.Validate if the designated constraints aren't met.
+type SchemaReferenceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3453,24 +9215,22 @@ type GetSchemaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSchemaResponseValidationError) Field() string { return e.field }
+func (e SchemaReferenceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSchemaResponseValidationError) Reason() string { return e.reason }
+func (e SchemaReferenceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSchemaResponseValidationError) Cause() error { return e.cause }
+func (e SchemaReferenceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSchemaResponseValidationError) Key() bool { return e.key }
+func (e SchemaReferenceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSchemaResponseValidationError) ErrorName() string {
-	return "GetSchemaResponseValidationError"
-}
+func (e SchemaReferenceValidationError) ErrorName() string { return "SchemaReferenceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetSchemaResponseValidationError) Error() string {
+func (e SchemaReferenceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3482,14 +9242,14 @@ func (e GetSchemaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSchemaResponse.%s: %s%s",
+		"invalid %sSchemaReference.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSchemaResponseValidationError{}
+var _ error = SchemaReferenceValidationError{}
 
 var _ interface {
 	Field() string
@@ -3497,24 +9257,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSchemaResponseValidationError{}
+} = SchemaReferenceValidationError{}
 
-// Validate checks the field values on DeleteVersionRequest with the rules
+// Validate checks the field values on ListReferencesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteVersionRequest) Validate() error {
+func (m *ListReferencesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteVersionRequest with the rules
+// ValidateAll checks the field values on ListReferencesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteVersionRequestMultiError, or nil if none found.
-func (m *DeleteVersionRequest) ValidateAll() error {
+// ListReferencesRequestMultiError, or nil if none found.
+func (m *ListReferencesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteVersionRequest) validate(all bool) error {
+func (m *ListReferencesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3528,18 +9288,18 @@ func (m *DeleteVersionRequest) validate(all bool) error {
 	// no validation rules for VersionId
 
 	if len(errors) > 0 {
-		return DeleteVersionRequestMultiError(errors)
+		return ListReferencesRequestMultiError(errors)
 	}
 	return nil
 }
 
-// DeleteVersionRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteVersionRequest.ValidateAll() if the designated
+// ListReferencesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReferencesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteVersionRequestMultiError []error
+type ListReferencesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteVersionRequestMultiError) Error() string {
+func (m ListReferencesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3548,11 +9308,11 @@ func (m DeleteVersionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteVersionRequestMultiError) AllErrors() []error { return m }
+func (m ListReferencesRequestMultiError) AllErrors() []error { return m }
 
-// DeleteVersionRequestValidationError is the validation error returned by
-// DeleteVersionRequest.Validate if the designated constraints aren't met.
-type DeleteVersionRequestValidationError struct {
+// ListReferencesRequestValidationError is the validation error returned by
+// ListReferencesRequest.Validate if the designated constraints aren't met.
+type ListReferencesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3560,24 +9320,24 @@ type DeleteVersionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteVersionRequestValidationError) Field() string { return e.field }
+func (e ListReferencesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteVersionRequestValidationError) Reason() string { return e.reason }
+func (e ListReferencesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteVersionRequestValidationError) Cause() error { return e.cause }
+func (e ListReferencesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteVersionRequestValidationError) Key() bool { return e.key }
+func (e ListReferencesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteVersionRequestValidationError) ErrorName() string {
-	return "DeleteVersionRequestValidationError"
+func (e ListReferencesRequestValidationError) ErrorName() string {
+	return "ListReferencesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteVersionRequestValidationError) Error() string {
+func (e ListReferencesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3589,14 +9349,14 @@ func (e DeleteVersionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteVersionRequest.%s: %s%s",
+		"invalid %sListReferencesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteVersionRequestValidationError{}
+var _ error = ListReferencesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3604,45 +9364,111 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteVersionRequestValidationError{}
+} = ListReferencesRequestValidationError{}
 
-// Validate checks the field values on DeleteVersionResponse with the rules
+// Validate checks the field values on ListReferencesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteVersionResponse) Validate() error {
+func (m *ListReferencesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteVersionResponse with the rules
+// ValidateAll checks the field values on ListReferencesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteVersionResponseMultiError, or nil if none found.
-func (m *DeleteVersionResponse) ValidateAll() error {
+// ListReferencesResponseMultiError, or nil if none found.
+func (m *ListReferencesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteVersionResponse) validate(all bool) error {
+func (m *ListReferencesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	for idx, item := range m.GetReferences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReferencesResponseValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReferencesResponseValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReferencesResponseValidationError{
+					field:  fmt.Sprintf("References[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetReferrers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReferencesResponseValidationError{
+						field:  fmt.Sprintf("Referrers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReferencesResponseValidationError{
+						field:  fmt.Sprintf("Referrers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReferencesResponseValidationError{
+					field:  fmt.Sprintf("Referrers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteVersionResponseMultiError(errors)
+		return ListReferencesResponseMultiError(errors)
 	}
 	return nil
 }
 
-// DeleteVersionResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteVersionResponse.ValidateAll() if the designated
+// ListReferencesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListReferencesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteVersionResponseMultiError []error
+type ListReferencesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteVersionResponseMultiError) Error() string {
+func (m ListReferencesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3651,11 +9477,11 @@ func (m DeleteVersionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteVersionResponseMultiError) AllErrors() []error { return m }
+func (m ListReferencesResponseMultiError) AllErrors() []error { return m }
 
-// DeleteVersionResponseValidationError is the validation error returned by
-// DeleteVersionResponse.Validate if the designated constraints aren't met.
-type DeleteVersionResponseValidationError struct {
+// ListReferencesResponseValidationError is the validation error returned by
+// ListReferencesResponse.Validate if the designated constraints aren't met.
+type ListReferencesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3663,24 +9489,24 @@ type DeleteVersionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteVersionResponseValidationError) Field() string { return e.field }
+func (e ListReferencesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteVersionResponseValidationError) Reason() string { return e.reason }
+func (e ListReferencesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteVersionResponseValidationError) Cause() error { return e.cause }
+func (e ListReferencesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteVersionResponseValidationError) Key() bool { return e.key }
+func (e ListReferencesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteVersionResponseValidationError) ErrorName() string {
-	return "DeleteVersionResponseValidationError"
+func (e ListReferencesResponseValidationError) ErrorName() string {
+	return "ListReferencesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteVersionResponseValidationError) Error() string {
+func (e ListReferencesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3692,14 +9518,14 @@ func (e DeleteVersionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteVersionResponse.%s: %s%s",
+		"invalid %sListReferencesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteVersionResponseValidationError{}
+var _ error = ListReferencesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3707,7 +9533,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteVersionResponseValidationError{}
+} = ListReferencesResponseValidationError{}
 
 // Validate checks the field values on SearchRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -30,13 +30,30 @@ type StencilServiceClient interface {
 	ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error)
 	CreateSchema(ctx context.Context, in *CreateSchemaRequest, opts ...grpc.CallOption) (*CreateSchemaResponse, error)
 	CheckCompatibility(ctx context.Context, in *CheckCompatibilityRequest, opts ...grpc.CallOption) (*CheckCompatibilityResponse, error)
+	DiffSchemaVersions(ctx context.Context, in *DiffSchemaVersionsRequest, opts ...grpc.CallOption) (*DiffSchemaVersionsResponse, error)
 	GetSchemaMetadata(ctx context.Context, in *GetSchemaMetadataRequest, opts ...grpc.CallOption) (*GetSchemaMetadataResponse, error)
 	UpdateSchemaMetadata(ctx context.Context, in *UpdateSchemaMetadataRequest, opts ...grpc.CallOption) (*UpdateSchemaMetadataResponse, error)
 	GetLatestSchema(ctx context.Context, in *GetLatestSchemaRequest, opts ...grpc.CallOption) (*GetLatestSchemaResponse, error)
 	DeleteSchema(ctx context.Context, in *DeleteSchemaRequest, opts ...grpc.CallOption) (*DeleteSchemaResponse, error)
 	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error)
+	GetSchemaByGlobalID(ctx context.Context, in *GetSchemaByGlobalIDRequest, opts ...grpc.CallOption) (*GetSchemaByGlobalIDResponse, error)
 	ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error)
 	DeleteVersion(ctx context.Context, in *DeleteVersionRequest, opts ...grpc.CallOption) (*DeleteVersionResponse, error)
+	WatchSchemas(ctx context.Context, in *WatchSchemasRequest, opts ...grpc.CallOption) (StencilService_WatchSchemasClient, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*ListRoleBindingsResponse, error)
+	GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*GrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*RevokeRoleResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	RestoreNamespace(ctx context.Context, in *RestoreNamespaceRequest, opts ...grpc.CallOption) (*RestoreNamespaceResponse, error)
+	RestoreSchema(ctx context.Context, in *RestoreSchemaRequest, opts ...grpc.CallOption) (*RestoreSchemaResponse, error)
+	RestoreVersion(ctx context.Context, in *RestoreVersionRequest, opts ...grpc.CallOption) (*RestoreVersionResponse, error)
+	ListDeletedItems(ctx context.Context, in *ListDeletedItemsRequest, opts ...grpc.CallOption) (*ListDeletedItemsResponse, error)
+	UpdateVersionState(ctx context.Context, in *UpdateVersionStateRequest, opts ...grpc.CallOption) (*UpdateVersionStateResponse, error)
+	ListReferences(ctx context.Context, in *ListReferencesRequest, opts ...grpc.CallOption) (*ListReferencesResponse, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 }
 
@@ -120,6 +137,15 @@ func (c *stencilServiceClient) CheckCompatibility(ctx context.Context, in *Check
 	return out, nil
 }
 
+func (c *stencilServiceClient) DiffSchemaVersions(ctx context.Context, in *DiffSchemaVersionsRequest, opts ...grpc.CallOption) (*DiffSchemaVersionsResponse, error) {
+	out := new(DiffSchemaVersionsResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/DiffSchemaVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stencilServiceClient) GetSchemaMetadata(ctx context.Context, in *GetSchemaMetadataRequest, opts ...grpc.CallOption) (*GetSchemaMetadataResponse, error) {
 	out := new(GetSchemaMetadataResponse)
 	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/GetSchemaMetadata", in, out, opts...)
@@ -165,6 +191,15 @@ func (c *stencilServiceClient) GetSchema(ctx context.Context, in *GetSchemaReque
 	return out, nil
 }
 
+func (c *stencilServiceClient) GetSchemaByGlobalID(ctx context.Context, in *GetSchemaByGlobalIDRequest, opts ...grpc.CallOption) (*GetSchemaByGlobalIDResponse, error) {
+	out := new(GetSchemaByGlobalIDResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/GetSchemaByGlobalID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stencilServiceClient) ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error) {
 	out := new(ListVersionsResponse)
 	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/ListVersions", in, out, opts...)
@@ -183,6 +218,164 @@ func (c *stencilServiceClient) DeleteVersion(ctx context.Context, in *DeleteVers
 	return out, nil
 }
 
+func (c *stencilServiceClient) WatchSchemas(ctx context.Context, in *WatchSchemasRequest, opts ...grpc.CallOption) (StencilService_WatchSchemasClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StencilService_ServiceDesc.Streams[0], "/raystack.stencil.v1beta1.StencilService/WatchSchemas", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stencilServiceWatchSchemasClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StencilService_WatchSchemasClient interface {
+	Recv() (*WatchSchemasResponse, error)
+	grpc.ClientStream
+}
+
+type stencilServiceWatchSchemasClient struct {
+	grpc.ClientStream
+}
+
+func (x *stencilServiceWatchSchemasClient) Recv() (*WatchSchemasResponse, error) {
+	m := new(WatchSchemasResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *stencilServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/CreateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/ListWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*ListRoleBindingsResponse, error) {
+	out := new(ListRoleBindingsResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/ListRoleBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*GrantRoleResponse, error) {
+	out := new(GrantRoleResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*RevokeRoleResponse, error) {
+	out := new(RevokeRoleResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) RestoreNamespace(ctx context.Context, in *RestoreNamespaceRequest, opts ...grpc.CallOption) (*RestoreNamespaceResponse, error) {
+	out := new(RestoreNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/RestoreNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) RestoreSchema(ctx context.Context, in *RestoreSchemaRequest, opts ...grpc.CallOption) (*RestoreSchemaResponse, error) {
+	out := new(RestoreSchemaResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/RestoreSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) RestoreVersion(ctx context.Context, in *RestoreVersionRequest, opts ...grpc.CallOption) (*RestoreVersionResponse, error) {
+	out := new(RestoreVersionResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/RestoreVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) ListDeletedItems(ctx context.Context, in *ListDeletedItemsRequest, opts ...grpc.CallOption) (*ListDeletedItemsResponse, error) {
+	out := new(ListDeletedItemsResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/ListDeletedItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) UpdateVersionState(ctx context.Context, in *UpdateVersionStateRequest, opts ...grpc.CallOption) (*UpdateVersionStateResponse, error) {
+	out := new(UpdateVersionStateResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/UpdateVersionState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stencilServiceClient) ListReferences(ctx context.Context, in *ListReferencesRequest, opts ...grpc.CallOption) (*ListReferencesResponse, error) {
+	out := new(ListReferencesResponse)
+	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/ListReferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stencilServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
 	out := new(SearchResponse)
 	err := c.cc.Invoke(ctx, "/raystack.stencil.v1beta1.StencilService/Search", in, out, opts...)
@@ -204,13 +397,30 @@ type StencilServiceServer interface {
 	ListSchemas(context.Context, *ListSchemasRequest) (*ListSchemasResponse, error)
 	CreateSchema(context.Context, *CreateSchemaRequest) (*CreateSchemaResponse, error)
 	CheckCompatibility(context.Context, *CheckCompatibilityRequest) (*CheckCompatibilityResponse, error)
+	DiffSchemaVersions(context.Context, *DiffSchemaVersionsRequest) (*DiffSchemaVersionsResponse, error)
 	GetSchemaMetadata(context.Context, *GetSchemaMetadataRequest) (*GetSchemaMetadataResponse, error)
 	UpdateSchemaMetadata(context.Context, *UpdateSchemaMetadataRequest) (*UpdateSchemaMetadataResponse, error)
 	GetLatestSchema(context.Context, *GetLatestSchemaRequest) (*GetLatestSchemaResponse, error)
 	DeleteSchema(context.Context, *DeleteSchemaRequest) (*DeleteSchemaResponse, error)
 	GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaResponse, error)
+	GetSchemaByGlobalID(context.Context, *GetSchemaByGlobalIDRequest) (*GetSchemaByGlobalIDResponse, error)
 	ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsResponse, error)
 	DeleteVersion(context.Context, *DeleteVersionRequest) (*DeleteVersionResponse, error)
+	WatchSchemas(*WatchSchemasRequest, StencilService_WatchSchemasServer) error
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error)
+	GrantRole(context.Context, *GrantRoleRequest) (*GrantRoleResponse, error)
+	RevokeRole(context.Context, *RevokeRoleRequest) (*RevokeRoleResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	RestoreNamespace(context.Context, *RestoreNamespaceRequest) (*RestoreNamespaceResponse, error)
+	RestoreSchema(context.Context, *RestoreSchemaRequest) (*RestoreSchemaResponse, error)
+	RestoreVersion(context.Context, *RestoreVersionRequest) (*RestoreVersionResponse, error)
+	ListDeletedItems(context.Context, *ListDeletedItemsRequest) (*ListDeletedItemsResponse, error)
+	UpdateVersionState(context.Context, *UpdateVersionStateRequest) (*UpdateVersionStateResponse, error)
+	ListReferences(context.Context, *ListReferencesRequest) (*ListReferencesResponse, error)
 	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 	mustEmbedUnimplementedStencilServiceServer()
 }
@@ -243,6 +453,9 @@ func (UnimplementedStencilServiceServer) CreateSchema(context.Context, *CreateSc
 func (UnimplementedStencilServiceServer) CheckCompatibility(context.Context, *CheckCompatibilityRequest) (*CheckCompatibilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckCompatibility not implemented")
 }
+func (UnimplementedStencilServiceServer) DiffSchemaVersions(context.Context, *DiffSchemaVersionsRequest) (*DiffSchemaVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffSchemaVersions not implemented")
+}
 func (UnimplementedStencilServiceServer) GetSchemaMetadata(context.Context, *GetSchemaMetadataRequest) (*GetSchemaMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaMetadata not implemented")
 }
@@ -258,12 +471,60 @@ func (UnimplementedStencilServiceServer) DeleteSchema(context.Context, *DeleteSc
 func (UnimplementedStencilServiceServer) GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
 }
+func (UnimplementedStencilServiceServer) GetSchemaByGlobalID(context.Context, *GetSchemaByGlobalIDRequest) (*GetSchemaByGlobalIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaByGlobalID not implemented")
+}
 func (UnimplementedStencilServiceServer) ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVersions not implemented")
 }
 func (UnimplementedStencilServiceServer) DeleteVersion(context.Context, *DeleteVersionRequest) (*DeleteVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteVersion not implemented")
 }
+func (UnimplementedStencilServiceServer) WatchSchemas(*WatchSchemasRequest, StencilService_WatchSchemasServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSchemas not implemented")
+}
+func (UnimplementedStencilServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedStencilServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedStencilServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedStencilServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedStencilServiceServer) ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleBindings not implemented")
+}
+func (UnimplementedStencilServiceServer) GrantRole(context.Context, *GrantRoleRequest) (*GrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (UnimplementedStencilServiceServer) RevokeRole(context.Context, *RevokeRoleRequest) (*RevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedStencilServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedStencilServiceServer) RestoreNamespace(context.Context, *RestoreNamespaceRequest) (*RestoreNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreNamespace not implemented")
+}
+func (UnimplementedStencilServiceServer) RestoreSchema(context.Context, *RestoreSchemaRequest) (*RestoreSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSchema not implemented")
+}
+func (UnimplementedStencilServiceServer) RestoreVersion(context.Context, *RestoreVersionRequest) (*RestoreVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVersion not implemented")
+}
+func (UnimplementedStencilServiceServer) ListDeletedItems(context.Context, *ListDeletedItemsRequest) (*ListDeletedItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedItems not implemented")
+}
+func (UnimplementedStencilServiceServer) UpdateVersionState(context.Context, *UpdateVersionStateRequest) (*UpdateVersionStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVersionState not implemented")
+}
+func (UnimplementedStencilServiceServer) ListReferences(context.Context, *ListReferencesRequest) (*ListReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReferences not implemented")
+}
 func (UnimplementedStencilServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
@@ -424,6 +685,24 @@ func _StencilService_CheckCompatibility_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StencilService_DiffSchemaVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffSchemaVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).DiffSchemaVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/DiffSchemaVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).DiffSchemaVersions(ctx, req.(*DiffSchemaVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StencilService_GetSchemaMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSchemaMetadataRequest)
 	if err := dec(in); err != nil {
@@ -514,6 +793,24 @@ func _StencilService_GetSchema_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StencilService_GetSchemaByGlobalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaByGlobalIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).GetSchemaByGlobalID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/GetSchemaByGlobalID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).GetSchemaByGlobalID(ctx, req.(*GetSchemaByGlobalIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StencilService_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListVersionsRequest)
 	if err := dec(in); err != nil {
@@ -550,6 +847,279 @@ func _StencilService_DeleteVersion_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StencilService_WatchSchemas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSchemasRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StencilServiceServer).WatchSchemas(m, &stencilServiceWatchSchemasServer{stream})
+}
+
+type StencilService_WatchSchemasServer interface {
+	Send(*WatchSchemasResponse) error
+	grpc.ServerStream
+}
+
+type stencilServiceWatchSchemasServer struct {
+	grpc.ServerStream
+}
+
+func (x *stencilServiceWatchSchemasServer) Send(m *WatchSchemasResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StencilService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/CreateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/ListWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_ListRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).ListRoleBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/ListRoleBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).ListRoleBindings(ctx, req.(*ListRoleBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).GrantRole(ctx, req.(*GrantRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/RevokeRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).RevokeRole(ctx, req.(*RevokeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_RestoreNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).RestoreNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/RestoreNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).RestoreNamespace(ctx, req.(*RestoreNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_RestoreSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).RestoreSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/RestoreSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).RestoreSchema(ctx, req.(*RestoreSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_RestoreVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).RestoreVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/RestoreVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).RestoreVersion(ctx, req.(*RestoreVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_ListDeletedItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).ListDeletedItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/ListDeletedItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).ListDeletedItems(ctx, req.(*ListDeletedItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_UpdateVersionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVersionStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).UpdateVersionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/UpdateVersionState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).UpdateVersionState(ctx, req.(*UpdateVersionStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StencilService_ListReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StencilServiceServer).ListReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.stencil.v1beta1.StencilService/ListReferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StencilServiceServer).ListReferences(ctx, req.(*ListReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StencilService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchRequest)
 	if err := dec(in); err != nil {
@@ -607,6 +1177,10 @@ var StencilService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckCompatibility",
 			Handler:    _StencilService_CheckCompatibility_Handler,
 		},
+		{
+			MethodName: "DiffSchemaVersions",
+			Handler:    _StencilService_DiffSchemaVersions_Handler,
+		},
 		{
 			MethodName: "GetSchemaMetadata",
 			Handler:    _StencilService_GetSchemaMetadata_Handler,
@@ -627,6 +1201,10 @@ var StencilService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSchema",
 			Handler:    _StencilService_GetSchema_Handler,
 		},
+		{
+			MethodName: "GetSchemaByGlobalID",
+			Handler:    _StencilService_GetSchemaByGlobalID_Handler,
+		},
 		{
 			MethodName: "ListVersions",
 			Handler:    _StencilService_ListVersions_Handler,
@@ -635,11 +1213,73 @@ var StencilService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVersion",
 			Handler:    _StencilService_DeleteVersion_Handler,
 		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _StencilService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _StencilService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _StencilService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _StencilService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ListRoleBindings",
+			Handler:    _StencilService_ListRoleBindings_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _StencilService_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _StencilService_RevokeRole_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _StencilService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "RestoreNamespace",
+			Handler:    _StencilService_RestoreNamespace_Handler,
+		},
+		{
+			MethodName: "RestoreSchema",
+			Handler:    _StencilService_RestoreSchema_Handler,
+		},
+		{
+			MethodName: "RestoreVersion",
+			Handler:    _StencilService_RestoreVersion_Handler,
+		},
+		{
+			MethodName: "ListDeletedItems",
+			Handler:    _StencilService_ListDeletedItems_Handler,
+		},
+		{
+			MethodName: "UpdateVersionState",
+			Handler:    _StencilService_UpdateVersionState_Handler,
+		},
+		{
+			MethodName: "ListReferences",
+			Handler:    _StencilService_ListReferences_Handler,
+		},
 		{
 			MethodName: "Search",
 			Handler:    _StencilService_Search_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSchemas",
+			Handler:       _StencilService_WatchSchemas_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "raystack/stencil/v1beta1/stencil.proto",
 }